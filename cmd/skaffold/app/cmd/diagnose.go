@@ -115,6 +115,9 @@ func printArtifactDiagnostics(ctx context.Context, out io.Writer, configs []sche
 		if err := diagnose.CheckArtifacts(ctx, runCtx, out); err != nil {
 			return fmt.Errorf("running diagnostic on artifacts: %w", err)
 		}
+		if err := diagnose.CheckTerraformDrift(ctx, runCtx, config.Deploy.TerraformDeploy, fromBuildOutputFile.BuildArtifacts(), out); err != nil {
+			return fmt.Errorf("running diagnostic on terraform deployments: %w", err)
+		}
 
 		output.Blue.Fprintln(out, "\nConfiguration")
 	}
@@ -133,6 +133,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "build", "run", "debug", "render"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "explain-cache",
+		Usage:         "Print why artifacts aren't found in the cache: the files, build args, configuration or required artifacts that changed since their last cached build",
+		Value:         &opts.ExplainCache,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "cache-file",
 		Usage:         "Specify the location of the cache file (default $HOME/.skaffold/cache)",
@@ -141,6 +150,14 @@ var flagRegistry = []Flag{
 		FlagAddMethod: "StringVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug"},
 	},
+	{
+		Name:          "shared-cache",
+		Usage:         "Shared caches of built images to consult in order after the cache file: a registry repository (oci://REGISTRY/REPOSITORY), a directory (file:///PATH) or an S3-compatible bucket (s3://BUCKET/PREFIX)",
+		Value:         &opts.SharedCaches,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug"},
+	},
 	{
 		Name:          "remote-cache-dir",
 		Usage:         "Specify the location of the remote cache (default $HOME/.skaffold/remote-cache)",
@@ -263,6 +280,15 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"deploy", "dev", "run", "debug", "apply"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "dry-run",
+		Usage:         "Preview the changes without applying them. Only supported by the Terraform deployer, which stops after `terraform plan`.",
+		Value:         &opts.DryRun,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"deploy"},
+		IsEnum:        true,
+	},
 	{
 		Name:          "skip-tests",
 		Usage:         "Whether to skip the tests after building",
@@ -595,7 +621,7 @@ The build result from a previous 'skaffold build --file-output' run can be used
 		Value:         &fromBuildOutputFile,
 		DefValue:      "",
 		FlagAddMethod: "Var",
-		DefinedOn:     []string{"deploy", "render", "test", "verify", "exec"},
+		DefinedOn:     []string{"deploy", "render", "test", "verify", "exec", "diagnose"},
 	},
 
 	{
@@ -634,6 +660,14 @@ The build result from a previous 'skaffold build --file-output' run can be used
 		FlagAddMethod: "IntVar",
 		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
 	},
+	{
+		Name:          "build-concurrency-pool",
+		Usage:         "Limit the number of concurrently running builds of a given artifact type, as TYPE=N (e.g. jib=1). Applies on top of --build-concurrency.",
+		Value:         &opts.BuildConcurrencyPools,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "build", "run", "debug", "deploy"},
+	},
 	{
 		Name:          "digest-source",
 		Usage:         "Set to 'remote' to skip builds and resolve the digest of images by tag from the remote registry. Set to 'local' to build images locally and use digests from built images. Set to 'tag' to use tags directly from the build. Set to 'none' to use tags directly from the Kubernetes manifests. If unspecified, defaults to 'remote' for remote clusters, and 'tag' for local clusters like kind or minikube.",
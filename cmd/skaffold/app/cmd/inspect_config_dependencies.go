@@ -32,7 +32,21 @@ import (
 func cmdConfigDependencies() *cobra.Command {
 	return NewCmd("config-dependencies").
 		WithDescription("Interact with skaffold config dependency definitions.").
-		WithCommands(cmdConfigDependenciesAdd())
+		WithCommands(cmdConfigDependenciesAdd(), cmdConfigDependenciesList())
+}
+
+func cmdConfigDependenciesList() *cobra.Command {
+	return NewCmd("list").
+		WithDescription("Print the remote repositories of config dependencies and the commits they're resolved to").
+		WithExample("Get the resolved commits of remote config dependencies", "inspect config-dependencies list --format json").
+		WithExample("Get the resolved commits of the remote config dependencies of a profile", "inspect config-dependencies list --profile local --format json").
+		WithFlagAdder(cmdConfigDependenciesListFlags).
+		NoArgs(listConfigDependencies)
+}
+
+func cmdConfigDependenciesListFlags(f *pflag.FlagSet) {
+	f.StringSliceVarP(&inspectFlags.profiles, "profile", "p", nil, `Profile names to activate`)
+	f.BoolVar(&inspectFlags.propagateProfiles, "propagate-profiles", true, `Setting '--propagate-profiles=false' disables propagating profiles set by the '--profile' flag across config dependencies. This mean that only profiles defined directly in the target 'skaffold.yaml' file are activated.`)
 }
 
 func cmdConfigDependenciesAdd() *cobra.Command {
@@ -62,3 +76,13 @@ func addConfigDependencies(ctx context.Context, out io.Writer, args []string) er
 		Modules:        inspectFlags.modules,
 	}, args[0])
 }
+
+func listConfigDependencies(ctx context.Context, out io.Writer) error {
+	return configDependencies.PrintConfigDependenciesList(ctx, out, inspect.Options{
+		Filename:          inspectFlags.filename,
+		OutFormat:         inspectFlags.outFormat,
+		RemoteCacheDir:    inspectFlags.remoteCacheDir,
+		Profiles:          inspectFlags.profiles,
+		PropagateProfiles: inspectFlags.propagateProfiles,
+	})
+}
@@ -0,0 +1,70 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser"
+)
+
+// for testing
+var updateLockFile = parser.UpdateLockFile
+
+// NewCmdLock describes the CLI command to pin remote dependencies.
+func NewCmdLock() *cobra.Command {
+	return NewCmd("lock").
+		WithDescription("Pin remote config dependencies and remote Helm charts in skaffold.lock").
+		WithExample("Pin the remote dependencies that aren't pinned yet", "lock").
+		WithExample("Update all the remote dependencies to their latest commits, generations and chart versions", "lock --update-lock").
+		WithCommonFlags().
+		WithFlags([]*Flag{
+			{Value: &opts.UpdateLock, Name: "update-lock", DefValue: false, Usage: "Update the pins of all the remote dependencies, instead of only adding missing ones"},
+		}).
+		NoArgs(doLock)
+}
+
+func doLock(ctx context.Context, out io.Writer) error {
+	deps, err := updateLockFile(ctx, opts)
+	if err != nil {
+		return err
+	}
+	for _, d := range deps {
+		switch d.Kind {
+		case "googleCloudStorage":
+			fmt.Fprintf(out, "%s: %d objects\n", d.Source, len(d.Generations))
+		case "helmChart":
+			fmt.Fprintf(out, "%s: %s (%s)\n", chartName(d), d.Resolved, d.Digest)
+		default:
+			fmt.Fprintf(out, "%s: %s\n", d.Repo, d.Commit)
+		}
+	}
+	fmt.Fprintf(out, "Wrote %s\n", lock.Path(opts.ConfigurationFile))
+	return nil
+}
+
+func chartName(d parser.RemoteDependency) string {
+	if d.Repo == "" {
+		return d.Chart
+	}
+	return d.Repo + " " + d.Chart
+}
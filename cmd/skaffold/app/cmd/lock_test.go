@@ -0,0 +1,50 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestDoLock(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&opts, config.SkaffoldOptions{ConfigurationFile: "path/to/skaffold.yaml", UpdateLock: true})
+		t.Override(&updateLockFile, func(_ context.Context, opts config.SkaffoldOptions) ([]parser.RemoteDependency, error) {
+			t.CheckTrue(opts.UpdateLock)
+			return []parser.RemoteDependency{
+				{Kind: "git", Repo: "https://gitea.example.com/org/configs.git", Ref: "main", Commit: "1111111111111111111111111111111111111111", Pinned: true},
+				{Kind: "googleCloudStorage", Source: "gs://bucket/configs/*", Generations: map[string]int64{"configs/skaffold.yaml": 1}, Pinned: true},
+				{Kind: "helmChart", Chart: "nginx", Repo: "https://charts.example.com", Resolved: "15.1.2", Digest: "sha256:abcd", Pinned: true},
+			}, nil
+		})
+
+		var out bytes.Buffer
+		err := doLock(context.Background(), &out)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(`https://gitea.example.com/org/configs.git: 1111111111111111111111111111111111111111
+gs://bucket/configs/*: 1 objects
+https://charts.example.com nginx: 15.1.2 (sha256:abcd)
+Wrote path/to/skaffold.lock
+`, out.String())
+	})
+}
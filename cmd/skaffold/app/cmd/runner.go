@@ -96,6 +96,9 @@ func runContext(ctx context.Context, out io.Writer, opts config.SkaffoldOptions)
 	if err != nil {
 		return nil, nil, fmt.Errorf("getting run context: %w", err)
 	}
+	for _, cfg := range cfgSet {
+		runCtx.SetConfigFile(cfg.Metadata.Name, cfg.SourceFile)
+	}
 
 	if err := validation.ProcessWithRunContext(ctx, runCtx); err != nil {
 		return nil, nil, fmt.Errorf("invalid skaffold config: %w", err)
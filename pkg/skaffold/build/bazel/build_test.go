@@ -58,6 +58,8 @@ func TestBuildBazel(t *testing.T) {
 
 func TestBazelTarPathPrependExecutionRoot(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Mkdir("bazel")
+		t.Chdir(tmpDir.Path("bazel"))
 		t.Override(&util.DefaultExecCommand, testutil.CmdRun("bazel build //:app.tar --color=no").AndRunOut(
 			"bazel cquery //:app.tar --output starlark --starlark:expr target.files.to_list()[0].path",
 			"app.tar").AndRunOut("bazel info execution_root", ".."))
@@ -81,6 +83,8 @@ func TestBazelTarPathPrependExecutionRoot(t *testing.T) {
 
 func TestBazelAddPlatforms(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Mkdir("bazel")
+		t.Chdir(tmpDir.Path("bazel"))
 		t.Override(&util.DefaultExecCommand, testutil.CmdRun("bazel build //:app.tar --platforms=//platforms:linux-x86_64 --color=no").AndRunOut(
 			"bazel cquery //:app.tar --output starlark --starlark:expr target.files.to_list()[0].path",
 			"app.tar").AndRunOut("bazel info execution_root", ".."))
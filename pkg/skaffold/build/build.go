@@ -63,6 +63,19 @@ type PipelineBuilder interface {
 	SupportedPlatforms() platform.Matcher
 }
 
+// MultiPlatformBuilder is implemented by pipeline builders that build some artifact types for multiple platforms at once,
+// instead of building an image per platform and assembling them into a manifest list.
+type MultiPlatformBuilder interface {
+	SupportsMultiPlatformBuild(a *latest.Artifact) bool
+}
+
+// BatchBuilder is implemented by pipeline builders that can build several artifacts together.
+// PrepareBatch is called before any build starts, with the artifacts built by this pipeline builder
+// and all the artifacts of the current build.
+type BatchBuilder interface {
+	PrepareBatch(ctx context.Context, artifacts []*latest.Artifact, building []*latest.Artifact, tags tag.ImageTags, platforms platform.Resolver)
+}
+
 type ErrSyncMapNotSupported struct{}
 
 func (ErrSyncMapNotSupported) Error() string {
@@ -21,7 +21,11 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/misc"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
@@ -34,11 +38,14 @@ import (
 
 // BuilderMux encapsulates multiple build configs.
 type BuilderMux struct {
-	builders    []PipelineBuilder
-	byImageName map[string]PipelineBuilder
-	store       ArtifactStore
-	concurrency int
-	cache       Cache
+	builders      []PipelineBuilder
+	byImageName   map[string]PipelineBuilder
+	store         ArtifactStore
+	concurrency   int
+	pools         map[string]int
+	durationsFile string
+	timeline      Timeline
+	cache         Cache
 }
 
 type Cache interface {
@@ -52,7 +59,9 @@ type Config interface {
 	Mode() config.RunMode
 	MultiLevelRepo() *bool
 	GlobalConfig() string
+	CacheFile() string
 	BuildConcurrency() int
+	BuildConcurrencyPools() []string
 }
 
 // NewBuilderMux returns an implementation of `build.BuilderMux`.
@@ -71,7 +80,15 @@ func NewBuilderMux(cfg Config, store ArtifactStore, cache Cache, builder func(p
 		}
 	}
 	concurrency := getConcurrency(pbs, cfg.BuildConcurrency())
-	return &BuilderMux{builders: pbs, byImageName: m, store: store, concurrency: concurrency, cache: cache}, nil
+	pools, err := getConcurrencyPools(cfg.BuildConcurrencyPools())
+	if err != nil {
+		return nil, err
+	}
+	file, err := durationsFile(cfg.CacheFile())
+	if err != nil {
+		log.Entry(context.TODO()).Debugf("Build durations won't be persisted: %v", err)
+	}
+	return &BuilderMux{builders: pbs, byImageName: m, store: store, concurrency: concurrency, pools: pools, durationsFile: file, cache: cache}, nil
 }
 
 // Build executes the specific image builder for each artifact in the given artifact slice.
@@ -85,6 +102,15 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		if err := builder.PreBuild(ctx, out); err != nil {
 			return nil, err
 		}
+		if bb, ok := builder.(BatchBuilder); ok {
+			var own []*latest.Artifact
+			for _, a := range artifacts {
+				if b.byImageName[a.ImageName] == builder {
+					own = append(own, a)
+				}
+			}
+			bb.PrepareBatch(ctx, own, artifacts, tags, resolver)
+		}
 	}
 
 	builderF := func(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
@@ -106,7 +132,7 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		}
 		var built string
 
-		if platforms.IsMultiPlatform() && !SupportsMultiPlatformBuild(*artifact) {
+		if platforms.IsMultiPlatform() && !SupportsMultiPlatformBuild(*artifact) && !builderSupportsMultiPlatformBuild(p, artifact) {
 			built, err = CreateMultiPlatformImage(ctx, out, artifact, tag, platforms, artifactBuilder)
 		} else {
 			built, err = artifactBuilder(ctx, out, artifact, tag, platforms)
@@ -116,11 +142,7 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 			return "", err
 		}
 
-		if err := b.cache.AddArtifact(ctx, graph.Artifact{
-			ImageName:   artifact.ImageName,
-			Tag:         built,
-			RuntimeType: artifact.RuntimeType,
-		}); err != nil {
+		if err := b.cache.AddArtifact(ctx, graph.NewArtifact(artifact, built)); err != nil {
 			log.Entry(ctx).Warnf("error adding artifact to cache; caching may not work as expected: %v", err)
 		}
 
@@ -136,7 +158,15 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		return nil, fmt.Errorf("%w", err) // TODO: remove error wrapping after fixing #7790
 	}
 
-	ar, err := InOrder(ctx, out, tags, resolver, artifacts, builderF, b.concurrency, b.store)
+	durations := loadDurations(ctx, b.durationsFile)
+	ar, timeline, err := inOrder(ctx, out, tags, resolver, artifacts, builderF, b.concurrency, b.pools, durations, b.store)
+	b.timeline = timeline
+	if b.durationsFile != "" {
+		durations.record(artifacts, timeline)
+		if errD := saveDurations(b.durationsFile, durations); errD != nil {
+			log.Entry(ctx).Debugf("Error saving build durations: %v", errD)
+		}
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -150,6 +180,11 @@ func (b *BuilderMux) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 	return ar, nil
 }
 
+// Timeline returns the timeline of the artifact builds of the last call to Build.
+func (b *BuilderMux) Timeline() Timeline {
+	return b.timeline
+}
+
 // Prune removes built images.
 func (b *BuilderMux) Prune(ctx context.Context, writer io.Writer) error {
 	for _, builder := range b.builders {
@@ -160,6 +195,12 @@ func (b *BuilderMux) Prune(ctx context.Context, writer io.Writer) error {
 	return nil
 }
 
+// builderSupportsMultiPlatformBuild returns true if the pipeline builder builds the artifact for multiple platforms at once.
+func builderSupportsMultiPlatformBuild(p PipelineBuilder, a *latest.Artifact) bool {
+	mb, ok := p.(MultiPlatformBuilder)
+	return ok && mb.SupportsMultiPlatformBuild(a)
+}
+
 // filterBuildEnvSupportedPlatforms filters the target platforms to those supported by the selected build environment (local/googleCloudBuild/cluster).
 func filterBuildEnvSupportedPlatforms(supported platform.Matcher, target platform.Matcher) (platform.Matcher, error) {
 	if target.IsEmpty() {
@@ -206,6 +247,31 @@ func getConcurrency(pbs []PipelineBuilder, cliConcurrency int) int {
 	return minConcurrency
 }
 
+// getConcurrencyPools parses the per artifact type concurrency limits, given as TYPE=N.
+func getConcurrencyPools(pools []string) (map[string]int, error) {
+	if len(pools) == 0 {
+		return nil, nil
+	}
+	types := []string{misc.Docker, misc.Kaniko, misc.Bazel, misc.Jib, misc.Custom, misc.Buildpack, misc.Ko, misc.Packer}
+	m := make(map[string]int)
+	for _, pool := range pools {
+		name, value, found := strings.Cut(pool, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid build concurrency pool %q: expected TYPE=N", pool)
+		}
+		if !slices.Contains(types, name) {
+			return nil, fmt.Errorf("invalid build concurrency pool %q: unknown artifact type %q, expected one of %s", pool, name, strings.Join(types, ", "))
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid build concurrency pool %q: %q is not a valid number of builds", pool, value)
+		}
+		log.Entry(context.TODO()).Infof("build concurrency of %s artifacts set to %d", name, limit)
+		m[name] = limit
+	}
+	return m, nil
+}
+
 func checkMultiplatformHaveRegistry(b *BuilderMux, artifacts []*latest.Artifact, platforms platform.Resolver) error {
 	for _, artifact := range artifacts {
 		pb := b.byImageName[artifact.ImageName]
@@ -194,6 +194,7 @@ type mockConfig struct {
 	pipelines []latest.Pipeline
 	mode      config.RunMode
 	optRepo   string
+	pools     []string
 }
 
 func (m *mockConfig) GetPipelines() []latest.Pipeline { return m.pipelines }
@@ -205,8 +206,49 @@ func (m *mockConfig) DefaultRepo() *string {
 	}
 	return nil
 }
-func (m *mockConfig) MultiLevelRepo() *bool { return nil }
-func (m *mockConfig) BuildConcurrency() int { return -1 }
+func (m *mockConfig) MultiLevelRepo() *bool           { return nil }
+func (m *mockConfig) BuildConcurrency() int           { return -1 }
+func (m *mockConfig) BuildConcurrencyPools() []string { return m.pools }
+func (m *mockConfig) CacheFile() string               { return "" }
+
+func TestGetConcurrencyPools(t *testing.T) {
+	tests := []struct {
+		description string
+		pools       []string
+		expected    map[string]int
+		shouldErr   bool
+	}{
+		{
+			description: "no pools",
+		},
+		{
+			description: "pools per artifact type",
+			pools:       []string{"jib=1", "docker=4", "bazel=0"},
+			expected:    map[string]int{"jib": 1, "docker": 4, "bazel": 0},
+		},
+		{
+			description: "missing limit",
+			pools:       []string{"jib"},
+			shouldErr:   true,
+		},
+		{
+			description: "unknown artifact type",
+			pools:       []string{"maven=1"},
+			shouldErr:   true,
+		},
+		{
+			description: "invalid limit",
+			pools:       []string{"docker=-1"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pools, err := getConcurrencyPools(test.pools)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, pools)
+		})
+	}
+}
 
 type mockPipelineBuilder struct {
 	concurrency *int
@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+// Backend is a shared cache of the images built for artifact hashes, consulted after the local cache file.
+// Shared caches only record image digests, so they're used for images pushed to a registry.
+type Backend interface {
+	// Get returns the details of the image built for an artifact hash.
+	Get(ctx context.Context, hash string) (ImageDetails, bool, error)
+	// Put records the details of the image built for an artifact hash.
+	Put(ctx context.Context, hash string, entry ImageDetails) error
+	String() string
+}
+
+// NewBackend returns the shared cache at a URI:
+//   - `oci://REGISTRY/REPOSITORY` tags an OCI artifact holding the image digest for each artifact hash.
+//   - `file:///PATH`, or a path, stores a file for each artifact hash in a directory.
+//   - `s3://BUCKET/PREFIX` stores an object for each artifact hash in an S3-compatible bucket.
+func NewBackend(ctx context.Context, uri string, cfg docker.Config) (Backend, error) {
+	switch {
+	case strings.HasPrefix(uri, "oci://"):
+		repo := strings.TrimSuffix(strings.TrimPrefix(uri, "oci://"), "/")
+		if repo == "" {
+			return nil, fmt.Errorf("invalid shared cache %q: missing repository", uri)
+		}
+		return &registryBackend{repo: repo, cfg: cfg}, nil
+	case strings.HasPrefix(uri, "s3://"):
+		u, err := url.Parse(uri)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shared cache %q: %w", uri, err)
+		}
+		store, err := newS3Store(ctx, u)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shared cache %q: %w", uri, err)
+		}
+		return &blobBackend{uri: uri, store: store}, nil
+	case strings.Contains(uri, "://") && !strings.HasPrefix(uri, "file://"):
+		return nil, fmt.Errorf("invalid shared cache %q: unsupported scheme, expected oci://, s3:// or file://", uri)
+	default:
+		dir := strings.TrimPrefix(uri, "file://")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return nil, fmt.Errorf("invalid shared cache %q: %w", uri, err)
+		}
+		return &blobBackend{uri: uri, store: dirStore(dir)}, nil
+	}
+}
+
+// newBackends returns the shared caches of the config, skipping the invalid ones.
+func newBackends(ctx context.Context, cfg Config) []Backend {
+	var backends []Backend
+	for _, uri := range cfg.SharedCaches() {
+		b, err := NewBackend(ctx, uri, cfg)
+		if err != nil {
+			log.Entry(ctx).Warnf("Not using shared cache: %v", err)
+			continue
+		}
+		backends = append(backends, b)
+	}
+	return backends
+}
+
+// lookupShared consults the shared caches in order, and records the first hit in the local cache.
+// Shared caches that missed before the hit are filled in, so that faster caches can be listed first.
+func (c *cache) lookupShared(ctx context.Context, imageName, hash string) (ImageDetails, bool) {
+	for i, b := range c.backends {
+		entry, found, err := b.Get(ctx, hash)
+		if err != nil {
+			log.Entry(ctx).Warnf("Could not look up %s in shared cache %s: %v", imageName, b, err)
+			continue
+		}
+		if !found || entry.Digest == "" {
+			continue
+		}
+		log.Entry(ctx).Debugf("Found %s in shared cache %s", imageName, b)
+		c.publish(ctx, c.backends[:i], hash, entry)
+
+		c.cacheMutex.Lock()
+		c.artifactCache[hash] = entry
+		c.cacheMutex.Unlock()
+		return entry, true
+	}
+	return ImageDetails{}, false
+}
+
+// publishTimeout bounds the time spent recording an image in a shared cache.
+const publishTimeout = 30 * time.Second
+
+// publish records the image built for an artifact hash in shared caches, in the background, so that slow or
+// unreachable caches don't delay builds. Failures only disable sharing.
+func (c *cache) publish(ctx context.Context, backends []Backend, hash string, entry ImageDetails) {
+	// the build context is canceled once the build ends, while images are still being recorded.
+	ctx = context.WithoutCancel(ctx)
+	for _, b := range backends {
+		c.publishing.Add(1)
+		go func(b Backend) {
+			defer c.publishing.Done()
+			ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+			defer cancel()
+			if err := b.Put(ctx, hash, entry); err != nil {
+				log.Entry(ctx).Warnf("Could not record %s in shared cache %s: %v", entry.Image, b, err)
+			}
+		}(b)
+	}
+}
+
+// waitForPublishing waits for the images being recorded in shared caches, for at most publishTimeout.
+func (c *cache) waitForPublishing(ctx context.Context) {
+	done := make(chan struct{})
+	go func() {
+		c.publishing.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(publishTimeout):
+		log.Entry(ctx).Warnf("Gave up recording images in shared caches after %v", publishTimeout)
+	}
+}
+
+// registryBackend records image details in the annotations of OCI artifacts, tagged with artifact hashes in a repository.
+type registryBackend struct {
+	repo string
+	cfg  docker.Config
+}
+
+const (
+	digestAnnotation = "dev.skaffold.cache.digest"
+	imageAnnotation  = "dev.skaffold.cache.image"
+)
+
+func (r *registryBackend) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	annotations, err := docker.RemoteAnnotations(r.repo+":"+hash, r.cfg)
+	if err != nil || annotations[digestAnnotation] == "" {
+		return ImageDetails{}, false, err
+	}
+	return ImageDetails{Digest: annotations[digestAnnotation], Image: annotations[imageAnnotation]}, true, nil
+}
+
+func (r *registryBackend) Put(_ context.Context, hash string, entry ImageDetails) error {
+	return docker.PushAnnotations(r.repo+":"+hash, map[string]string{
+		digestAnnotation: entry.Digest,
+		imageAnnotation:  entry.Image,
+	}, r.cfg)
+}
+
+func (r *registryBackend) String() string {
+	return "oci://" + r.repo
+}
+
+// objectStore reads and writes objects by name.
+type objectStore interface {
+	get(ctx context.Context, name string) ([]byte, bool, error)
+	put(ctx context.Context, name string, data []byte) error
+}
+
+// blobBackend stores image details as a YAML object for each artifact hash.
+type blobBackend struct {
+	uri   string
+	store objectStore
+}
+
+func (b *blobBackend) Get(ctx context.Context, hash string) (ImageDetails, bool, error) {
+	data, found, err := b.store.get(ctx, hash+".yaml")
+	if err != nil || !found {
+		return ImageDetails{}, false, err
+	}
+	var entry ImageDetails
+	if err := yaml.Unmarshal(data, &entry); err != nil {
+		return ImageDetails{}, false, fmt.Errorf("parsing cache entry %s: %w", hash, err)
+	}
+	return entry, true, nil
+}
+
+func (b *blobBackend) Put(ctx context.Context, hash string, entry ImageDetails) error {
+	data, err := yaml.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return b.store.put(ctx, hash+".yaml", data)
+}
+
+func (b *blobBackend) String() string {
+	return b.uri
+}
+
+// dirStore stores objects as files of a directory, which can be a shared mount.
+type dirStore string
+
+func (d dirStore) get(_ context.Context, name string) ([]byte, bool, error) {
+	data, err := os.ReadFile(filepath.Join(string(d), name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, false, nil
+	}
+	return data, err == nil, err
+}
+
+func (d dirStore) put(_ context.Context, name string, data []byte) error {
+	// Write to a temporary file first, so that concurrent readers never see a partial file.
+	f, err := os.CreateTemp(string(d), name+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(string(d), name))
+}
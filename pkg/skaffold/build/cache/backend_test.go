@@ -0,0 +1,218 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/registry"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestNewBackend(t *testing.T) {
+	tests := []struct {
+		description string
+		uri         string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "registry",
+			uri:         "oci://gcr.io/my-project/skaffold-cache/",
+			expected:    "oci://gcr.io/my-project/skaffold-cache",
+		},
+		{
+			description: "registry without repository",
+			uri:         "oci://",
+			shouldErr:   true,
+		},
+		{
+			description: "s3 bucket",
+			uri:         "s3://my-bucket/skaffold?region=eu-west-1",
+			expected:    "s3://my-bucket/skaffold?region=eu-west-1",
+		},
+		{
+			description: "s3 without bucket",
+			uri:         "s3:///skaffold",
+			shouldErr:   true,
+		},
+		{
+			description: "unsupported scheme",
+			uri:         "ftp://example.com/cache",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.SetEnvs(map[string]string{"AWS_CONFIG_FILE": "does-not-exist", "AWS_SHARED_CREDENTIALS_FILE": "does-not-exist"})
+
+			b, err := NewBackend(context.Background(), test.uri, &mockConfig{})
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, b.String())
+			}
+		})
+	}
+}
+
+func TestDirectoryBackend(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+
+		b, err := NewBackend(context.Background(), "file://"+tmpDir.Path("shared/cache"), &mockConfig{})
+		t.CheckNoError(err)
+
+		_, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		t.CheckNoError(b.Put(context.Background(), "hash", ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}))
+		t.CheckFileExistAndContent(tmpDir.Path("shared/cache/hash.yaml"), []byte("digest: sha256:abcd\nimage: gcr.io/p/app\n"))
+
+		entry, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}, entry)
+	})
+}
+
+func TestS3Backend(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var mu sync.Mutex
+		objects := map[string]string{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=AKID/") || !strings.Contains(r.Header.Get("Authorization"), "/eu-west-1/s3/aws4_request") {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			switch r.Method {
+			case http.MethodPut:
+				buf := new(strings.Builder)
+				_, _ = io.Copy(buf, r.Body)
+				objects[r.URL.Path] = buf.String()
+			case http.MethodGet:
+				o, found := objects[r.URL.Path]
+				if !found {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = w.Write([]byte(o))
+			}
+		}))
+		defer server.Close()
+		t.SetEnvs(map[string]string{
+			"AWS_CONFIG_FILE":             "does-not-exist",
+			"AWS_SHARED_CREDENTIALS_FILE": "does-not-exist",
+			"AWS_ACCESS_KEY_ID":           "AKID",
+			"AWS_SECRET_ACCESS_KEY":       "SECRET",
+			"AWS_REGION":                  "eu-west-1",
+			"AWS_ENDPOINT_URL_S3":         server.URL,
+		})
+
+		b, err := NewBackend(context.Background(), "s3://my-bucket/skaffold/cache", &mockConfig{})
+		t.CheckNoError(err)
+
+		_, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		t.CheckNoError(b.Put(context.Background(), "hash", ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}))
+		t.CheckDeepEqual(map[string]string{"/my-bucket/skaffold/cache/hash.yaml": "digest: sha256:abcd\nimage: gcr.io/p/app\n"}, objects)
+
+		entry, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}, entry)
+
+		// requests rejected by the store are errors, not misses
+		t.SetEnvs(map[string]string{"AWS_REGION": "us-east-1"})
+		b, err = NewBackend(context.Background(), "s3://my-bucket/skaffold/cache", &mockConfig{})
+		t.CheckNoError(err)
+		_, _, err = b.Get(context.Background(), "hash")
+		t.CheckErrorContains("StatusCode: 403", err)
+	})
+}
+
+func TestRegistryBackend(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		server := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+		defer server.Close()
+		repo := strings.TrimPrefix(server.URL, "http://") + "/skaffold-cache"
+
+		b, err := NewBackend(context.Background(), "oci://"+repo, &mockConfig{})
+		t.CheckNoError(err)
+
+		_, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckFalse(found)
+
+		t.CheckNoError(b.Put(context.Background(), "hash", ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}))
+		entry, found, err := b.Get(context.Background(), "hash")
+		t.CheckNoError(err)
+		t.CheckTrue(found)
+		t.CheckDeepEqual(ImageDetails{Digest: "sha256:abcd", Image: "gcr.io/p/app"}, entry)
+	})
+}
+
+func TestAddArtifactPublishes(t *testing.T) {
+	digest := "sha256:aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"
+	tests := []struct {
+		description string
+		isLocal     bool
+		expected    map[string]ImageDetails
+	}{
+		{
+			description: "pushed images are shared",
+			expected:    map[string]ImageDetails{"hash": {Digest: digest, Image: "gcr.io/p/app"}},
+		},
+		{
+			description: "local images aren't shared",
+			isLocal:     true,
+			expected:    map[string]ImageDetails{},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			backend := &mockBackend{entries: map[string]ImageDetails{}, block: make(chan struct{})}
+			c := &cache{
+				artifactCache: ArtifactCache{},
+				hashByName:    map[string]string{"app": "hash"},
+				isLocalImage:  func(string) (bool, error) { return test.isLocal, nil },
+				client:        fakeLocalDaemon((&testutil.FakeAPIClient{}).Add("gcr.io/p/app:v1@"+digest, "imageID")),
+				backends:      []Backend{backend},
+			}
+
+			// images are recorded in the background, without blocking builds
+			err := c.AddArtifact(context.Background(), graph.Artifact{ImageName: "app", Tag: "gcr.io/p/app:v1@" + digest})
+			t.CheckNoError(err)
+			close(backend.block)
+			c.waitForPublishing(context.Background())
+			t.CheckDeepEqual(test.expected, backend.entries)
+		})
+	}
+}
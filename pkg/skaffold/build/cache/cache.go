@@ -40,6 +40,13 @@ import (
 type ImageDetails struct {
 	Digest string `yaml:"digest,omitempty"`
 	ID     string `yaml:"id,omitempty"`
+	// Image is the repository that holds the digest, for images found in a shared cache.
+	Image string `yaml:"image,omitempty"`
+	// MachineImage is the ID of what a Packer artifact built when it isn't an image, for instance an AMI.
+	// Such entries are only kept in the local cache and aren't checked for existence.
+	MachineImage string `yaml:"machineImage,omitempty"`
+	// Inputs are the inputs of the hash, which are only kept in the local cache.
+	Inputs *Inputs `yaml:"inputs,omitempty"`
 }
 
 // ArtifactCache is a map of [artifact dependencies hash : ImageDetails]
@@ -58,6 +65,10 @@ type cache struct {
 	isLocalImage       func(imageName string) (bool, error)
 	importMissingImage func(imageName string) (bool, error)
 	lister             DependencyLister
+	backends           []Backend
+	publishing         sync.WaitGroup
+	// currentInputs are the inputs of the last lookup of each artifact.
+	currentInputs map[string]*Inputs
 }
 
 // DependencyLister fetches a list of dependencies for an artifact
@@ -71,6 +82,8 @@ type Config interface {
 	GetCluster() config.Cluster
 	CacheArtifacts() bool
 	CacheFile() string
+	SharedCaches() []string
+	ExplainCache() bool
 	Mode() config.RunMode
 }
 
@@ -129,6 +142,8 @@ func NewCache(ctx context.Context, cfg Config, isLocalImage func(imageName strin
 		isLocalImage:       isLocalImage,
 		importMissingImage: importMissingImage,
 		lister:             dependencies,
+		backends:           newBackends(ctx, cfg),
+		currentInputs:      map[string]*Inputs{},
 	}, nil
 }
 
@@ -78,9 +78,11 @@ func (d needsLocalTagging) Tag(ctx context.Context, c *cache, platforms platform
 
 // Found remotely with wrong tag. Needs retagging
 type needsRemoteTagging struct {
-	hash      string
-	tag       string
-	digest    string
+	hash   string
+	tag    string
+	digest string
+	// source is the repository that holds the digest, when it was built by another machine.
+	source    string
 	platforms []v1.Platform
 }
 
@@ -90,6 +92,9 @@ func (d needsRemoteTagging) Hash() string {
 
 func (d needsRemoteTagging) Tag(ctx context.Context, c *cache, platforms platform.Resolver) error {
 	fqn := d.tag + "@" + d.digest // Tag is not important. We just need the registry and the digest to locate the image.
+	if d.source != "" {
+		fqn = d.source + "@" + d.digest
+	}
 	return docker.AddRemoteTag(fqn, d.tag, c.cfg, d.platforms)
 }
 
@@ -26,9 +26,12 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
+	"sync"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/kaniko"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
@@ -48,13 +51,18 @@ var (
 
 type artifactHasher interface {
 	hash(context.Context, *latest.Artifact, platform.Resolver) (string, error)
+	// inputs returns the inputs of the last hash of an artifact, or nil.
+	inputs(imageName string) *Inputs
 }
 
 type artifactHasherImpl struct {
-	artifacts graph.ArtifactGraph
-	lister    DependencyLister
-	mode      config.RunMode
-	syncStore *util.SyncStore[string]
+	artifacts   graph.ArtifactGraph
+	lister      DependencyLister
+	mode        config.RunMode
+	syncStore   *util.SyncStore[string]
+	inputsMutex sync.Mutex
+	single      map[string]*Inputs
+	all         map[string]*Inputs
 }
 
 // newArtifactHasher returns a new instance of an artifactHasher. Use newArtifactHasherFunc instead of calling this function directly.
@@ -64,6 +72,8 @@ func newArtifactHasher(artifacts graph.ArtifactGraph, lister DependencyLister, m
 		lister:    lister,
 		mode:      mode,
 		syncStore: util.NewSyncStore[string](),
+		single:    map[string]*Inputs{},
+		all:       map[string]*Inputs{},
 	}
 }
 
@@ -79,6 +89,7 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact, platf
 		return "", err
 	}
 	hashes := []string{hash}
+	deps := map[string]string{}
 	for _, dep := range sortedDependencies(a, h.artifacts) {
 		depHash, err := h.hash(ctx, dep, platforms)
 		if err != nil {
@@ -86,24 +97,55 @@ func (h *artifactHasherImpl) hash(ctx context.Context, a *latest.Artifact, platf
 			return "", err
 		}
 		hashes = append(hashes, depHash)
+		deps[dep.ImageName] = depHash
 	}
 
-	if len(hashes) == 1 {
-		return hashes[0], nil
+	if len(hashes) > 1 {
+		if hash, err = encode(hashes); err != nil {
+			return "", err
+		}
 	}
-	return encode(hashes)
+
+	h.inputsMutex.Lock()
+	if single := h.single[a.ImageName]; single != nil {
+		in := *single
+		in.Hash = hash
+		if len(deps) > 0 {
+			in.Dependencies = deps
+		}
+		h.all[a.ImageName] = &in
+	}
+	h.inputsMutex.Unlock()
+	return hash, nil
+}
+
+func (h *artifactHasherImpl) inputs(imageName string) *Inputs {
+	h.inputsMutex.Lock()
+	defer h.inputsMutex.Unlock()
+	return h.all[imageName]
 }
 
 func (h *artifactHasherImpl) safeHash(ctx context.Context, a *latest.Artifact, platforms platform.Matcher) (string, error) {
 	return h.syncStore.Exec(a.ImageName,
 		func() (string, error) {
-			return singleArtifactHash(ctx, h.lister, a, h.mode, platforms)
+			in := &Inputs{}
+			hash, err := singleArtifactHash(ctx, h.lister, a, h.mode, platforms, in)
+			if err == nil {
+				h.inputsMutex.Lock()
+				h.single[a.ImageName] = in
+				h.inputsMutex.Unlock()
+			}
+			return hash, err
 		})
 }
 
 // singleArtifactHash calculates the hash for a single artifact, and ignores its required artifacts.
-func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest.Artifact, mode config.RunMode, m platform.Matcher) (string, error) {
+// The inputs of the hash are recorded in the input manifest, when it's set.
+func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *latest.Artifact, mode config.RunMode, m platform.Matcher, manifest *Inputs) (string, error) {
 	var inputs []string
+	if manifest == nil {
+		manifest = &Inputs{}
+	}
 
 	// Append the artifact's configuration
 	config, err := artifactConfigFunc(a)
@@ -111,6 +153,7 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 		return "", fmt.Errorf("getting artifact's configuration for %q: %w", a.ImageName, err)
 	}
 	inputs = append(inputs, config)
+	manifest.Config = digestOf(config)
 
 	// Append the digest of each input file
 	deps, err := depLister(ctx, a)
@@ -130,6 +173,10 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 			return "", fmt.Errorf("getting hash for %q: %w", d, err)
 		}
 		inputs = append(inputs, h)
+		if manifest.Files == nil {
+			manifest.Files = map[string]string{}
+		}
+		manifest.Files[d] = h
 	}
 
 	// add build args for the artifact if specified
@@ -139,6 +186,11 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 	}
 	if args != nil {
 		inputs = append(inputs, args...)
+		manifest.buildArgs = map[string]string{}
+		for _, arg := range args {
+			k, v, _ := strings.Cut(arg, "=")
+			manifest.buildArgs[k] = v
+		}
 	}
 
 	// add build platforms
@@ -148,10 +200,17 @@ func singleArtifactHash(ctx context.Context, depLister DependencyLister, a *late
 	}
 	sort.Strings(ps)
 	inputs = append(inputs, ps...)
+	manifest.Platforms = ps
 
 	return encode(inputs)
 }
 
+// digestOf returns a short digest of a hash input.
+func digestOf(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
 func encode(inputs []string) (string, error) {
 	// get a key for the hashes
 	hasher := sha256.New()
@@ -185,6 +244,8 @@ func hashBuildArgs(artifact *latest.Artifact, mode config.RunMode) ([]string, er
 		env, err = buildpacks.GetEnv(artifact, mode)
 	case artifact.CustomArtifact != nil && artifact.CustomArtifact.Dependencies.Dockerfile != nil:
 		args, err = util.EvaluateEnvTemplateMap(artifact.CustomArtifact.Dependencies.Dockerfile.BuildArgs)
+	case artifact.PackerArtifact != nil:
+		env = packer.GetEnv(artifact.PackerArtifact)
 	default:
 		return nil, nil
 	}
@@ -21,6 +21,8 @@ import (
 	"os"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
@@ -545,6 +547,14 @@ func TestHashBuildArgs(t *testing.T) {
 					Dependencies: &latest.CustomDependencies{},
 				},
 			},
+		}, {
+			description: "packer artifact with template variables in env",
+			artifactType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{
+					Env: []string{"PKR_VAR_region=us-east-1", "PACKER_LOG=1"},
+				},
+			},
+			expected: []string{"PKR_VAR_region=us-east-1"},
 		},
 	}
 
@@ -565,9 +575,50 @@ func TestHashBuildArgs(t *testing.T) {
 				a.Workspace = tmpDir.Path(".")
 				a.ArtifactType.KanikoArtifact.DockerfilePath = Dockerfile
 			}
+			if test.artifactType.PackerArtifact != nil {
+				t.Override(&util.OSEnviron, func() []string { return nil })
+			}
 			actual, err := hashBuildArgs(a, test.mode)
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
 		})
 	}
 }
+
+func TestArtifactInputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&fileHasherFunc, mockCacheHasher)
+		t.Override(&artifactConfigFunc, fakeArtifactConfig)
+		tmpDir := t.NewTempDir().Write("Dockerfile", "FROM foo")
+		artifacts := []*latest.Artifact{
+			{ImageName: "img1", Dependencies: []*latest.ArtifactDependency{{ImageName: "img2"}}},
+			{ImageName: "img2", Workspace: tmpDir.Root(), ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+				DockerfilePath: Dockerfile,
+				BuildArgs:      map[string]*string{"TOKEN": util.Ptr("secret")},
+			}}},
+		}
+		depLister := func(_ context.Context, a *latest.Artifact) ([]string, error) {
+			return map[string][]string{"img1": {"a", "not-found"}, "img2": {"b"}}[a.ImageName], nil
+		}
+
+		h := newArtifactHasher(graph.ToArtifactGraph(artifacts), depLister, config.RunModes.Dev)
+		hash, err := h.hash(context.Background(), artifacts[0], platform.Resolver{})
+		t.CheckNoError(err)
+		depHash, err := h.hash(context.Background(), artifacts[1], platform.Resolver{})
+		t.CheckNoError(err)
+
+		t.CheckDeepEqual(&Inputs{
+			Hash:         hash,
+			Config:       digestOf(""),
+			Files:        map[string]string{"a": "a"},
+			Dependencies: map[string]string{"img2": depHash},
+		}, h.inputs("img1"), cmp.AllowUnexported(Inputs{}))
+		t.CheckDeepEqual(&Inputs{
+			Hash:      depHash,
+			Config:    digestOf("docker/target="),
+			Files:     map[string]string{"b": "b"},
+			buildArgs: map[string]string{"TOKEN": "secret"},
+		}, h.inputs("img2"), cmp.AllowUnexported(Inputs{}))
+		t.CheckNil(h.inputs("img3"))
+	})
+}
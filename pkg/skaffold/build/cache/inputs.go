@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// maxExplainedFiles is the number of changed files listed when explaining a cache miss.
+const maxExplainedFiles = 10
+
+// Inputs is the manifest of the inputs of an artifact hash, recorded with the hash in the cache file to explain cache misses.
+type Inputs struct {
+	// Hash is the artifact hash, which keys the inputs in the cache file.
+	Hash string `yaml:"-"`
+	// ImageName is the artifact built from the inputs.
+	ImageName string `yaml:"imageName"`
+	// Time is when the image of the inputs was last built or found in the cache.
+	Time time.Time `yaml:"time"`
+	// Config is a digest of the artifact's configuration.
+	Config string `yaml:"config"`
+	// Files are the hashes of the artifact's dependency files.
+	Files map[string]string `yaml:"files,omitempty"`
+	// Salt is the random salt of the build arg digests.
+	Salt string `yaml:"salt,omitempty"`
+	// BuildArgs are salted digests of the values of the build args or environment variables of the artifact, which can be secrets.
+	BuildArgs map[string]string `yaml:"buildArgs,omitempty"`
+	Platforms []string          `yaml:"platforms,omitempty"`
+	// Dependencies are the hashes of the required artifacts, by image name.
+	Dependencies map[string]string `yaml:"dependencies,omitempty"`
+
+	// buildArgs are the values of the build args of current inputs, which are never recorded.
+	buildArgs map[string]string
+}
+
+// seal returns the inputs to record in the cache file, with salted digests of the build arg values instead of the values.
+// The salt of the previously recorded inputs is kept when the build arg values didn't change, so that the cache file doesn't change either.
+func (in *Inputs) seal(imageName string, now time.Time, previous *Inputs) (*Inputs, error) {
+	sealed := *in
+	sealed.ImageName = imageName
+	sealed.Time = now
+	sealed.buildArgs = nil
+	if len(in.buildArgs) == 0 {
+		return &sealed, nil
+	}
+	if previous != nil && previous.Salt != "" && in.sameBuildArgs(previous) {
+		sealed.Salt = previous.Salt
+		sealed.BuildArgs = previous.BuildArgs
+		return &sealed, nil
+	}
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	sealed.Salt = hex.EncodeToString(salt)
+	sealed.BuildArgs = in.saltedBuildArgs(sealed.Salt)
+	return &sealed, nil
+}
+
+// sameBuildArgs tells whether the current build arg values are the ones whose salted digests were recorded.
+func (in *Inputs) sameBuildArgs(recorded *Inputs) bool {
+	digests := in.saltedBuildArgs(recorded.Salt)
+	if len(digests) != len(recorded.BuildArgs) {
+		return false
+	}
+	for k, v := range digests {
+		if recorded.BuildArgs[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// saltedBuildArgs returns the digests of the build arg values with a salt.
+func (in *Inputs) saltedBuildArgs(salt string) map[string]string {
+	if in.buildArgs == nil {
+		return nil
+	}
+	digests := map[string]string{}
+	for k, v := range in.buildArgs {
+		sum := sha256.Sum256([]byte(salt + v))
+		digests[k] = hex.EncodeToString(sum[:])
+	}
+	return digests
+}
+
+// lastInputs returns the inputs of the last cached build of an artifact, or nil.
+func lastInputs(artifactCache ArtifactCache, imageName string) *Inputs {
+	var last *Inputs
+	for hash, entry := range artifactCache {
+		if in := entry.Inputs; in != nil && in.ImageName == imageName && (last == nil || in.Time.After(last.Time)) {
+			last = &Inputs{}
+			*last = *in
+			last.Hash = hash
+		}
+	}
+	return last
+}
+
+// ExplainMiss lists the differences between the inputs of the last cached build of an artifact and its current inputs.
+func ExplainMiss(cached, current *Inputs) []string {
+	switch {
+	case current == nil:
+		return nil
+	case cached == nil:
+		return []string{"no previous build of this artifact was cached"}
+	case cached.Hash == current.Hash:
+		return []string{"inputs didn't change, but the cached image wasn't found"}
+	}
+
+	var reasons []string
+	if cached.Config != current.Config {
+		reasons = append(reasons, "artifact configuration changed")
+	}
+	reasons = append(reasons, limit(diffMaps("file", cached.Files, current.Files), maxExplainedFiles, "files")...)
+	reasons = append(reasons, diffMaps("build arg", cached.BuildArgs, current.saltedBuildArgs(cached.Salt))...)
+	if strings.Join(cached.Platforms, ",") != strings.Join(current.Platforms, ",") {
+		reasons = append(reasons, fmt.Sprintf("platforms changed from [%s] to [%s]", strings.Join(cached.Platforms, ", "), strings.Join(current.Platforms, ", ")))
+	}
+	reasons = append(reasons, diffMaps("required artifact", cached.Dependencies, current.Dependencies)...)
+	if len(reasons) == 0 {
+		reasons = append(reasons, "inputs changed")
+	}
+	return reasons
+}
+
+// diffMaps lists the keys that were added, removed or changed, in order.
+func diffMaps(kind string, cached, current map[string]string) []string {
+	keys := map[string]bool{}
+	for k := range cached {
+		keys[k] = true
+	}
+	for k := range current {
+		keys[k] = true
+	}
+	var sorted []string
+	for k := range keys {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	var diffs []string
+	for _, k := range sorted {
+		before, wasSet := cached[k]
+		after, isSet := current[k]
+		switch {
+		case !wasSet:
+			diffs = append(diffs, fmt.Sprintf("%s added: %s", kind, k))
+		case !isSet:
+			diffs = append(diffs, fmt.Sprintf("%s removed: %s", kind, k))
+		case before != after:
+			diffs = append(diffs, fmt.Sprintf("%s changed: %s", kind, k))
+		}
+	}
+	return diffs
+}
+
+func limit(diffs []string, n int, kind string) []string {
+	if len(diffs) <= n {
+		return diffs
+	}
+	return append(diffs[:n], fmt.Sprintf("... and %d more %s", len(diffs)-n, kind))
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestExplainMiss(t *testing.T) {
+	sealed := func(in *Inputs) *Inputs {
+		s, err := in.seal("app", time.Now(), nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	manyFiles := map[string]string{}
+	for i := 0; i < 12; i++ {
+		manyFiles[fmt.Sprintf("src/file%02d.go", i)] = "hash"
+	}
+	tests := []struct {
+		description string
+		cached      *Inputs
+		current     *Inputs
+		expected    []string
+	}{
+		{
+			description: "unknown inputs",
+			cached:      &Inputs{Hash: "old"},
+		},
+		{
+			description: "never cached",
+			current:     &Inputs{Hash: "new"},
+			expected:    []string{"no previous build of this artifact was cached"},
+		},
+		{
+			description: "same inputs",
+			cached:      &Inputs{Hash: "hash"},
+			current:     &Inputs{Hash: "hash"},
+			expected:    []string{"inputs didn't change, but the cached image wasn't found"},
+		},
+		{
+			description: "changed inputs",
+			cached: sealed(&Inputs{
+				Hash:         "old",
+				Config:       "config1",
+				Files:        map[string]string{"Dockerfile": "1", "main.go": "1", "old.go": "1"},
+				Platforms:    []string{"linux/amd64"},
+				Dependencies: map[string]string{"base": "1"},
+				buildArgs:    map[string]string{"VERSION": "1", "DEBUG": "1", "OLD": "1"},
+			}),
+			current: &Inputs{
+				Hash:         "new",
+				Config:       "config2",
+				Files:        map[string]string{"Dockerfile": "1", "main.go": "2", "new.go": "1"},
+				Platforms:    []string{"linux/amd64", "linux/arm64"},
+				Dependencies: map[string]string{"base": "2"},
+				buildArgs:    map[string]string{"VERSION": "2", "DEBUG": "1", "NEW": "1"},
+			},
+			expected: []string{
+				"artifact configuration changed",
+				"file changed: main.go",
+				"file added: new.go",
+				"file removed: old.go",
+				"build arg added: NEW",
+				"build arg removed: OLD",
+				"build arg changed: VERSION",
+				"platforms changed from [linux/amd64] to [linux/amd64, linux/arm64]",
+				"required artifact changed: base",
+			},
+		},
+		{
+			description: "many changed files",
+			cached:      &Inputs{Hash: "old"},
+			current:     &Inputs{Hash: "new", Files: manyFiles},
+			expected: []string{
+				"file added: src/file00.go", "file added: src/file01.go", "file added: src/file02.go", "file added: src/file03.go", "file added: src/file04.go",
+				"file added: src/file05.go", "file added: src/file06.go", "file added: src/file07.go", "file added: src/file08.go", "file added: src/file09.go",
+				"... and 2 more files",
+			},
+		},
+		{
+			description: "other inputs",
+			cached:      &Inputs{Hash: "old"},
+			current:     &Inputs{Hash: "new"},
+			expected:    []string{"inputs changed"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, ExplainMiss(test.cached, test.current))
+		})
+	}
+}
+
+func TestSealInputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		in := &Inputs{Hash: "hash", Config: "config", buildArgs: map[string]string{"TOKEN": "secret"}}
+
+		first, err := in.seal("app", time.Now(), nil)
+		t.CheckNoError(err)
+		second, err := in.seal("app", time.Now(), nil)
+		t.CheckNoError(err)
+
+		// the values of build args are never recorded, and their digests are salted
+		data, err := yaml.Marshal(first)
+		t.CheckNoError(err)
+		t.CheckFalse(strings.Contains(string(data), "secret"))
+		t.CheckFalse(first.Salt == second.Salt)
+		t.CheckFalse(first.BuildArgs["TOKEN"] == second.BuildArgs["TOKEN"])
+		t.CheckDeepEqual(first.BuildArgs, in.saltedBuildArgs(first.Salt))
+		t.CheckDeepEqual("app", first.ImageName)
+
+		// the salt is kept while the values don't change
+		resealed, err := in.seal("app", time.Now(), first)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(first.Salt, resealed.Salt)
+		t.CheckDeepEqual(first.BuildArgs, resealed.BuildArgs)
+
+		changed := &Inputs{Hash: "hash", Config: "config", buildArgs: map[string]string{"TOKEN": "other"}}
+		resealed, err = changed.seal("app", time.Now(), first)
+		t.CheckNoError(err)
+		t.CheckFalse(first.Salt == resealed.Salt)
+	})
+}
+
+func TestRecordInputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		c := &cache{
+			artifactCache: ArtifactCache{
+				"old":   {Digest: "sha256:1", Inputs: &Inputs{ImageName: "app", Config: "1", Files: map[string]string{"main.go": "1"}}},
+				"new":   {Digest: "sha256:2"},
+				"other": {Digest: "sha256:3", Inputs: &Inputs{ImageName: "other", Config: "3"}},
+			},
+			currentInputs: map[string]*Inputs{
+				"app": {Hash: "new", Config: "2", Files: map[string]string{"main.go": "2"}, buildArgs: map[string]string{"TOKEN": "secret"}},
+			},
+		}
+
+		c.recordInputs("app")
+
+		// only the last inputs of an artifact are kept
+		t.CheckDeepEqual((*Inputs)(nil), c.artifactCache["old"].Inputs)
+		t.CheckDeepEqual("2", c.artifactCache["new"].Inputs.Config)
+		t.CheckDeepEqual("3", c.artifactCache["other"].Inputs.Config)
+
+		// recording the same inputs again doesn't change the cache
+		recorded := *c.artifactCache["new"].Inputs
+		c.recordInputs("app")
+		t.CheckDeepEqual(recorded, *c.artifactCache["new"].Inputs, cmp.AllowUnexported(Inputs{}))
+	})
+}
+
+func TestLastInputs(t *testing.T) {
+	now := time.Now()
+	artifactCache := ArtifactCache{
+		"old":   {Digest: "sha256:1", Inputs: &Inputs{ImageName: "app", Time: now.Add(-time.Hour), Config: "1"}},
+		"last":  {Digest: "sha256:2", Inputs: &Inputs{ImageName: "app", Time: now, Config: "2"}},
+		"other": {Digest: "sha256:3", Inputs: &Inputs{ImageName: "other", Time: now.Add(time.Hour), Config: "3"}},
+		"none":  {Digest: "sha256:4"},
+	}
+
+	testutil.CheckDeepEqual(t, &Inputs{Hash: "last", ImageName: "app", Time: now, Config: "2"}, lastInputs(artifactCache, "app"), cmp.AllowUnexported(Inputs{}))
+	testutil.CheckDeepEqual(t, (*Inputs)(nil), lastInputs(artifactCache, "unknown"))
+}
@@ -67,10 +67,26 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, plat
 	if err != nil {
 		return failed{err: fmt.Errorf("getting hash for artifact %q: %s", a.ImageName, err)}
 	}
+	if in := h.inputs(a.ImageName); in != nil {
+		c.cacheMutex.Lock()
+		c.currentInputs[a.ImageName] = in
+		c.cacheMutex.Unlock()
+	}
 
 	c.cacheMutex.RLock()
 	entry, cacheHit := c.artifactCache[hash]
 	c.cacheMutex.RUnlock()
+	if cacheHit && entry.MachineImage != "" {
+		return found{hash: hash}
+	}
+
+	isLocal, err := c.isLocalImage(a.ImageName)
+	if err != nil {
+		return failed{err}
+	}
+	if !cacheHit && !isLocal {
+		entry, cacheHit = c.lookupShared(ctx, a.ImageName, hash)
+	}
 
 	pls := platforms.GetPlatforms(a.ImageName)
 	// TODO (gaghosh): allow `tryImport` when the Docker daemon starts supporting multiarch images
@@ -86,9 +102,7 @@ func (c *cache) lookup(ctx context.Context, a *latest.Artifact, tag string, plat
 		}
 	}
 
-	if isLocal, err := c.isLocalImage(a.ImageName); err != nil {
-		return failed{err}
-	} else if isLocal {
+	if isLocal {
 		return c.lookupLocal(ctx, hash, tag, entry)
 	}
 	return c.lookupRemote(ctx, hash, tag, pls.Platforms, entry)
@@ -127,11 +141,14 @@ func (c *cache) lookupRemote(ctx context.Context, hash, tag string, platforms []
 		}
 	}
 
-	// Image exists remotely with a different tag
+	// Image exists remotely with a different tag, or in the repository of a shared cache entry
 	fqn := tag + "@" + entry.Digest // Actual tag will be ignored but we need the registry and the digest part of it.
+	if entry.Image != "" {
+		fqn = entry.Image + "@" + entry.Digest
+	}
 	if remoteDigest, err := docker.RemoteDigest(fqn, c.cfg, nil); err == nil {
 		if remoteDigest == entry.Digest {
-			return needsRemoteTagging{hash: hash, tag: tag, digest: entry.Digest, platforms: platforms}
+			return needsRemoteTagging{hash: hash, tag: tag, digest: entry.Digest, source: entry.Image, platforms: platforms}
 		}
 	}
 
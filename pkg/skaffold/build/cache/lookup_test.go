@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"sync"
 	"testing"
 
 	"github.com/docker/docker/client"
@@ -228,6 +229,168 @@ func TestLookupRemote(t *testing.T) {
 	}
 }
 
+func TestLookupShared(t *testing.T) {
+	tests := []struct {
+		description      string
+		isLocal          bool
+		cache            ArtifactCache
+		backends         []*mockBackend
+		expected         cacheDetails
+		expectedCache    ArtifactCache
+		expectedBackends []map[string]ImageDetails
+	}{
+		{
+			description: "hit in another repository, earlier caches are filled in",
+			cache:       ArtifactCache{},
+			backends: []*mockBackend{
+				{entries: map[string]ImageDetails{}},
+				{entries: map[string]ImageDetails{"hash": {Digest: "shareddigest", Image: "registry/other"}}},
+			},
+			expected:      needsRemoteTagging{hash: "hash", tag: "tag", digest: "shareddigest", source: "registry/other"},
+			expectedCache: ArtifactCache{"hash": {Digest: "shareddigest", Image: "registry/other"}},
+			expectedBackends: []map[string]ImageDetails{
+				{"hash": {Digest: "shareddigest", Image: "registry/other"}},
+				{"hash": {Digest: "shareddigest", Image: "registry/other"}},
+			},
+		},
+		{
+			description: "hit with the same tag",
+			cache:       ArtifactCache{},
+			backends: []*mockBackend{
+				{entries: map[string]ImageDetails{"hash": {Digest: "digest", Image: "tag"}}},
+			},
+			expected:         found{hash: "hash"},
+			expectedCache:    ArtifactCache{"hash": {Digest: "digest", Image: "tag"}},
+			expectedBackends: []map[string]ImageDetails{{"hash": {Digest: "digest", Image: "tag"}}},
+		},
+		{
+			description: "failing caches are skipped",
+			cache:       ArtifactCache{},
+			backends: []*mockBackend{
+				{err: errors.New("unreachable")},
+				{entries: map[string]ImageDetails{"hash": {Digest: "shareddigest", Image: "registry/other"}}},
+			},
+			expected:         needsRemoteTagging{hash: "hash", tag: "tag", digest: "shareddigest", source: "registry/other"},
+			expectedCache:    ArtifactCache{"hash": {Digest: "shareddigest", Image: "registry/other"}},
+			expectedBackends: []map[string]ImageDetails{nil, {"hash": {Digest: "shareddigest", Image: "registry/other"}}},
+		},
+		{
+			description: "hit with a deleted image",
+			cache:       ArtifactCache{},
+			backends: []*mockBackend{
+				{entries: map[string]ImageDetails{"hash": {Digest: "deleteddigest", Image: "registry/other"}}},
+			},
+			expected:         needsBuilding{hash: "hash"},
+			expectedCache:    ArtifactCache{"hash": {Digest: "deleteddigest", Image: "registry/other"}},
+			expectedBackends: []map[string]ImageDetails{{"hash": {Digest: "deleteddigest", Image: "registry/other"}}},
+		},
+		{
+			description:      "miss",
+			cache:            ArtifactCache{},
+			backends:         []*mockBackend{{entries: map[string]ImageDetails{}}},
+			expected:         needsBuilding{hash: "hash"},
+			expectedCache:    ArtifactCache{},
+			expectedBackends: []map[string]ImageDetails{{}},
+		},
+		{
+			description:      "local cache takes precedence",
+			cache:            ArtifactCache{"hash": {Digest: "otherdigest"}},
+			backends:         []*mockBackend{{err: errors.New("shouldn't be called")}},
+			expected:         needsRemoteTagging{hash: "hash", tag: "tag", digest: "otherdigest"},
+			expectedCache:    ArtifactCache{"hash": {Digest: "otherdigest"}},
+			expectedBackends: []map[string]ImageDetails{nil},
+		},
+		{
+			description:      "local images don't use shared caches",
+			isLocal:          true,
+			cache:            ArtifactCache{},
+			backends:         []*mockBackend{{err: errors.New("shouldn't be called")}},
+			expected:         needsBuilding{hash: "hash"},
+			expectedCache:    ArtifactCache{},
+			expectedBackends: []map[string]ImageDetails{nil},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&docker.RemoteDigest, func(identifier string, _ docker.Config, _ []specs.Platform) (string, error) {
+				switch identifier {
+				case "tag":
+					return "digest", nil
+				case "tag@otherdigest":
+					return "otherdigest", nil
+				case "registry/other@shareddigest":
+					return "shareddigest", nil
+				default:
+					return "", errors.New("unknown remote tag")
+				}
+			})
+
+			var backends []Backend
+			for _, b := range test.backends {
+				backends = append(backends, b)
+			}
+			cache := &cache{
+				isLocalImage:       func(string) (bool, error) { return test.isLocal, nil },
+				importMissingImage: func(imageName string) (bool, error) { return false, nil },
+				artifactCache:      test.cache,
+				client:             fakeLocalDaemon(&testutil.FakeAPIClient{}),
+				cfg:                &mockConfig{mode: config.RunModes.Build},
+				backends:           backends,
+			}
+			t.Override(&newArtifactHasherFunc, func(_ graph.ArtifactGraph, _ DependencyLister, _ config.RunMode) artifactHasher {
+				return mockHasher{"hash"}
+			})
+			details := cache.lookupArtifacts(context.Background(), map[string]string{"artifact": "tag"}, platform.Resolver{}, []*latest.Artifact{{
+				ImageName: "artifact",
+			}})
+
+			if !reflect.DeepEqual(test.expected, details[0]) {
+				t.Errorf("Expected result different from actual result. Expected: \n%v, \nActual: \n%v", test.expected, details)
+			}
+			t.CheckDeepEqual(test.expectedCache, cache.artifactCache)
+			cache.waitForPublishing(context.Background())
+			for i, b := range test.backends {
+				t.CheckDeepEqual(test.expectedBackends[i], b.entries)
+			}
+		})
+	}
+}
+
+type mockBackend struct {
+	mu      sync.Mutex
+	entries map[string]ImageDetails
+	err     error
+	// block delays Put until it's closed.
+	block chan struct{}
+}
+
+func (m *mockBackend) Get(_ context.Context, hash string) (ImageDetails, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return ImageDetails{}, false, m.err
+	}
+	entry, found := m.entries[hash]
+	return entry, found, nil
+}
+
+func (m *mockBackend) Put(_ context.Context, hash string, entry ImageDetails) error {
+	if m.block != nil {
+		<-m.block
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.entries[hash] = entry
+	return nil
+}
+
+func (m *mockBackend) String() string {
+	return "mock"
+}
+
 type mockHasher struct {
 	val string
 }
@@ -236,6 +399,10 @@ func (m mockHasher) hash(context.Context, *latest.Artifact, platform.Resolver) (
 	return m.val, nil
 }
 
+func (m mockHasher) inputs(string) *Inputs {
+	return nil
+}
+
 type failingHasher struct {
 	err error
 }
@@ -244,6 +411,10 @@ func (f failingHasher) hash(context.Context, *latest.Artifact, platform.Resolver
 	return "", f.err
 }
 
+func (f failingHasher) inputs(string) *Inputs {
+	return nil
+}
+
 func fakeLocalDaemon(api client.CommonAPIClient) docker.LocalDaemon {
 	return docker.NewLocalDaemon(api, nil, false, nil)
 }
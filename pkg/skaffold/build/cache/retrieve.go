@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
@@ -77,6 +78,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		case needsBuilding:
 			eventV2.CacheCheckMiss(artifact.ImageName, platforms.GetPlatforms(artifact.ImageName).String())
 			output.Yellow.Fprintln(out, "Not found. Building")
+			c.explainMiss(ctx, out, artifact.ImageName)
 			c.hashByName[artifact.ImageName] = result.Hash()
 			needToBuild = append(needToBuild, artifact)
 			continue
@@ -113,11 +115,19 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 		}
 
 		// Image is already built
+		c.recordInputs(artifact.ImageName)
 		c.cacheMutex.RLock()
 		entry := c.artifactCache[result.Hash()]
 		c.cacheMutex.RUnlock()
 		tag := tags[artifact.ImageName]
 
+		if entry.MachineImage != "" {
+			result := packer.MachineImageResult(entry.MachineImage)
+			c.artifactStore.Record(artifact, result)
+			alreadyBuilt = append(alreadyBuilt, graph.NewArtifact(artifact, result))
+			continue
+		}
+
 		var uniqueTag string
 		isLocal, err := c.isLocalImage(artifact.ImageName)
 		if err != nil {
@@ -145,6 +155,7 @@ func (c *cache) Build(ctx context.Context, out io.Writer, tags tag.ImageTags, ar
 	log.Entry(ctx).Infoln("Cache check completed in", timeutil.Humanize(time.Since(start)))
 
 	defer func() {
+		c.waitForPublishing(ctx)
 		if err := saveArtifactCache(c.cacheFile, c.artifactCache); err != nil {
 			log.Entry(ctx).Warnf("error saving cache file; caching may not work as expected: %v", err)
 		}
@@ -181,7 +192,9 @@ func (c *cache) AddArtifact(ctx context.Context, a graph.Artifact) error {
 	if err != nil {
 		return err
 	}
-	if isLocal {
+	if a.MachineImage != "" {
+		entry.MachineImage = a.MachineImage
+	} else if isLocal {
 		imageID, err := c.client.ImageID(ctx, a.Tag)
 		if err != nil {
 			return err
@@ -196,10 +209,58 @@ func (c *cache) AddArtifact(ctx context.Context, a graph.Artifact) error {
 			return fmt.Errorf("parsing reference %q: %w", a.Tag, err)
 		}
 		entry.Digest = ref.Digest
+		c.publish(ctx, c.backends, c.hashByName[a.ImageName], ImageDetails{Digest: ref.Digest, Image: ref.BaseName})
 	}
 	c.cacheMutex.Lock()
 	c.artifactCache[c.hashByName[a.ImageName]] = entry
 	c.cacheMutex.Unlock()
+	c.recordInputs(a.ImageName)
 
 	return nil
 }
+
+// recordInputs records the current inputs of an artifact in the cache entry of their hash.
+// Only the last inputs of each artifact are kept, so that the cache file doesn't grow with every build.
+func (c *cache) recordInputs(imageName string) {
+	c.cacheMutex.Lock()
+	defer c.cacheMutex.Unlock()
+	in := c.currentInputs[imageName]
+	if in == nil {
+		return
+	}
+	entry, found := c.artifactCache[in.Hash]
+	if !found {
+		return
+	}
+	last := lastInputs(c.artifactCache, imageName)
+	if last != nil && last.Hash == in.Hash && in.sameBuildArgs(last) {
+		return
+	}
+	sealed, err := in.seal(imageName, time.Now(), last)
+	if err != nil {
+		log.Entry(context.TODO()).Debugf("Not recording the inputs of %s: %v", imageName, err)
+		return
+	}
+	for hash, e := range c.artifactCache {
+		if e.Inputs != nil && e.Inputs.ImageName == imageName {
+			e.Inputs = nil
+			c.artifactCache[hash] = e
+		}
+	}
+	entry.Inputs = sealed
+	c.artifactCache[in.Hash] = entry
+}
+
+// explainMiss prints why an artifact isn't found in the cache with `--explain-cache`, and logs it otherwise.
+func (c *cache) explainMiss(ctx context.Context, out io.Writer, imageName string) {
+	c.cacheMutex.RLock()
+	reasons := ExplainMiss(lastInputs(c.artifactCache, imageName), c.currentInputs[imageName])
+	c.cacheMutex.RUnlock()
+	for _, reason := range reasons {
+		if c.cfg.ExplainCache() {
+			output.Default.Fprintf(out, "   - %s\n", reason)
+		} else {
+			log.Entry(ctx).Debugf("Cache miss for %s: %s", imageName, reason)
+		}
+	}
+}
@@ -17,15 +17,19 @@ limitations under the License.
 package cache
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"io"
+	"os"
+	"strings"
 	"testing"
 
 	"github.com/docker/docker/api/types/registry"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
@@ -288,6 +292,60 @@ func TestCacheBuildRemote(t *testing.T) {
 	})
 }
 
+func TestCacheBuildMachineImage(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("template.pkr.hcl", "content").
+			Chdir()
+
+		tags := map[string]string{"ami": "ami:tag"}
+		artifacts := []*latest.Artifact{
+			{ImageName: "ami", ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{}}},
+		}
+		deps := depLister(map[string][]string{"ami": {"template.pkr.hcl"}})
+
+		t.Override(&docker.NewAPIClient, func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+		})
+		t.Override(&docker.RemoteDigest, func(string, docker.Config, []specs.Platform) (string, error) {
+			return "", errors.New("unknown remote tag")
+		})
+
+		cfg := &mockConfig{
+			pipeline:  latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}},
+			cacheFile: tmpDir.Path("cache"),
+		}
+		artifactCache, err := NewCache(context.Background(), cfg, func(string) (bool, error) { return false, nil }, deps, graph.ToArtifactGraph(artifacts), make(mockArtifactStore))
+		t.CheckNoError(err)
+
+		var built int
+		buildAMI := func(ctx context.Context, _ io.Writer, _ tag.ImageTags, artifacts []*latest.Artifact, _ platform.Resolver) ([]graph.Artifact, error) {
+			var res []graph.Artifact
+			for _, a := range artifacts {
+				built++
+				ga := graph.NewArtifact(a, packer.MachineImageResult("us-east-1:ami-0123456789abcdef0"))
+				if err := artifactCache.AddArtifact(ctx, ga); err != nil {
+					return nil, err
+				}
+				res = append(res, ga)
+			}
+			return res, nil
+		}
+
+		// First build: the machine image is built
+		bRes, err := artifactCache.Build(context.Background(), io.Discard, tags, artifacts, platform.Resolver{}, buildAMI)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, built)
+		t.CheckDeepEqual(graph.Artifact{ImageName: "ami", MachineImage: "us-east-1:ami-0123456789abcdef0"}, bRes[0])
+
+		// Second build: the machine image ID is read from cache
+		bRes, err = artifactCache.Build(context.Background(), io.Discard, tags, artifacts, platform.Resolver{}, buildAMI)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, built)
+		t.CheckDeepEqual(graph.Artifact{ImageName: "ami", MachineImage: "us-east-1:ami-0123456789abcdef0"}, bRes[0])
+	})
+}
+
 func TestCacheFindMissing(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		tmpDir := t.NewTempDir().
@@ -355,14 +413,87 @@ func TestCacheFindMissing(t *testing.T) {
 	})
 }
 
+func TestCacheExplainMiss(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().
+			Write("dep1", "content1").
+			Write("dep2", "content2").
+			Chdir()
+
+		tags := map[string]string{"artifact1": "artifact1:tag1"}
+		token := "secret"
+		artifacts := []*latest.Artifact{
+			{ImageName: "artifact1", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{
+				BuildArgs: map[string]*string{"TOKEN": &token},
+			}}},
+		}
+		deps := depLister(map[string][]string{"artifact1": {"dep1", "dep2"}})
+
+		t.Override(&docker.DefaultAuthHelper, stubAuth{})
+		dockerDaemon := fakeLocalDaemon(&testutil.FakeAPIClient{})
+		t.Override(&docker.NewAPIClient, func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+			return dockerDaemon, nil
+		})
+		t.Override(&docker.EvalBuildArgs, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string) (map[string]*string, error) {
+			return args, nil
+		})
+		t.Override(&docker.EvalBuildArgsWithEnv, func(_ config.RunMode, _ string, _ string, args map[string]*string, _ map[string]*string, _ map[string]string) (map[string]*string, error) {
+			return args, nil
+		})
+
+		cfg := &mockConfig{
+			pipeline:     latest.Pipeline{Build: latest.BuildConfig{BuildType: latest.BuildType{LocalBuild: &latest.LocalBuild{}}}},
+			cacheFile:    tmpDir.Path("cache"),
+			explainCache: true,
+		}
+		build := func() string {
+			// A new cache reads the inputs recorded by the previous runs.
+			store := make(mockArtifactStore)
+			artifactCache, err := NewCache(context.Background(), cfg, func(imageName string) (bool, error) { return true, nil }, deps, graph.ToArtifactGraph(artifacts), store)
+			t.CheckNoError(err)
+			var out bytes.Buffer
+			builder := &mockBuilder{dockerDaemon: dockerDaemon, push: false, store: store, cache: artifactCache}
+			_, err = artifactCache.Build(context.Background(), &out, tags, artifacts, platform.Resolver{}, builder.Build)
+			t.CheckNoError(err)
+			return out.String()
+		}
+
+		t.CheckContains("Not found. Building\n   - no previous build of this artifact was cached\n", build())
+		t.CheckContains("Found Locally", build())
+
+		tmpDir.Write("dep2", "new content")
+		t.CheckContains("Not found. Building\n   - file changed: dep2\n", build())
+
+		// the inputs are recorded by hash in the cache file, without the values of build args
+		token = "other"
+		t.CheckContains("   - build arg changed: TOKEN\n", build())
+		contents, err := os.ReadFile(tmpDir.Path("cache"))
+		t.CheckNoError(err)
+		t.CheckFalse(strings.Contains(string(contents), "secret") || strings.Contains(string(contents), "other"))
+		artifactCache, err := retrieveArtifactCache(tmpDir.Path("cache"))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(3, len(artifactCache))
+		// only the inputs of the last build are kept
+		var recorded []string
+		for _, entry := range artifactCache {
+			if entry.Inputs != nil {
+				recorded = append(recorded, entry.Inputs.ImageName)
+			}
+		}
+		t.CheckDeepEqual([]string{"artifact1"}, recorded)
+	})
+}
+
 type mockConfig struct {
 	runcontext.RunContext // Embedded to provide the default values.
 	cacheFile             string
+	explainCache          bool
 	mode                  config.RunMode
 	pipeline              latest.Pipeline
 }
 
 func (c *mockConfig) CacheArtifacts() bool                            { return true }
 func (c *mockConfig) CacheFile() string                               { return c.cacheFile }
+func (c *mockConfig) ExplainCache() bool                              { return c.explainCache }
 func (c *mockConfig) Mode() config.RunMode                            { return c.mode }
 func (c *mockConfig) PipelineForImage(string) (latest.Pipeline, bool) { return c.pipeline, true }
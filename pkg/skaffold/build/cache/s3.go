@@ -0,0 +1,107 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// s3Store stores objects in a bucket of Amazon S3, or of an S3-compatible store like MinIO.
+// The region and endpoint are read from the `region` and `endpoint` query parameters of the cache URI,
+// or from the AWS environment and config files, with the `AWS_ENDPOINT_URL_S3` and `AWS_ENDPOINT_URL` variables.
+type s3Store struct {
+	bucket string
+	prefix string
+	client *s3.Client
+}
+
+func newS3Store(ctx context.Context, u *url.URL) (*s3Store, error) {
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing bucket")
+	}
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading AWS config: %w", err)
+	}
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		if region := u.Query().Get("region"); region != "" {
+			o.Region = region
+		}
+		if o.Region == "" {
+			o.Region = "us-east-1"
+		}
+		if endpoint := u.Query().Get("endpoint"); endpoint != "" {
+			o.BaseEndpoint = aws.String(endpoint)
+		}
+		// Custom endpoints use path-style addressing, which S3-compatible stores support without wildcard DNS.
+		o.UsePathStyle = o.BaseEndpoint != nil
+	})
+	return &s3Store{
+		bucket: u.Host,
+		prefix: strings.Trim(u.Path, "/"),
+		client: client,
+	}, nil
+}
+
+func (s *s3Store) key(name string) string {
+	return path.Join(s.prefix, name)
+}
+
+func (s *s3Store) get(ctx context.Context, name string) ([]byte, bool, error) {
+	out, err := s.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+	})
+	if err != nil {
+		var respErr *awshttp.ResponseError
+		if errors.As(err, &respErr) && respErr.HTTPStatusCode() == http.StatusNotFound {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("getting s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+	defer out.Body.Close()
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, false, fmt.Errorf("reading s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+	return data, true, nil
+}
+
+func (s *s3Store) put(ctx context.Context, name string, data []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(s.key(name)),
+		Body:   bytes.NewReader(data),
+	})
+	if err != nil {
+		return fmt.Errorf("putting s3://%s/%s: %w", s.bucket, s.key(name), err)
+	}
+	return nil
+}
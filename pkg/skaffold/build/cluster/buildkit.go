@@ -0,0 +1,377 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes/client"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+const (
+	buildkitContainer          = "buildkit"
+	buildkitInitContainer      = "buildkit-init-container"
+	buildkitEmptyDirName       = "buildkit-emptydir"
+	buildkitEmptyDirMountPath  = "/workspace"
+	buildkitSecretsName        = "buildkit-secrets"
+	buildkitSecretsMountPath   = "/run/buildkit-secrets"
+	buildkitStateName          = "buildkit-state"
+	buildkitStateMountPath     = "/home/user/.local/share/buildkit"
+	buildkitDockerConfigSecret = "buildkit-docker-config"
+	buildkitDockerConfigPath   = "/buildkit/.docker"
+	buildkitMetadataFile       = "/tmp/metadata.json"
+
+	// rootless BuildKit runs as this user in the official images
+	buildkitRootlessUser = int64(1000)
+)
+
+// buildWithBuildKit builds a docker artifact with BuildKit in a pod, either with its own rootless buildkitd or with an existing buildkitd service.
+// BuildKit pushes the image for all the target platforms, and the digest is read from the pod's termination message.
+func (b *Builder) buildWithBuildKit(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, requiredImages map[string]*string, platforms platform.Matcher) (string, error) {
+	output.Default.Fprintf(out, "Start building with buildkit for artifact\n")
+
+	start := time.Now()
+	defer func() {
+		log.Entry(ctx).Infof("Building with buildkit completed in %s", time.Since(start))
+	}()
+
+	if a.DockerArtifact.SSH != "" {
+		log.Entry(ctx).Warnf("ssh forwarding isn't supported by the buildkit builder in a cluster, ignoring `ssh` for artifact %q", a.ImageName)
+	}
+	if b.ClusterDetails.PullSecretName != "" {
+		// buildctl only reads registry credentials from a docker config, not from a service account key.
+		log.Entry(ctx).Warnf("`pullSecretName` only applies to kaniko builds, ignoring it for artifact %q: use `dockerConfig` for the registry credentials of buildkit", a.ImageName)
+	}
+
+	buildArgs, err := docker.EvalBuildArgsWithEnv(b.cfg.Mode(), a.Workspace, a.DockerArtifact.DockerfilePath, a.DockerArtifact.BuildArgs, requiredImages, nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+
+	secrets, err := buildkitSecrets(a.DockerArtifact.Secrets)
+	if err != nil {
+		return "", err
+	}
+
+	client, err := kubernetesclient.DefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	pods := client.CoreV1().Pods(b.Namespace)
+
+	pod, err := pods.Create(ctx, b.buildkitPodSpec(a.DockerArtifact, buildArgs, tag, platforms), metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("creating buildkit pod: %w", err)
+	}
+
+	defer func() {
+		// if build interrupted the original context is cancelled
+		// and pod deletion will not be called, so we need a new ctx
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
+			GracePeriodSeconds: new(int64),
+		}); err != nil {
+			log.Entry(ctx).Errorf("deleting pod: %s", err)
+		}
+	}()
+
+	if err := kubernetes.WaitForPodInitialized(ctx, pods, pod.Name); err != nil {
+		return "", fmt.Errorf("waiting for pod to initialize: %w", err)
+	}
+	if err := b.copyBuildKitContext(ctx, a, buildArgs, secrets, pod.Name); err != nil {
+		return "", fmt.Errorf("copying sources: %w", err)
+	}
+
+	// Wait for the pods to succeed while streaming the logs
+	waitForLogs := streamLogs(ctx, out, pod.Name, buildkitContainer, pods)
+
+	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
+		waitForLogs()
+		return "", err
+	}
+
+	waitForLogs()
+	if digest := getDigestFromContainerLogs(ctx, pods, pod.Name); digest != "" {
+		log.Entry(ctx).Debugf("retrieved image digest %q from buildkit container status message", digest)
+		return digest, nil
+	}
+	log.Entry(ctx).Debug("cannot get image digest from buildkit container status message. Checking directly against the image registry")
+	return docker.RemoteDigest(tag, b.cfg, nil)
+}
+
+// copyBuildKitContext sends the build context and the build secrets to the pod and completes its init container.
+func (b *Builder) copyBuildKitContext(ctx context.Context, a *latest.Artifact, buildArgs map[string]*string, secrets []byte, podName string) error {
+	buildCtx := &bytes.Buffer{}
+	gw := gzip.NewWriter(buildCtx)
+	if err := docker.CreateDockerTarContext(ctx, gw, docker.NewBuildConfig(a.Workspace, a.ImageName, a.DockerArtifact.DockerfilePath, buildArgs), b.cfg); err != nil {
+		return fmt.Errorf("creating build context: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("creating build context: %w", err)
+	}
+
+	var cmdOut bytes.Buffer
+	if err := b.kubectlcli.Run(ctx, buildCtx, &cmdOut, "exec", "-i", podName, "-c", buildkitInitContainer, "-n", b.Namespace, "--", "tar", "-zxf", "-", "-C", buildkitEmptyDirMountPath); err != nil {
+		return fmt.Errorf("uploading build context: %s", cmdOut.String())
+	}
+
+	if len(secrets) > 0 {
+		cmdOut.Reset()
+		if err := b.kubectlcli.Run(ctx, bytes.NewReader(secrets), &cmdOut, "exec", "-i", podName, "-c", buildkitInitContainer, "-n", b.Namespace, "--", "tar", "-xf", "-", "-C", buildkitSecretsMountPath); err != nil {
+			return fmt.Errorf("uploading build secrets: %s", cmdOut.String())
+		}
+	}
+
+	// Generate a file to successfully terminate the init container.
+	if out, err := b.kubectlcli.RunOut(ctx, "exec", podName, "-c", buildkitInitContainer, "-n", b.Namespace, "--", "touch", "/tmp/complete"); err != nil {
+		return fmt.Errorf("finishing upload of the build context: %s", out)
+	}
+	return nil
+}
+
+// buildkitSecrets returns a tarball with a file for each build secret, named after its id.
+// The secrets are read on the host, from a file or an environment variable, and sent to a memory volume of the pod.
+func buildkitSecrets(secrets []*latest.DockerSecret) ([]byte, error) {
+	if len(secrets) == 0 {
+		return nil, nil
+	}
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, secret := range secrets {
+		var value []byte
+		switch {
+		case secret.Source != "":
+			content, err := os.ReadFile(util.ExpandHomePath(secret.Source))
+			if err != nil {
+				return nil, fmt.Errorf("reading secret %q: %w", secret.ID, err)
+			}
+			value = content
+		case secret.Env != "":
+			v, found := os.LookupEnv(secret.Env)
+			if !found {
+				return nil, fmt.Errorf("reading secret %q: environment variable %q isn't set", secret.ID, secret.Env)
+			}
+			value = []byte(v)
+		}
+
+		if err := tw.WriteHeader(&tar.Header{Name: secret.ID, Mode: 0400, Size: int64(len(value)), ModTime: time.Now()}); err != nil {
+			return nil, err
+		}
+		if _, err := tw.Write(value); err != nil {
+			return nil, err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// buildctlArgs returns the arguments of `buildctl build` for a docker artifact whose build context is in the pod's workspace.
+func (b *Builder) buildctlArgs(a *latest.DockerArtifact, buildArgs map[string]*string, tag string, platforms platform.Matcher) []string {
+	dockerfile := filepath.ToSlash(a.DockerfilePath)
+	args := []string{
+		"build",
+		"--frontend", "dockerfile.v0",
+		"--local", "context=" + buildkitEmptyDirMountPath,
+		"--local", "dockerfile=" + path.Join(buildkitEmptyDirMountPath, path.Dir(dockerfile)),
+		"--opt", "filename=" + path.Base(dockerfile),
+	}
+
+	if a.Target != "" {
+		args = append(args, "--opt", "target="+a.Target)
+	}
+
+	var keys []string
+	for k := range buildArgs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if v := buildArgs[k]; v != nil {
+			args = append(args, "--opt", fmt.Sprintf("build-arg:%s=%s", k, *v))
+		}
+	}
+
+	if len(platforms.Platforms) > 0 {
+		args = append(args, "--opt", "platform="+platforms.String())
+	}
+
+	if a.NoCache {
+		args = append(args, "--no-cache")
+	}
+
+	for _, image := range a.CacheFrom {
+		args = append(args, "--import-cache", "type=registry,ref="+image)
+	}
+	for _, cache := range b.BuildKit.ImportCache {
+		args = append(args, "--import-cache", cache)
+	}
+	for _, cache := range b.BuildKit.ExportCache {
+		args = append(args, "--export-cache", cache)
+	}
+
+	for _, secret := range a.Secrets {
+		args = append(args, "--secret", fmt.Sprintf("id=%s,src=%s", secret.ID, path.Join(buildkitSecretsMountPath, secret.ID)))
+	}
+
+	args = append(args,
+		"--output", fmt.Sprintf("type=image,name=%s,push=true", tag),
+		"--metadata-file", buildkitMetadataFile)
+
+	if b.BuildKit.Address != "" {
+		return append([]string{"buildctl", "--addr", b.BuildKit.Address}, args...)
+	}
+	return append([]string{"buildctl-daemonless.sh"}, args...)
+}
+
+// buildkitPodSpec returns the pod that runs `buildctl` on the build context that's copied to its init container.
+// Without the address of a buildkitd service, the pod runs a rootless buildkitd next to `buildctl`.
+func (b *Builder) buildkitPodSpec(a *latest.DockerArtifact, buildArgs map[string]*string, tag string, platforms platform.Matcher) *v1.Pod {
+	vm := v1.VolumeMount{
+		Name:      buildkitEmptyDirName,
+		MountPath: buildkitEmptyDirMountPath,
+	}
+	secrets := v1.VolumeMount{
+		Name:      buildkitSecretsName,
+		MountPath: buildkitSecretsMountPath,
+	}
+
+	args := b.buildctlArgs(a, buildArgs, tag, platforms)
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations:  b.ClusterDetails.Annotations,
+			GenerateName: "buildkit-",
+			Labels:       map[string]string{"skaffold-buildkit": "skaffold-buildkit"},
+			Namespace:    b.ClusterDetails.Namespace,
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            buildkitInitContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    []v1.VolumeMount{vm, secrets},
+				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			Containers: []v1.Container{{
+				Name:            buildkitContainer,
+				Image:           b.BuildKit.Image,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				// The command is `$0` and its arguments are `$@`, so that they don't need to be quoted.
+				// The digest of the pushed image is written to the termination message.
+				Command:      []string{"sh", "-c", fmt.Sprintf(`"$0" "$@" && sed -n 's/.*"containerimage.digest": *"\([^"]*\)".*/\1/p' %s > /dev/termination-log`, buildkitMetadataFile)},
+				Args:         args,
+				Env:          b.buildkitEnv(),
+				VolumeMounts: []v1.VolumeMount{vm, secrets},
+				Resources:    resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			RestartPolicy: v1.RestartPolicyNever,
+			Volumes: []v1.Volume{{
+				Name: vm.Name,
+				VolumeSource: v1.VolumeSource{
+					EmptyDir: &v1.EmptyDirVolumeSource{},
+				},
+			}, {
+				Name: secrets.Name,
+				VolumeSource: v1.VolumeSource{
+					EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory},
+				},
+			}},
+		},
+	}
+
+	if b.BuildKit.Address == "" {
+		// rootless buildkitd needs unconfined seccomp and AppArmor profiles, and a writable state directory.
+		// See https://github.com/moby/buildkit/blob/master/docs/rootless.md
+		annotations := map[string]string{"container.apparmor.security.beta.kubernetes.io/" + buildkitContainer: "unconfined"}
+		for k, v := range b.ClusterDetails.Annotations {
+			annotations[k] = v
+		}
+		pod.ObjectMeta.Annotations = annotations
+		pod.Spec.Containers[0].SecurityContext = &v1.SecurityContext{
+			RunAsUser:      util.Ptr(buildkitRootlessUser),
+			RunAsGroup:     util.Ptr(buildkitRootlessUser),
+			SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeUnconfined},
+		}
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, v1.VolumeMount{
+			Name:      buildkitStateName,
+			MountPath: buildkitStateMountPath,
+		})
+		pod.Spec.Volumes = append(pod.Spec.Volumes, v1.Volume{
+			Name: buildkitStateName,
+			VolumeSource: v1.VolumeSource{
+				EmptyDir: &v1.EmptyDirVolumeSource{},
+			},
+		})
+	}
+
+	if b.ClusterDetails.DockerConfig != nil {
+		// Add secret for docker config if specified
+		addSecretVolume(pod, buildkitDockerConfigSecret, buildkitDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
+	}
+
+	// BuildKit builds for other platforms itself, so the pod is only scheduled on a matching node for single platform builds.
+	b.addClusterDetails(pod, platforms)
+
+	// Add used-defines Volumes
+	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
+
+	return pod
+}
+
+// buildkitEnv returns the environment of the buildkit container: the proxies, the credentials and the rootless buildkitd flags.
+func (b *Builder) buildkitEnv() []v1.EnvVar {
+	var env []v1.EnvVar
+	if b.BuildKit.Address == "" {
+		env = append(env, v1.EnvVar{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"})
+	}
+	if b.ClusterDetails.HTTPProxy != "" {
+		env = append(env, v1.EnvVar{Name: "HTTP_PROXY", Value: b.ClusterDetails.HTTPProxy})
+	}
+	if b.ClusterDetails.HTTPSProxy != "" {
+		env = append(env, v1.EnvVar{Name: "HTTPS_PROXY", Value: b.ClusterDetails.HTTPSProxy})
+	}
+	if b.ClusterDetails.DockerConfig != nil {
+		env = append(env, v1.EnvVar{Name: "DOCKER_CONFIG", Value: buildkitDockerConfigPath})
+	}
+	return env
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"testing"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestBuildctlArgs(t *testing.T) {
+	tests := []struct {
+		description string
+		buildKit    *latest.BuildKitDetails
+		artifact    *latest.DockerArtifact
+		buildArgs   map[string]*string
+		platforms   platform.Matcher
+		expected    []string
+	}{
+		{
+			description: "rootless buildkitd",
+			buildKit:    &latest.BuildKitDetails{},
+			artifact:    &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+			expected: []string{"buildctl-daemonless.sh", "build", "--frontend", "dockerfile.v0",
+				"--local", "context=/workspace", "--local", "dockerfile=/workspace", "--opt", "filename=Dockerfile",
+				"--output", "type=image,name=img:tag,push=true", "--metadata-file", "/tmp/metadata.json"},
+		},
+		{
+			description: "existing buildkitd with caches, secrets and multiple platforms",
+			buildKit: &latest.BuildKitDetails{
+				Address:     "tcp://buildkitd:1234",
+				ImportCache: []string{"type=registry,ref=registry/cache"},
+				ExportCache: []string{"type=registry,ref=registry/cache,mode=max"},
+			},
+			artifact: &latest.DockerArtifact{
+				DockerfilePath: "docker/prod.Dockerfile",
+				Target:         "release",
+				CacheFrom:      []string{"registry/img:latest"},
+				NoCache:        true,
+				Secrets:        []*latest.DockerSecret{{ID: "npmrc", Source: "~/.npmrc"}},
+			},
+			buildArgs: map[string]*string{"VERSION": util.Ptr("1.0"), "EMPTY": nil, "BASE": util.Ptr("base:tag")},
+			platforms: platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}},
+			expected: []string{"buildctl", "--addr", "tcp://buildkitd:1234", "build", "--frontend", "dockerfile.v0",
+				"--local", "context=/workspace", "--local", "dockerfile=/workspace/docker", "--opt", "filename=prod.Dockerfile",
+				"--opt", "target=release",
+				"--opt", "build-arg:BASE=base:tag", "--opt", "build-arg:VERSION=1.0",
+				"--opt", "platform=linux/amd64,linux/arm64",
+				"--no-cache",
+				"--import-cache", "type=registry,ref=registry/img:latest",
+				"--import-cache", "type=registry,ref=registry/cache",
+				"--export-cache", "type=registry,ref=registry/cache,mode=max",
+				"--secret", "id=npmrc,src=/run/buildkit-secrets/npmrc",
+				"--output", "type=image,name=img:tag,push=true", "--metadata-file", "/tmp/metadata.json"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			builder := &Builder{ClusterDetails: &latest.ClusterDetails{BuildKit: test.buildKit}}
+
+			args := builder.buildctlArgs(test.artifact, test.buildArgs, "img:tag", test.platforms)
+
+			t.CheckDeepEqual(test.expected, args)
+		})
+	}
+}
+
+func TestBuildKitPodSpec(t *testing.T) {
+	builder := &Builder{
+		cfg: &mockBuilderContext{},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:          "ns",
+			HTTPSProxy:         "https://proxy",
+			ServiceAccountName: "sa",
+			DockerConfig:       &latest.DockerConfig{SecretName: "docker-cfg"},
+			BuildKit:           &latest.BuildKitDetails{Image: "moby/buildkit:rootless"},
+		},
+	}
+	platforms := platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "arm64"}}}
+
+	pod := builder.buildkitPodSpec(&latest.DockerArtifact{DockerfilePath: "Dockerfile"}, nil, "img:tag", platforms)
+
+	vm := v1.VolumeMount{Name: buildkitEmptyDirName, MountPath: buildkitEmptyDirMountPath}
+	secrets := v1.VolumeMount{Name: buildkitSecretsName, MountPath: buildkitSecretsMountPath}
+	expected := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations:  map[string]string{"container.apparmor.security.beta.kubernetes.io/buildkit": "unconfined"},
+			GenerateName: "buildkit-",
+			Labels:       map[string]string{"skaffold-buildkit": "skaffold-buildkit"},
+			Namespace:    "ns",
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            buildkitInitContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    []v1.VolumeMount{vm, secrets},
+			}},
+			Containers: []v1.Container{{
+				Name:            buildkitContainer,
+				Image:           "moby/buildkit:rootless",
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", `"$0" "$@" && sed -n 's/.*"containerimage.digest": *"\([^"]*\)".*/\1/p' /tmp/metadata.json > /dev/termination-log`},
+				Args: []string{"buildctl-daemonless.sh", "build", "--frontend", "dockerfile.v0",
+					"--local", "context=/workspace", "--local", "dockerfile=/workspace", "--opt", "filename=Dockerfile",
+					"--opt", "platform=linux/arm64",
+					"--output", "type=image,name=img:tag,push=true", "--metadata-file", "/tmp/metadata.json"},
+				Env: []v1.EnvVar{
+					{Name: "BUILDKITD_FLAGS", Value: "--oci-worker-no-process-sandbox"},
+					{Name: "HTTPS_PROXY", Value: "https://proxy"},
+					{Name: "DOCKER_CONFIG", Value: "/buildkit/.docker"},
+				},
+				VolumeMounts: []v1.VolumeMount{vm, secrets,
+					{Name: buildkitStateName, MountPath: buildkitStateMountPath},
+					{Name: buildkitDockerConfigSecret, MountPath: "/buildkit/.docker"},
+				},
+				SecurityContext: &v1.SecurityContext{
+					RunAsUser:      util.Ptr(int64(1000)),
+					RunAsGroup:     util.Ptr(int64(1000)),
+					SeccompProfile: &v1.SeccompProfile{Type: v1.SeccompProfileTypeUnconfined},
+				},
+			}},
+			RestartPolicy:      v1.RestartPolicyNever,
+			ServiceAccountName: "sa",
+			NodeSelector:       map[string]string{nodeArchitectureLabel: "arm64", nodeOperatingSystemLabel: "linux"},
+			Volumes: []v1.Volume{{
+				Name:         buildkitEmptyDirName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			}, {
+				Name:         buildkitSecretsName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{Medium: v1.StorageMediumMemory}},
+			}, {
+				Name:         buildkitStateName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			}, {
+				Name:         buildkitDockerConfigSecret,
+				VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "docker-cfg"}},
+			}},
+		},
+	}
+
+	testutil.CheckDeepEqual(t, expected, pod)
+}
+
+func TestBuildKitPodSpecWithAddress(t *testing.T) {
+	builder := &Builder{
+		cfg: &mockBuilderContext{},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace: "ns",
+			BuildKit:  &latest.BuildKitDetails{Address: "tcp://buildkitd:1234", Image: "moby/buildkit"},
+		},
+	}
+
+	pod := builder.buildkitPodSpec(&latest.DockerArtifact{DockerfilePath: "Dockerfile"}, nil, "img:tag", platform.Matcher{})
+
+	testutil.CheckDeepEqual(t, map[string]string(nil), pod.Annotations)
+	testutil.CheckDeepEqual(t, (*v1.SecurityContext)(nil), pod.Spec.Containers[0].SecurityContext)
+	testutil.CheckDeepEqual(t, []v1.EnvVar(nil), pod.Spec.Containers[0].Env)
+	testutil.CheckDeepEqual(t, "buildctl", pod.Spec.Containers[0].Args[0])
+	testutil.CheckDeepEqual(t, 2, len(pod.Spec.Volumes))
+}
+
+func TestBuildKitPodSpecIgnoresPullSecret(t *testing.T) {
+	builder := &Builder{
+		cfg: &mockBuilderContext{},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:           "ns",
+			PullSecretName:      "kaniko-secret",
+			PullSecretPath:      "kaniko-secret",
+			PullSecretMountPath: "/secret",
+			BuildKit:            &latest.BuildKitDetails{Address: "tcp://buildkitd:1234", Image: "moby/buildkit"},
+		},
+	}
+
+	pod := builder.buildkitPodSpec(&latest.DockerArtifact{DockerfilePath: "Dockerfile"}, nil, "img:tag", platform.Matcher{})
+
+	testutil.CheckDeepEqual(t, []v1.EnvVar(nil), pod.Spec.Containers[0].Env)
+	testutil.CheckDeepEqual(t, 2, len(pod.Spec.Volumes))
+	testutil.CheckDeepEqual(t, 2, len(pod.Spec.Containers[0].VolumeMounts))
+}
+
+func TestBuildKitSecrets(t *testing.T) {
+	tests := []struct {
+		description string
+		secrets     []*latest.DockerSecret
+		expected    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "no secrets",
+		},
+		{
+			description: "secrets from a file and an environment variable",
+			secrets:     []*latest.DockerSecret{{ID: "npmrc", Source: "npmrc"}, {ID: "token", Env: "TOKEN"}},
+			expected:    map[string]string{"npmrc": "//registry/:_authToken=abc", "token": "secret"},
+		},
+		{
+			description: "missing environment variable",
+			secrets:     []*latest.DockerSecret{{ID: "missing", Env: "MISSING_TOKEN"}},
+			shouldErr:   true,
+		},
+		{
+			description: "missing file",
+			secrets:     []*latest.DockerSecret{{ID: "missing", Source: "missing"}},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Write("npmrc", "//registry/:_authToken=abc").Chdir()
+			t.SetEnvs(map[string]string{"TOKEN": "secret"})
+
+			content, err := buildkitSecrets(test.secrets)
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				return
+			}
+
+			var files map[string]string
+			tr := tar.NewReader(bytes.NewReader(content))
+			for {
+				hdr, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				t.CheckNoError(err)
+				b, err := io.ReadAll(tr)
+				t.CheckNoError(err)
+				if files == nil {
+					files = map[string]string{}
+				}
+				files[hdr.Name] = string(b)
+			}
+			t.CheckDeepEqual(test.expected, files)
+		})
+	}
+}
@@ -64,7 +64,7 @@ func (b *Builder) PostBuild(_ context.Context, _ io.Writer) error {
 
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string, m platform.Matcher) (string, error) {
 	// TODO: Implement building multiplatform images for cluster builder
-	if m.IsMultiPlatform() {
+	if m.IsMultiPlatform() && !b.SupportsMultiPlatformBuild(artifact) {
 		log.Entry(ctx).Println("skaffold doesn't yet support multi platform builds for the cluster builder")
 	}
 
@@ -73,9 +73,19 @@ func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, artifact *la
 		return "", err
 	}
 
+	// Packer templates don't always produce images, so the Packer builder returns the reference to what it built
+	if artifact.PackerArtifact != nil {
+		return digest, nil
+	}
+
 	return build.TagWithDigest(tag, digest), nil
 }
 
+// SupportsMultiPlatformBuild returns true for docker artifacts, which BuildKit builds for all the target platforms at once.
+func (b *Builder) SupportsMultiPlatformBuild(a *latest.Artifact) bool {
+	return a.DockerArtifact != nil && b.BuildKit != nil
+}
+
 func (b *Builder) Concurrency() *int {
 	return util.Ptr(b.ClusterDetails.Concurrency)
 }
@@ -87,6 +97,12 @@ func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *lat
 	case a.KanikoArtifact != nil:
 		return b.buildWithKaniko(ctx, out, a.Workspace, a.ImageName, a.KanikoArtifact, tag, requiredImages, platforms)
 
+	case a.PackerArtifact != nil:
+		return b.buildWithPacker(ctx, out, a, tag, platforms)
+
+	case a.DockerArtifact != nil && b.BuildKit != nil:
+		return b.buildWithBuildKit(ctx, out, a, tag, requiredImages, platforms)
+
 	case a.CustomArtifact != nil:
 		return custom.NewArtifactBuilder(nil, b.cfg, true, b.skipTests, append(b.retrieveExtraEnv(), util.EnvPtrMapToSlice(requiredImages, "=")...)).Build(ctx, out, a, tag, platforms)
 
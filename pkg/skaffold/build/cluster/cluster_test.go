@@ -52,3 +52,15 @@ func TestRetrieveEnvMinimal(t *testing.T) {
 	expected := []string{"KUBE_CONTEXT=", "NAMESPACE=", "PULL_SECRET_NAME=", "TIMEOUT=20m"}
 	testutil.CheckDeepEqual(t, expected, actual)
 }
+
+func TestSupportsMultiPlatformBuild(t *testing.T) {
+	docker := &latest.Artifact{ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}
+	kaniko := &latest.Artifact{ArtifactType: latest.ArtifactType{KanikoArtifact: &latest.KanikoArtifact{}}}
+
+	withBuildKit := &Builder{ClusterDetails: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}}}
+	withKaniko := &Builder{ClusterDetails: &latest.ClusterDetails{}}
+
+	testutil.CheckDeepEqual(t, true, withBuildKit.SupportsMultiPlatformBuild(docker))
+	testutil.CheckDeepEqual(t, false, withBuildKit.SupportsMultiPlatformBuild(kaniko))
+	testutil.CheckDeepEqual(t, false, withKaniko.SupportsMultiPlatformBuild(docker))
+}
@@ -111,7 +111,7 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 	}
 
 	// Wait for the pods to succeed while streaming the logs
-	waitForLogs := streamLogs(ctx, out, pod.Name, pods)
+	waitForLogs := streamLogs(ctx, out, pod.Name, kaniko.DefaultContainerName, pods)
 
 	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
 		waitForLogs()
@@ -28,11 +28,10 @@ import (
 	v1 "k8s.io/api/core/v1"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
-	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/kaniko"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 )
 
-func streamLogs(ctx context.Context, out io.Writer, name string, pods corev1.PodInterface) func() {
+func streamLogs(ctx context.Context, out io.Writer, name string, container string, pods corev1.PodInterface) func() {
 	var wg sync.WaitGroup
 	wg.Add(1)
 
@@ -44,10 +43,10 @@ func streamLogs(ctx context.Context, out io.Writer, name string, pods corev1.Pod
 		for atomic.LoadInt32(&retry) == 1 {
 			r, err := pods.GetLogs(name, &v1.PodLogOptions{
 				Follow:    true,
-				Container: kaniko.DefaultContainerName,
+				Container: container,
 			}).Stream(ctx)
 			if err != nil {
-				log.Entry(ctx).Debug("unable to get kaniko pod logs:", err)
+				log.Entry(ctx).Debugf("unable to get %s pod logs: %v", container, err)
 				time.Sleep(1 * time.Second)
 				continue
 			}
@@ -76,7 +75,7 @@ func streamLogs(ctx context.Context, out io.Writer, name string, pods corev1.Pod
 		// get latest logs if pod was terminated before logs have been streamed
 		if atomic.LoadInt64(&written) == 0 {
 			r, err := pods.GetLogs(name, &v1.PodLogOptions{
-				Container: kaniko.DefaultContainerName,
+				Container: container,
 			}).Stream(ctx)
 			if err == nil {
 				io.Copy(out, r)
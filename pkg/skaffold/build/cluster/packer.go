@@ -0,0 +1,265 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/kaniko"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes"
+	kubernetesclient "github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes/client"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+const (
+	packerContainer          = "packer"
+	packerInitContainer      = "packer-init-container"
+	packerEmptyDirName       = "packer-emptydir"
+	packerEmptyDirMountPath  = "/workspace"
+	packerDockerConfigSecret = "packer-docker-config"
+	packerDockerConfigPath   = "/packer/.docker"
+)
+
+// buildWithPacker builds an artifact with Packer in a pod, and returns the ID of the machine image it produced.
+// Templates that build Docker images aren't supported, since the pod has no Docker daemon.
+func (b *Builder) buildWithPacker(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
+	output.Default.Fprintf(out, "Start building with packer for artifact\n")
+
+	start := time.Now()
+	defer func() {
+		log.Entry(ctx).Infof("Building with packer completed in %s", time.Since(start))
+	}()
+
+	if err := packer.Validate(a); err != nil {
+		return "", err
+	}
+	images, err := packer.ProducesImages(a.Workspace, a.PackerArtifact)
+	if err != nil {
+		return "", err
+	}
+	if images {
+		return "", fmt.Errorf("packer artifact %q builds Docker images, which the cluster builder can't do since its pods have no Docker daemon. Build it with the local or Cloud Build builder instead", a.ImageName)
+	}
+	if platforms.IsMultiPlatform() {
+		log.Entry(ctx).Warnf("multiple target platforms %q found for artifact %q. Skaffold doesn't yet support multi-platform builds for the packer builder in a cluster. Consider specifying a single target platform explicitly.", platforms.String(), a.ImageName)
+	}
+
+	client, err := kubernetesclient.DefaultClient()
+	if err != nil {
+		return "", fmt.Errorf("getting Kubernetes client: %w", err)
+	}
+	pods := client.CoreV1().Pods(b.Namespace)
+
+	podSpec, err := b.packerPodSpec(a, tag, platforms)
+	if err != nil {
+		return "", err
+	}
+
+	pod, err := pods.Create(ctx, podSpec, metav1.CreateOptions{})
+	if err != nil {
+		return "", fmt.Errorf("creating packer pod: %w", err)
+	}
+
+	defer func() {
+		// if build interrupted the original context is cancelled
+		// and pod deletion will not be called, so we need a new ctx
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+
+		if err := pods.Delete(ctx, pod.Name, metav1.DeleteOptions{
+			GracePeriodSeconds: new(int64),
+		}); err != nil {
+			log.Entry(ctx).Errorf("deleting pod: %s", err)
+		}
+	}()
+
+	if err := kubernetes.WaitForPodInitialized(ctx, pods, pod.Name); err != nil {
+		return "", fmt.Errorf("waiting for pod to initialize: %w", err)
+	}
+	if err := b.copyPackerBuildContext(ctx, a, pod.Name); err != nil {
+		return "", fmt.Errorf("copying sources: %w", err)
+	}
+
+	// Wait for the pods to succeed while streaming the logs
+	w := packer.NewOutputWriter(out)
+	waitForLogs := streamLogs(ctx, w, pod.Name, packerContainer, pods)
+
+	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
+		waitForLogs()
+		return "", err
+	}
+
+	waitForLogs()
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+	id, ok := w.MachineImage()
+	if !ok {
+		return "", fmt.Errorf("packer build of %q didn't report the machine image it built", a.ImageName)
+	}
+	return packer.MachineImageResult(id), nil
+}
+
+// copyPackerBuildContext sends the artifact's dependencies to the pod and completes its init container.
+func (b *Builder) copyPackerBuildContext(ctx context.Context, a *latest.Artifact, podName string) error {
+	deps, err := packer.GetDependencies(ctx, a.Workspace, a.PackerArtifact)
+	if err != nil {
+		return fmt.Errorf("getting dependencies: %w", err)
+	}
+
+	var paths []string
+	for _, dep := range deps {
+		if filepath.IsAbs(dep) {
+			log.Entry(ctx).Warnf("Not sending %s to the packer pod since it's outside of the workspace %s", dep, a.Workspace)
+			continue
+		}
+		paths = append(paths, filepath.Join(a.Workspace, dep))
+	}
+
+	buildCtx := &bytes.Buffer{}
+	if err := util.CreateTarGz(ctx, buildCtx, a.Workspace, paths); err != nil {
+		return fmt.Errorf("creating build context: %w", err)
+	}
+
+	var cmdOut bytes.Buffer
+	if err := b.kubectlcli.Run(ctx, buildCtx, &cmdOut, "exec", "-i", podName, "-c", packerInitContainer, "-n", b.Namespace, "--", "tar", "-zxf", "-", "-C", packerEmptyDirMountPath); err != nil {
+		return fmt.Errorf("uploading build context: %s", cmdOut.String())
+	}
+
+	// Generate a file to successfully terminate the init container.
+	if out, err := b.kubectlcli.RunOut(ctx, "exec", podName, "-c", packerInitContainer, "-n", b.Namespace, "--", "touch", "/tmp/complete"); err != nil {
+		return fmt.Errorf("finishing upload of the build context: %s", out)
+	}
+	return nil
+}
+
+// packerPodSpec returns the pod that runs `packer init` and `packer build` on the build context
+// that's copied to its init container.
+func (b *Builder) packerPodSpec(a *latest.Artifact, tag string, platforms platform.Matcher) (*v1.Pod, error) {
+	template, err := packer.RemoteTemplatePath(a)
+	if err != nil {
+		return nil, err
+	}
+
+	var pl *specs.Platform
+	if len(platforms.Platforms) == 1 {
+		pl = &platforms.Platforms[0]
+	}
+
+	vm := v1.VolumeMount{
+		Name:      packerEmptyDirName,
+		MountPath: packerEmptyDirMountPath,
+	}
+
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations:  b.ClusterDetails.Annotations,
+			GenerateName: "packer-",
+			Labels:       map[string]string{"skaffold-packer": "skaffold-packer"},
+			Namespace:    b.ClusterDetails.Namespace,
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            packerInitContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    []v1.VolumeMount{vm},
+				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			Containers: []v1.Container{{
+				Name:            packerContainer,
+				Image:           b.ClusterDetails.PackerImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				// The template is `$0` and the build arguments are `$@`, so that they don't need to be quoted.
+				Command:      []string{"sh", "-c", `packer init "$0" && exec packer build -machine-readable "$@"`},
+				Args:         append([]string{template}, packer.BuildArgs(a, template, tag, pl)...),
+				Env:          b.packerEnv(a.PackerArtifact),
+				WorkingDir:   packerEmptyDirMountPath,
+				VolumeMounts: []v1.VolumeMount{vm},
+				Resources:    resourceRequirements(b.ClusterDetails.Resources),
+			}},
+			RestartPolicy: v1.RestartPolicyNever,
+			Volumes: []v1.Volume{{
+				Name: vm.Name,
+				VolumeSource: v1.VolumeSource{
+					EmptyDir: &v1.EmptyDirVolumeSource{},
+				},
+			}},
+		},
+	}
+
+	// Add secret for pull secret
+	if b.ClusterDetails.PullSecretName != "" {
+		addSecretVolume(pod, kaniko.DefaultSecretName, b.ClusterDetails.PullSecretMountPath, b.ClusterDetails.PullSecretName)
+	}
+
+	if b.ClusterDetails.DockerConfig != nil {
+		// Add secret for docker config if specified
+		addSecretVolume(pod, packerDockerConfigSecret, packerDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
+	}
+
+	b.addClusterDetails(pod, platforms)
+
+	// Add used-defines Volumes
+	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
+
+	return pod, nil
+}
+
+// packerEnv returns the environment of the packer container: the artifact's `env`, the proxies and the credentials.
+func (b *Builder) packerEnv(a *latest.PackerArtifact) []v1.EnvVar {
+	var env []v1.EnvVar
+	for _, kv := range a.Env {
+		if name, value, found := strings.Cut(kv, "="); found && name != "" {
+			env = append(env, v1.EnvVar{Name: name, Value: value})
+		}
+	}
+
+	if b.ClusterDetails.HTTPProxy != "" {
+		env = append(env, v1.EnvVar{Name: "HTTP_PROXY", Value: b.ClusterDetails.HTTPProxy})
+	}
+	if b.ClusterDetails.HTTPSProxy != "" {
+		env = append(env, v1.EnvVar{Name: "HTTPS_PROXY", Value: b.ClusterDetails.HTTPSProxy})
+	}
+	if b.ClusterDetails.PullSecretName != "" {
+		env = append(env, v1.EnvVar{
+			Name:  "GOOGLE_APPLICATION_CREDENTIALS",
+			Value: b.ClusterDetails.PullSecretMountPath + "/" + b.ClusterDetails.PullSecretPath,
+		})
+	}
+	if b.ClusterDetails.DockerConfig != nil {
+		env = append(env, v1.EnvVar{Name: "DOCKER_CONFIG", Value: packerDockerConfigPath})
+	}
+	return env
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"path/filepath"
+	"testing"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestPackerPodSpec(t *testing.T) {
+	artifact := &latest.Artifact{
+		ImageName: "img",
+		ArtifactType: latest.ArtifactType{
+			PackerArtifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+				BuildArgs:    []string{"-force"},
+				Env:          []string{"PKR_VAR_size=small"},
+			},
+		},
+	}
+
+	builder := &Builder{
+		cfg: &mockBuilderContext{},
+		ClusterDetails: &latest.ClusterDetails{
+			Namespace:           "ns",
+			PullSecretName:      "secret",
+			PullSecretPath:      "kaniko-secret.json",
+			PullSecretMountPath: "/secret",
+			HTTPSProxy:          "https://proxy",
+			ServiceAccountName:  "sa",
+			PackerImage:         "hashicorp/packer:light",
+		},
+	}
+	platforms := platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "arm64"}}}
+
+	pod, err := builder.packerPodSpec(artifact, "img:tag", platforms)
+
+	vm := v1.VolumeMount{Name: packerEmptyDirName, MountPath: packerEmptyDirMountPath}
+	expected := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "packer-",
+			Labels:       map[string]string{"skaffold-packer": "skaffold-packer"},
+			Namespace:    "ns",
+		},
+		Spec: v1.PodSpec{
+			InitContainers: []v1.Container{{
+				Name:            packerInitContainer,
+				Image:           constants.DefaultBusyboxImage,
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", "while [ ! -f /tmp/complete ]; do sleep 1; done"},
+				VolumeMounts:    []v1.VolumeMount{vm},
+			}},
+			Containers: []v1.Container{{
+				Name:            packerContainer,
+				Image:           "hashicorp/packer:light",
+				ImagePullPolicy: v1.PullIfNotPresent,
+				Command:         []string{"sh", "-c", `packer init "$0" && exec packer build -machine-readable "$@"`},
+				Args: []string{"image.pkr.hcl", "-force", "-var", "image_name=img", "-var", "image_tag=img:tag",
+					"-var", "target_platform=linux/arm64", "-var", "target_os=linux", "-var", "target_arch=arm64", "image.pkr.hcl"},
+				Env: []v1.EnvVar{
+					{Name: "PKR_VAR_size", Value: "small"},
+					{Name: "HTTPS_PROXY", Value: "https://proxy"},
+					{Name: "GOOGLE_APPLICATION_CREDENTIALS", Value: "/secret/kaniko-secret.json"},
+				},
+				WorkingDir:   packerEmptyDirMountPath,
+				VolumeMounts: []v1.VolumeMount{vm, {Name: "kaniko-secret", MountPath: "/secret"}},
+			}},
+			RestartPolicy:      v1.RestartPolicyNever,
+			ServiceAccountName: "sa",
+			NodeSelector:       map[string]string{nodeArchitectureLabel: "arm64", nodeOperatingSystemLabel: "linux"},
+			Volumes: []v1.Volume{{
+				Name:         packerEmptyDirName,
+				VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}},
+			}, {
+				Name:         "kaniko-secret",
+				VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "secret"}},
+			}},
+		},
+	}
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, expected, pod)
+}
+
+func TestPackerPodSpecAbsoluteTemplatePath(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// Templates of required configs have absolute paths on the host, that don't exist in the pod
+		workspace := t.NewTempDir().Root()
+		artifact := &latest.Artifact{
+			ImageName: "img",
+			Workspace: workspace,
+			ArtifactType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: filepath.Join(workspace, "images", "image.pkr.hcl")},
+			},
+		}
+		builder := &Builder{
+			cfg:            &mockBuilderContext{},
+			ClusterDetails: &latest.ClusterDetails{PackerImage: "hashicorp/packer:light"},
+		}
+
+		pod, err := builder.packerPodSpec(artifact, "img:tag", platform.Matcher{})
+
+		t.CheckNoError(err)
+		args := pod.Spec.Containers[0].Args
+		t.CheckDeepEqual("images/image.pkr.hcl", args[0])
+		t.CheckDeepEqual("images/image.pkr.hcl", args[len(args)-1])
+	})
+}
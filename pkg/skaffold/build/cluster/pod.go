@@ -100,6 +100,22 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string, pla
 		addSecretVolume(pod, kaniko.DefaultDockerConfigSecretName, kaniko.DefaultDockerConfigPath, b.ClusterDetails.DockerConfig.SecretName)
 	}
 
+	b.addClusterDetails(pod, platforms)
+
+	// Add used-defines Volumes
+	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
+
+	// Add user-defined VolumeMounts
+	for _, vm := range artifact.VolumeMounts {
+		pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, vm)
+		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
+	}
+
+	return pod, nil
+}
+
+// addClusterDetails configures the service account, security context and scheduling of a build pod.
+func (b *Builder) addClusterDetails(pod *v1.Pod, platforms platform.Matcher) {
 	// Add Service Account
 	if b.ClusterDetails.ServiceAccountName != "" {
 		pod.Spec.ServiceAccountName = b.ClusterDetails.ServiceAccountName
@@ -113,18 +129,18 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string, pla
 		pod.Spec.SecurityContext.RunAsUser = b.ClusterDetails.RunAsUser
 	}
 
-	// Add Tolerations for kaniko pod setup
+	// Add Tolerations for pod setup
 	if len(b.ClusterDetails.Tolerations) > 0 {
 		pod.Spec.Tolerations = b.ClusterDetails.Tolerations
 	}
 
-	// Add nodeSelector for kaniko pod setup
+	// Add nodeSelector for pod setup
 	if b.ClusterDetails.NodeSelector != nil {
 		pod.Spec.NodeSelector = b.ClusterDetails.NodeSelector
 	}
 
 	// Add nodeSelector for image target platform.
-	// Kaniko doesn't support building cross platform images, so the pod platform needs to match the image target platform.
+	// The builders don't build cross platform images, so the pod platform needs to match the image target platform.
 	if len(platforms.Platforms) == 1 {
 		if pod.Spec.NodeSelector == nil {
 			pod.Spec.NodeSelector = make(map[string]string)
@@ -136,17 +152,6 @@ func (b *Builder) kanikoPodSpec(artifact *latest.KanikoArtifact, tag string, pla
 			pod.Spec.NodeSelector[nodeOperatingSystemLabel] = platforms.Platforms[0].OS
 		}
 	}
-
-	// Add used-defines Volumes
-	pod.Spec.Volumes = append(pod.Spec.Volumes, b.Volumes...)
-
-	// Add user-defined VolumeMounts
-	for _, vm := range artifact.VolumeMounts {
-		pod.Spec.InitContainers[0].VolumeMounts = append(pod.Spec.InitContainers[0].VolumeMounts, vm)
-		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, vm)
-	}
-
-	return pod, nil
 }
 
 func (b *Builder) env(artifact *latest.KanikoArtifact, httpProxy, httpsProxy string) []v1.EnvVar {
@@ -0,0 +1,406 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/tag"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+// for testing
+var bakeTempDir = func() (string, error) { return os.MkdirTemp("", "skaffold-bake") }
+
+var invalidTargetChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// Bake builds a set of docker artifacts with a single `docker buildx bake` invocation.
+// The bake runs the first time one of its artifacts is built, and the other artifacts
+// then pick up their results.
+type Bake struct {
+	localDocker docker.LocalDaemon
+	cfg         docker.Config
+	pushImages  bool
+	artifacts   ArtifactResolver
+
+	targets []*bakeArtifact
+	byImage map[string]*bakeArtifact
+
+	once sync.Once
+	err  error
+}
+
+type bakeArtifact struct {
+	target    string
+	artifact  *latest.Artifact
+	tag       string
+	platforms platform.Matcher
+	result    string
+}
+
+// bakeFile is the JSON definition read by `docker buildx bake`.
+type bakeFile struct {
+	Group  map[string]bakeGroup   `json:"group"`
+	Target map[string]*bakeTarget `json:"target"`
+}
+
+type bakeGroup struct {
+	Targets []string `json:"targets"`
+}
+
+type bakeTarget struct {
+	Context    string             `json:"context"`
+	Dockerfile string             `json:"dockerfile"`
+	Args       map[string]*string `json:"args,omitempty"`
+	Contexts   map[string]string  `json:"contexts,omitempty"`
+	Target     string             `json:"target,omitempty"`
+	Tags       []string           `json:"tags"`
+	Platforms  []string           `json:"platforms,omitempty"`
+	CacheFrom  []string           `json:"cache-from,omitempty"`
+	Secret     []string           `json:"secret,omitempty"`
+	SSH        []string           `json:"ssh,omitempty"`
+	Network    string             `json:"network,omitempty"`
+	NoCache    bool               `json:"no-cache,omitempty"`
+	Pull       bool               `json:"pull,omitempty"`
+	Output     []string           `json:"output"`
+}
+
+// NewBake plans a bake for the docker artifacts that can be built together.
+// `artifacts` are the artifacts built by the caller, and `building` lists all the artifacts of the current build:
+// an artifact that requires an artifact of the current build which isn't part of the bake is left out of it.
+// NewBake returns nil when no artifact can be baked.
+func NewBake(localDocker docker.LocalDaemon, cfg docker.Config, pushImages bool, ar ArtifactResolver, artifacts []*latest.Artifact, building []*latest.Artifact, tags tag.ImageTags, platforms platform.Resolver) *Bake {
+	inBuild := make(map[string]bool)
+	for _, a := range building {
+		inBuild[a.ImageName] = true
+	}
+
+	baked := make(map[string]bool)
+	for _, a := range artifacts {
+		if _, found := tags[a.ImageName]; found && bakeable(a, platforms.GetPlatforms(a.ImageName), pushImages) {
+			baked[a.ImageName] = true
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, a := range artifacts {
+			if !baked[a.ImageName] {
+				continue
+			}
+			for _, d := range a.Dependencies {
+				if inBuild[d.ImageName] && !baked[d.ImageName] {
+					baked[a.ImageName] = false
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	b := &Bake{
+		localDocker: localDocker,
+		cfg:         cfg,
+		pushImages:  pushImages,
+		artifacts:   ar,
+		byImage:     make(map[string]*bakeArtifact),
+	}
+	names := make(map[string]bool)
+	for _, a := range artifacts {
+		if !baked[a.ImageName] {
+			continue
+		}
+		name := invalidTargetChars.ReplaceAllString(a.ImageName, "-")
+		for i := 2; names[name]; i++ {
+			name = fmt.Sprintf("%s-%d", invalidTargetChars.ReplaceAllString(a.ImageName, "-"), i)
+		}
+		names[name] = true
+
+		ba := &bakeArtifact{
+			target:    name,
+			artifact:  a,
+			tag:       docker.SanitizeImageName(tags[a.ImageName]),
+			platforms: platforms.GetPlatforms(a.ImageName),
+		}
+		b.targets = append(b.targets, ba)
+		b.byImage[a.ImageName] = ba
+	}
+	if len(b.targets) == 0 {
+		return nil
+	}
+	return b
+}
+
+// bakeable returns true if the artifact only uses options that `docker buildx bake` supports.
+func bakeable(a *latest.Artifact, platforms platform.Matcher, pushImages bool) bool {
+	d := a.DockerArtifact
+	if d == nil || len(d.CliFlags) > 0 || len(d.AddHost) > 0 || d.Squash {
+		return false
+	}
+	// The bake runs when the first of its artifacts is built, so the pre-build hooks
+	// of the other artifacts would only run after their image is built.
+	if len(a.LifecycleHooks.PreHooks) > 0 {
+		return false
+	}
+	// Multi-platform images can't be loaded into the local docker daemon.
+	return !platforms.All && (pushImages || len(platforms.Platforms) <= 1)
+}
+
+// Includes returns true if the artifact is built by the bake.
+func (b *Bake) Includes(imageName string) bool {
+	if b == nil {
+		return false
+	}
+	_, found := b.byImage[imageName]
+	return found
+}
+
+// Build runs the bake the first time it's called, and returns the digest of the artifact when pushing or its image ID otherwise.
+func (b *Bake) Build(ctx context.Context, out io.Writer, a *latest.Artifact) (string, error) {
+	ba, found := b.byImage[a.ImageName]
+	if !found {
+		return "", fmt.Errorf("artifact %q is not part of the bake", a.ImageName)
+	}
+
+	ran := false
+	b.once.Do(func() {
+		ran = true
+		b.err = b.run(ctx, output.GetUnderlyingWriter(out))
+	})
+	if b.err != nil {
+		return "", b.err
+	}
+	if !ran {
+		output.Default.Fprintf(out, "Built %s with docker buildx bake\n", ba.tag)
+	}
+	return ba.result, nil
+}
+
+func (b *Bake) run(ctx context.Context, out io.Writer) error {
+	def, err := b.definition()
+	if err != nil {
+		return err
+	}
+
+	dir, err := bakeTempDir()
+	if err != nil {
+		return fmt.Errorf("creating bake directory: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "docker-bake.json")
+	metadataFile := filepath.Join(dir, "metadata.json")
+	content, err := json.MarshalIndent(def, "", "  ")
+	if err != nil {
+		return fmt.Errorf("generating bake definition: %w", err)
+	}
+	if err := os.WriteFile(file, content, 0o644); err != nil {
+		return fmt.Errorf("writing bake definition: %w", err)
+	}
+	log.Entry(ctx).Debugf("Bake definition:\n%s", content)
+
+	cmd := exec.CommandContext(ctx, "docker", "buildx", "bake", "--file", file, "--metadata-file", metadataFile)
+	cmd.Env = append(util.OSEnviron(), b.localDocker.ExtraEnv()...)
+	cmd.Stdout = out
+
+	var errBuffer bytes.Buffer
+	cmd.Stderr = io.MultiWriter(out, &errBuffer)
+
+	if err := util.RunCmd(ctx, cmd); err != nil {
+		return newBuildError(tryExecFormatErr(fmt.Errorf("running bake: %w", err), errBuffer), b.cfg)
+	}
+
+	digests, err := readBakeMetadata(metadataFile)
+	if err != nil {
+		log.Entry(ctx).Debugf("unable to read bake metadata: %v", err)
+	}
+
+	for _, ba := range b.targets {
+		if !b.pushImages {
+			if ba.result, err = b.localDocker.ImageID(ctx, ba.tag); err != nil {
+				return fmt.Errorf("getting imageID for %q: %w", ba.tag, err)
+			}
+			continue
+		}
+		if ba.result = digests[ba.target]; ba.result == "" {
+			if ba.result, err = docker.RemoteDigest(ba.tag, b.cfg, ba.platforms.Platforms); err != nil {
+				return fmt.Errorf("getting digest for %q: %w", ba.tag, err)
+			}
+		}
+	}
+	return nil
+}
+
+// definition generates the bake definition. Artifacts required by other artifacts of the bake are passed
+// as named contexts, so that buildx builds them in the same invocation.
+func (b *Bake) definition() (*bakeFile, error) {
+	def := &bakeFile{
+		Group:  map[string]bakeGroup{"default": {}},
+		Target: make(map[string]*bakeTarget),
+	}
+
+	var names []string
+	for _, ba := range b.targets {
+		t, err := b.target(ba)
+		if err != nil {
+			return nil, err
+		}
+		def.Target[ba.target] = t
+		names = append(names, ba.target)
+	}
+	sort.Strings(names)
+	def.Group["default"] = bakeGroup{Targets: names}
+
+	return def, nil
+}
+
+func (b *Bake) target(ba *bakeArtifact) (*bakeTarget, error) {
+	a := adjustCacheFrom(ba.artifact, ba.tag)
+	d := a.DockerArtifact
+
+	workspace, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		return nil, fmt.Errorf("getting absolute path of workspace: %w", err)
+	}
+	dockerfile, err := docker.NormalizeDockerfilePath(a.Workspace, d.DockerfilePath)
+	if err != nil {
+		return nil, dockerfileNotFound(fmt.Errorf("normalizing dockerfile path: %w", err), a.ImageName)
+	}
+	if _, err := os.Stat(dockerfile); os.IsNotExist(err) {
+		return nil, dockerfileNotFound(err, a.ImageName)
+	}
+
+	contexts := make(map[string]string)
+	var external []*latest.ArtifactDependency
+	extra := make(map[string]*string)
+	for _, dep := range a.Dependencies {
+		if baked, found := b.byImage[dep.ImageName]; found {
+			extra[dep.Alias] = util.Ptr(baked.tag)
+			contexts[baked.tag] = "target:" + baked.target
+		} else {
+			external = append(external, dep)
+		}
+	}
+	for k, v := range docker.ResolveDependencyImages(external, b.artifacts, true) {
+		extra[k] = v
+	}
+
+	imgRef, err := docker.ParseReference(ba.tag)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse image tag: %w", err)
+	}
+	imageInfoEnv := map[string]string{
+		"IMAGE_REPO": imgRef.Repo,
+		"IMAGE_NAME": imgRef.Name,
+		"IMAGE_TAG":  imgRef.Tag,
+	}
+	args, err := docker.EvalBuildArgsWithEnv(b.cfg.Mode(), a.Workspace, d.DockerfilePath, d.BuildArgs, extra, imageInfoEnv)
+	if err != nil {
+		return nil, fmt.Errorf("unable to evaluate build args: %w", err)
+	}
+	// Like `docker build --build-arg KEY`, an argument without a value is read from the environment.
+	for k, v := range args {
+		if v != nil {
+			continue
+		}
+		if value, found := os.LookupEnv(k); found {
+			args[k] = util.Ptr(value)
+		} else {
+			delete(args, k)
+		}
+	}
+
+	t := &bakeTarget{
+		Context:    workspace,
+		Dockerfile: dockerfile,
+		Args:       args,
+		Target:     d.Target,
+		Tags:       []string{ba.tag},
+		CacheFrom:  d.CacheFrom,
+		SSH:        nonEmpty(d.SSH),
+		Network:    strings.ToLower(d.NetworkMode),
+		NoCache:    d.NoCache,
+		Pull:       d.PullParent,
+	}
+	if len(contexts) > 0 {
+		t.Contexts = contexts
+	}
+	for _, p := range ba.platforms.Platforms {
+		t.Platforms = append(t.Platforms, platform.Format(p))
+	}
+	for _, secret := range d.Secrets {
+		secretString := fmt.Sprintf("id=%s", secret.ID)
+		if secret.Source != "" {
+			secretString += ",src=" + util.ExpandHomePath(secret.Source)
+		}
+		if secret.Env != "" {
+			secretString += ",env=" + secret.Env
+		}
+		t.Secret = append(t.Secret, secretString)
+	}
+	if b.pushImages {
+		t.Output = []string{"type=registry"}
+	} else {
+		t.Output = []string{"type=docker"}
+	}
+	return t, nil
+}
+
+// readBakeMetadata returns the image digests written by `docker buildx bake --metadata-file`, by target name.
+func readBakeMetadata(file string) (map[string]string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, err
+	}
+
+	digests := make(map[string]string)
+	for target, raw := range entries {
+		var m struct {
+			Digest string `json:"containerimage.digest"`
+		}
+		if json.Unmarshal(raw, &m) == nil && m.Digest != "" {
+			digests[target] = m.Digest
+		}
+	}
+	return digests, nil
+}
+
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
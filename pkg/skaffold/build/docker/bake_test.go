@@ -0,0 +1,221 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/tag"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func dockerArtifact(imageName string, deps ...string) *latest.Artifact {
+	a := &latest.Artifact{
+		ImageName: imageName,
+		Workspace: imageName,
+		ArtifactType: latest.ArtifactType{
+			DockerArtifact: &latest.DockerArtifact{DockerfilePath: "Dockerfile"},
+		},
+	}
+	for _, d := range deps {
+		a.Dependencies = append(a.Dependencies, &latest.ArtifactDependency{ImageName: d, Alias: "BASE"})
+	}
+	return a
+}
+
+func TestNewBake(t *testing.T) {
+	jib := &latest.Artifact{ImageName: "jib", ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}}
+	withFlags := dockerArtifact("flags")
+	withFlags.DockerArtifact.CliFlags = []string{"--compress"}
+	withHooks := dockerArtifact("hooks")
+	withHooks.LifecycleHooks.PreHooks = []latest.HostHook{{Command: []string{"make", "generate"}}}
+	multiArch := dockerArtifact("app")
+	multiArch.Platforms = []string{"linux/amd64", "linux/arm64"}
+
+	tests := []struct {
+		description string
+		artifacts   []*latest.Artifact
+		building    []*latest.Artifact
+		pushImages  bool
+		expected    []string
+	}{
+		{
+			description: "docker artifacts and their dependencies",
+			artifacts:   []*latest.Artifact{dockerArtifact("base"), dockerArtifact("app", "base")},
+			expected:    []string{"base", "app"},
+		},
+		{
+			description: "dependency built earlier",
+			artifacts:   []*latest.Artifact{dockerArtifact("app", "base")},
+			expected:    []string{"app"},
+		},
+		{
+			description: "unsupported options are built separately",
+			artifacts:   []*latest.Artifact{withFlags, dockerArtifact("app", "flags"), dockerArtifact("other", "app")},
+			expected:    nil,
+		},
+		{
+			description: "artifacts with pre-build hooks are built separately",
+			artifacts:   []*latest.Artifact{withHooks, dockerArtifact("app", "hooks"), dockerArtifact("other")},
+			expected:    []string{"other"},
+		},
+		{
+			description: "dependency on another artifact type of the build",
+			artifacts:   []*latest.Artifact{dockerArtifact("app", "jib"), dockerArtifact("other")},
+			building:    []*latest.Artifact{jib},
+			expected:    []string{"other"},
+		},
+		{
+			description: "multi-platform images are baked only when pushing",
+			artifacts:   []*latest.Artifact{multiArch, dockerArtifact("other")},
+			expected:    []string{"other"},
+		},
+		{
+			description: "multi-platform images pushed",
+			artifacts:   []*latest.Artifact{multiArch, dockerArtifact("other")},
+			pushImages:  true,
+			expected:    []string{"app", "other"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tags := tag.ImageTags{}
+			for _, a := range test.artifacts {
+				tags[a.ImageName] = a.ImageName + ":tag"
+			}
+			building := append(test.building, test.artifacts...)
+			resolver, err := platform.NewResolver(context.Background(), []latest.Pipeline{{Build: latest.BuildConfig{Artifacts: test.artifacts}}}, platform.ResolverOpts{})
+			t.CheckNoError(err)
+
+			bake := NewBake(fakeLocalDaemonWithExtraEnv(nil), mockConfig{}, test.pushImages, mockArtifactResolver{}, test.artifacts, building, tags, resolver)
+
+			var included []string
+			for _, a := range building {
+				if bake.Includes(a.ImageName) {
+					included = append(included, a.ImageName)
+				}
+			}
+			t.CheckDeepEqual(test.expected, included)
+			t.CheckDeepEqual(test.expected == nil, bake == nil)
+		})
+	}
+}
+
+func TestBakeDefinition(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmp := t.NewTempDir().
+			Write("base/Dockerfile", "FROM alpine").
+			Write("app/Dockerfile", "ARG BASE\nARG TOOLS\nFROM $TOOLS AS tools\nFROM $BASE").
+			Chdir()
+
+		base := dockerArtifact("base")
+		base.DockerArtifact.NoCache = true
+		app := dockerArtifact("app", "base")
+		app.Dependencies = append(app.Dependencies, &latest.ArtifactDependency{ImageName: "tools", Alias: "TOOLS"})
+		app.DockerArtifact.Target = "release"
+		app.DockerArtifact.CacheFrom = []string{"app"}
+		app.DockerArtifact.Secrets = []*latest.DockerSecret{{ID: "token", Env: "TOKEN"}}
+		tags := tag.ImageTags{"base": "gcr.io/p/base:v1", "app": "gcr.io/p/app:v1"}
+		resolver := mockArtifactResolver{m: map[string]string{"tools": "gcr.io/p/tools:v1@sha256:abc"}}
+
+		bake := NewBake(fakeLocalDaemonWithExtraEnv(nil), mockConfig{runMode: config.RunModes.Build}, false, resolver, []*latest.Artifact{base, app}, nil, tags, platform.Resolver{})
+		def, err := bake.definition()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&bakeFile{
+			Group: map[string]bakeGroup{"default": {Targets: []string{"app", "base"}}},
+			Target: map[string]*bakeTarget{
+				"base": {
+					Context:    tmp.Path("base"),
+					Dockerfile: tmp.Path("base/Dockerfile"),
+					Args:       map[string]*string{},
+					Tags:       []string{"gcr.io/p/base:v1"},
+					NoCache:    true,
+					Output:     []string{"type=docker"},
+				},
+				"app": {
+					Context:    tmp.Path("app"),
+					Dockerfile: tmp.Path("app/Dockerfile"),
+					Args: map[string]*string{
+						"BASE":  util.Ptr("gcr.io/p/base:v1"),
+						"TOOLS": util.Ptr("gcr.io/p/tools:v1@sha256:abc"),
+					},
+					Contexts:  map[string]string{"gcr.io/p/base:v1": "target:base"},
+					Target:    "release",
+					Tags:      []string{"gcr.io/p/app:v1"},
+					CacheFrom: []string{"gcr.io/p/app:v1"},
+					Secret:    []string{"id=token,env=TOKEN"},
+					Output:    []string{"type=docker"},
+				},
+			},
+		}, def)
+	})
+}
+
+func TestBakeBuild(t *testing.T) {
+	tests := []struct {
+		description string
+		pushImages  bool
+		metadata    string
+		expected    map[string]string
+	}{
+		{
+			description: "load into the local docker daemon",
+			expected:    map[string]string{"base": "sha256:base", "app": "sha256:app"},
+		},
+		{
+			description: "push",
+			pushImages:  true,
+			metadata:    `{"base": {"containerimage.digest": "sha256:111"}, "app": {"containerimage.digest": "sha256:222"}, "buildx.build.ref": "default/default/xyz"}`,
+			expected:    map[string]string{"base": "sha256:111", "app": "sha256:222"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmp := t.NewTempDir().
+				Write("base/Dockerfile", "FROM alpine").
+				Write("app/Dockerfile", "ARG BASE\nFROM $BASE").
+				Chdir()
+			bakeDir := t.NewTempDir().Write("metadata.json", test.metadata)
+			t.Override(&bakeTempDir, func() (string, error) { return bakeDir.Root(), nil })
+			t.Override(&util.DefaultExecCommand, testutil.CmdRunEnv(
+				"docker buildx bake --file "+bakeDir.Path("docker-bake.json")+" --metadata-file "+bakeDir.Path("metadata.json"),
+				[]string{"KEY=VALUE"},
+			))
+			tmp.Chdir()
+
+			api := (&testutil.FakeAPIClient{}).Add("base:v1", "sha256:base").Add("app:v1", "sha256:app")
+			localDocker := docker.NewLocalDaemon(api, []string{"KEY=VALUE"}, false, nil)
+			artifacts := []*latest.Artifact{dockerArtifact("base"), dockerArtifact("app", "base")}
+			tags := tag.ImageTags{"base": "base:v1", "app": "app:v1"}
+
+			bake := NewBake(localDocker, mockConfig{}, test.pushImages, mockArtifactResolver{}, artifacts, artifacts, tags, platform.Resolver{})
+			for _, a := range artifacts {
+				result, err := bake.Build(context.Background(), &bytes.Buffer{}, a)
+				t.CheckNoError(err)
+				t.CheckDeepEqual(test.expected[a.ImageName], result)
+			}
+		})
+	}
+}
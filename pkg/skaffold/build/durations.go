@@ -0,0 +1,174 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+// Durations records how long each artifact took to build in previous runs, keyed by durationKey.
+type Durations map[string]time.Duration
+
+// durationKey identifies an artifact by its workspace as well as its image name, so that unrelated projects
+// using the same image names, like `app`, don't share the durations file entries.
+func durationKey(a *latest.Artifact) string {
+	workspace, err := filepath.Abs(a.Workspace)
+	if err != nil {
+		workspace = a.Workspace
+	}
+	return filepath.ToSlash(workspace) + ":" + a.ImageName
+}
+
+// durationsFile returns the file where build durations are persisted.
+// It sits next to the cache file if one was given, or in the default skaffold directory otherwise.
+func durationsFile(cacheFile string) (string, error) {
+	if cacheFile != "" {
+		return cacheFile + "-" + constants.DefaultBuildDurationsFile, nil
+	}
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", fmt.Errorf("retrieving home directory: %w", err)
+	}
+	return filepath.Join(home, constants.DefaultSkaffoldDir, constants.DefaultBuildDurationsFile), nil
+}
+
+func retrieveDurations(file string) (Durations, error) {
+	durations := Durations{}
+	contents, err := os.ReadFile(file)
+	if os.IsNotExist(err) {
+		return durations, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(contents, &durations); err != nil {
+		return nil, err
+	}
+	return durations, nil
+}
+
+func saveDurations(file string, durations Durations) error {
+	data, err := yaml.Marshal(durations)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(file, data, 0644)
+}
+
+// record updates the durations with the successful builds of a timeline.
+// A new duration is averaged with the previous one so that a single slow or fast build doesn't reorder the next run.
+func (d Durations) record(artifacts []*latest.Artifact, timeline Timeline) {
+	keys := make(map[string]string)
+	for _, a := range artifacts {
+		keys[a.ImageName] = durationKey(a)
+	}
+	for _, e := range timeline {
+		key, found := keys[e.ImageName]
+		if e.Failed || !found {
+			continue
+		}
+		took := e.End.Sub(e.Start).Round(time.Millisecond)
+		if previous, found := d[key]; found {
+			took = (previous + took) / 2
+		}
+		d[key] = took
+	}
+}
+
+// criticalPaths returns, for each artifact, the expected time from the start of its build to the end of
+// the longest chain of artifacts that depend on it. Artifacts with a longer critical path should start first.
+// Artifacts that were never built are expected to take the average of the known durations.
+func criticalPaths(artifacts []*latest.Artifact, durations Durations) []time.Duration {
+	var total time.Duration
+	var known int
+	for _, a := range artifacts {
+		if d, found := durations[durationKey(a)]; found {
+			total += d
+			known++
+		}
+	}
+	var estimate time.Duration
+	if known > 0 {
+		estimate = total / time.Duration(known)
+	}
+
+	index := make(map[string]int)
+	for i, a := range artifacts {
+		index[a.ImageName] = i
+	}
+	dependents := make([][]int, len(artifacts))
+	for i, a := range artifacts {
+		for _, d := range a.Dependencies {
+			if j, found := index[d.ImageName]; found {
+				dependents[j] = append(dependents[j], i)
+			}
+		}
+	}
+
+	paths := make([]time.Duration, len(artifacts))
+	visited := make([]bool, len(artifacts))
+	var visit func(i int) time.Duration
+	visit = func(i int) time.Duration {
+		if visited[i] {
+			return paths[i]
+		}
+		// marking the artifact before visiting its dependents guards against cycles
+		visited[i] = true
+		var longest time.Duration
+		for _, j := range dependents[i] {
+			if p := visit(j); p > longest {
+				longest = p
+			}
+		}
+		d, found := durations[durationKey(artifacts[i])]
+		if !found {
+			d = estimate
+		}
+		paths[i] = d + longest
+		return paths[i]
+	}
+	for i := range artifacts {
+		visit(i)
+	}
+	return paths
+}
+
+func loadDurations(ctx context.Context, file string) Durations {
+	if file == "" {
+		return Durations{}
+	}
+	durations, err := retrieveDurations(file)
+	if err != nil {
+		log.Entry(ctx).Debugf("Error retrieving build durations: %v", err)
+		return Durations{}
+	}
+	return durations
+}
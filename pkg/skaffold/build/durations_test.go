@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestCriticalPaths(t *testing.T) {
+	tests := []struct {
+		description string
+		artifacts   []*latest.Artifact
+		durations   Durations
+		expected    []time.Duration
+	}{
+		{
+			description: "no history",
+			artifacts:   []*latest.Artifact{{ImageName: "a"}, {ImageName: "b"}},
+			expected:    []time.Duration{0, 0},
+		},
+		{
+			description: "independent artifacts",
+			artifacts:   []*latest.Artifact{{ImageName: "a"}, {ImageName: "b"}},
+			durations:   byImageName(map[string]time.Duration{"a": time.Second, "b": 3 * time.Second}),
+			expected:    []time.Duration{time.Second, 3 * time.Second},
+		},
+		{
+			description: "required artifacts add up the longest chain of dependents",
+			artifacts: []*latest.Artifact{
+				{ImageName: "base"},
+				{ImageName: "slow", Dependencies: []*latest.ArtifactDependency{{ImageName: "base"}}},
+				{ImageName: "fast", Dependencies: []*latest.ArtifactDependency{{ImageName: "base"}}},
+				{ImageName: "other"},
+			},
+			durations: byImageName(map[string]time.Duration{"base": time.Second, "slow": 5 * time.Second, "fast": time.Second, "other": 4 * time.Second}),
+			expected:  []time.Duration{6 * time.Second, 5 * time.Second, time.Second, 4 * time.Second},
+		},
+		{
+			description: "unknown artifacts are expected to take the average duration",
+			artifacts:   []*latest.Artifact{{ImageName: "a"}, {ImageName: "b"}, {ImageName: "new"}},
+			durations:   byImageName(map[string]time.Duration{"a": time.Second, "b": 3 * time.Second}),
+			expected:    []time.Duration{time.Second, 3 * time.Second, 2 * time.Second},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, criticalPaths(test.artifacts, test.durations))
+		})
+	}
+}
+
+func TestDurationsRecord(t *testing.T) {
+	start := time.Now()
+	artifacts := []*latest.Artifact{{ImageName: "a"}, {ImageName: "b"}, {ImageName: "c"}}
+	durations := byImageName(map[string]time.Duration{"a": 4 * time.Second})
+
+	durations.record(artifacts, Timeline{
+		{ImageName: "a", Start: start, End: start.Add(2 * time.Second)},
+		{ImageName: "b", Start: start, End: start.Add(time.Second)},
+		{ImageName: "c", Start: start, End: start.Add(time.Second), Failed: true},
+	})
+
+	testutil.CheckDeepEqual(t, byImageName(map[string]time.Duration{"a": 3 * time.Second, "b": time.Second}), durations)
+}
+
+func TestDurationKey(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Mkdir("project1").Mkdir("project2")
+		t.Chdir(tmpDir.Path("project1"))
+
+		// the same image name in another project doesn't share its duration
+		key := durationKey(&latest.Artifact{ImageName: "app", Workspace: "."})
+		other := durationKey(&latest.Artifact{ImageName: "app", Workspace: tmpDir.Path("project2")})
+
+		t.CheckDeepEqual(filepath.ToSlash(tmpDir.Path("project1"))+":app", key)
+		t.CheckDeepEqual(filepath.ToSlash(tmpDir.Path("project2"))+":app", other)
+	})
+}
+
+// byImageName keys durations like the artifacts with the given image names, in the current directory.
+func byImageName(durations map[string]time.Duration) Durations {
+	keyed := Durations{}
+	for imageName, d := range durations {
+		keyed[durationKey(&latest.Artifact{ImageName: imageName})] = d
+	}
+	return keyed
+}
+
+func TestSaveAndRetrieveDurations(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := filepath.Join(t.NewTempDir().Root(), "skaffold", "build-durations")
+
+		durations, err := retrieveDurations(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(Durations{}, durations)
+
+		err = saveDurations(file, Durations{"a": 1500 * time.Millisecond})
+		t.CheckNoError(err)
+
+		durations, err = retrieveDurations(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(Durations{"a": 1500 * time.Millisecond}, durations)
+	})
+}
+
+func TestDurationsFile(t *testing.T) {
+	file, err := durationsFile("/tmp/cache")
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, "/tmp/cache-build-durations", file)
+}
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
@@ -168,7 +169,15 @@ func (b *Builder) buildArtifactWithCloudBuild(ctx context.Context, out io.Writer
 	logsObject := fmt.Sprintf("log-%s.txt", remoteID)
 	output.Default.Fprintf(out, "Logs are available at \nhttps://storage.cloud.google.com/%s/%s\n", cbBucket, logsObject)
 
-	var digest string
+	// Packer reports the machine images it builds in its logs
+	logs := io.Writer(out)
+	var packerLogs *packerLogWriter
+	if artifact.PackerArtifact != nil {
+		packerLogs = newPackerLogWriter(out)
+		logs = packerLogs
+	}
+
+	var digest, machineImage string
 	offset := int64(0)
 watch:
 	for {
@@ -217,7 +226,7 @@ watch:
 			})
 		}
 		if r != nil {
-			written, err := io.Copy(out, r)
+			written, err := io.Copy(logs, r)
 			if err != nil {
 				return "", sErrors.NewErrorWithStatusCode(&proto.ActionableErr{
 					ErrCode: proto.StatusCode_BUILD_GCB_COPY_BUILD_LOG_ERR,
@@ -230,6 +239,15 @@ watch:
 		switch cb.Status {
 		case StatusQueued, StatusWorking, StatusUnknown:
 		case StatusSuccess:
+			if packerLogs != nil {
+				if err := packerLogs.Flush(); err != nil {
+					return "", err
+				}
+				if id, ok := packerLogs.MachineImage(); ok {
+					machineImage = id
+					break watch
+				}
+			}
 			digest, err = b.getDigest(cb, tag, platform)
 			if err != nil {
 				return "", sErrors.NewErrorWithStatusCode(&proto.ActionableErr{
@@ -274,6 +292,9 @@ watch:
 		log.Entry(ctx).Infof("Deleted source archive %s", buildObject)
 	}
 
+	if machineImage != "" {
+		return packer.MachineImageResult(machineImage), nil
+	}
 	return build.TagWithDigest(tag, digest), nil
 }
 
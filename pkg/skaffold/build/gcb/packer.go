@@ -0,0 +1,120 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/api/cloudbuild/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/misc"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// stepPrefixRegex matches the prefix of the lines of Cloud Build logs, `Step #1: ` or `Step #1 - "id": `.
+var stepPrefixRegex = regexp.MustCompile(`^Step #\d+(?: - "[^"]*")?: `)
+
+// packerBuildSpec lists the build steps required to build an artifact with Packer.
+// Templates that build Docker images are expected to name them after the `image_name` and `image_tag` variables,
+// and Cloud Build then pushes them. Other templates build machine images, whose IDs are read from the logs.
+func (b *Builder) packerBuildSpec(a *latest.Artifact, tag string, platforms platform.Matcher) (cloudbuild.Build, error) {
+	if err := packer.Validate(a); err != nil {
+		return cloudbuild.Build{}, err
+	}
+	images, err := packer.ProducesImages(a.Workspace, a.PackerArtifact)
+	if err != nil {
+		return cloudbuild.Build{}, err
+	}
+	if images && b.PackerImage == constants.DefaultPackerImage {
+		return cloudbuild.Build{}, fmt.Errorf("packer artifact %q builds Docker images, which requires the Docker CLI that the default packer image %s doesn't have. Set `packerImage` to an image with Packer and the Docker CLI", a.ImageName, constants.DefaultPackerImage)
+	}
+	// TODO: Build an image per platform and assemble them into a manifest list, like the local builder does.
+	if platforms.IsMultiPlatform() {
+		return cloudbuild.Build{}, fmt.Errorf("packer builder doesn't support building for multiple platforms %s on Cloud Build. Cannot build gcb artifact:\n%s", platforms.String(), misc.FormatArtifact(a))
+	}
+
+	template, err := packer.RemoteTemplatePath(a)
+	if err != nil {
+		return cloudbuild.Build{}, err
+	}
+
+	var pl *specs.Platform
+	if len(platforms.Platforms) == 1 {
+		pl = &platforms.Platforms[0]
+	}
+
+	steps := platformEmulatorInstallStep(b.GoogleCloudBuild, platforms)
+	steps = append(steps, &cloudbuild.BuildStep{
+		Name: b.PackerImage,
+		Args: []string{"init", template},
+		Env:  a.PackerArtifact.Env,
+	}, &cloudbuild.BuildStep{
+		Name: b.PackerImage,
+		Args: append([]string{"build", "-machine-readable"}, packer.BuildArgs(a, template, tag, pl)...),
+		Env:  a.PackerArtifact.Env,
+	})
+
+	build := cloudbuild.Build{
+		Steps:         steps,
+		Substitutions: b.Substitutions,
+		Timeout:       b.Timeout,
+	}
+	if images {
+		build.Images = []string{tag}
+	}
+	return build, nil
+}
+
+// packerLogWriter passes the Cloud Build logs of a Packer build to a packer.OutputWriter, without the step prefixes.
+type packerLogWriter struct {
+	*packer.OutputWriter
+	buf bytes.Buffer
+}
+
+func newPackerLogWriter(out io.Writer) *packerLogWriter {
+	return &packerLogWriter{OutputWriter: packer.NewOutputWriter(out)}
+}
+
+func (w *packerLogWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if _, err := w.OutputWriter.Write(stepPrefixRegex.ReplaceAll(w.buf.Next(i+1), nil)); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Flush parses the last line, if it isn't terminated by a newline.
+func (w *packerLogWriter) Flush() error {
+	if w.buf.Len() > 0 {
+		if _, err := w.OutputWriter.Write(stepPrefixRegex.ReplaceAll(w.buf.Next(w.buf.Len()), nil)); err != nil {
+			return err
+		}
+	}
+	return w.OutputWriter.Flush()
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcb
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"google.golang.org/api/cloudbuild/v1"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+const (
+	dockerTemplate = `source "docker" "ubuntu" {
+  image  = "ubuntu:22.04"
+  commit = true
+}
+
+build {
+  sources = ["source.docker.ubuntu"]
+}`
+	amiTemplate = `source "amazon-ebs" "ubuntu" {
+  ami_name = "app"
+}
+
+build {
+  sources = ["source.amazon-ebs.ubuntu"]
+}`
+)
+
+func TestPackerBuildSpec(t *testing.T) {
+	tests := []struct {
+		description    string
+		artifact       *latest.PackerArtifact
+		template       string
+		packerImage    string
+		platforms      platform.Matcher
+		expected       []*cloudbuild.BuildStep
+		expectedImages []string
+		shouldErr      bool
+	}{
+		{
+			description: "default",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+				Env:          []string{"PKR_VAR_size=small"},
+			},
+			expected: []*cloudbuild.BuildStep{{
+				Name: "packer/image",
+				Args: []string{"init", "image.pkr.hcl"},
+				Env:  []string{"PKR_VAR_size=small"},
+			}, {
+				Name: "packer/image",
+				Args: []string{"build", "-machine-readable", "-var", "image_name=img", "-var", "image_tag=img:tag", "image.pkr.hcl"},
+				Env:  []string{"PKR_VAR_size=small"},
+			}},
+			expectedImages: []string{"img:tag"},
+		},
+		{
+			description: "machine image",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+			},
+			template: amiTemplate,
+			expected: []*cloudbuild.BuildStep{{
+				Name: "packer/image",
+				Args: []string{"init", "image.pkr.hcl"},
+			}, {
+				Name: "packer/image",
+				Args: []string{"build", "-machine-readable", "-var", "image_name=img", "-var", "image_tag=img:tag", "image.pkr.hcl"},
+			}},
+		},
+		{
+			description: "docker image with the default packer image",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+			},
+			packerImage: "hashicorp/packer:light",
+			shouldErr:   true,
+		},
+		{
+			description: "machine image with the default packer image",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+			},
+			template:    amiTemplate,
+			packerImage: "hashicorp/packer:light",
+			expected: []*cloudbuild.BuildStep{{
+				Name: "hashicorp/packer:light",
+				Args: []string{"init", "image.pkr.hcl"},
+			}, {
+				Name: "hashicorp/packer:light",
+				Args: []string{"build", "-machine-readable", "-var", "image_name=img", "-var", "image_tag=img:tag", "image.pkr.hcl"},
+			}},
+		},
+		{
+			description: "filters and build args",
+			artifact: &latest.PackerArtifact{
+				TemplatePath:   "image.pkr.hcl",
+				BuildArgs:      []string{"-force"},
+				PostProcessors: []string{"docker.ubuntu"},
+			},
+			expected: []*cloudbuild.BuildStep{{
+				Name: "packer/image",
+				Args: []string{"init", "image.pkr.hcl"},
+			}, {
+				Name: "packer/image",
+				Args: []string{"build", "-machine-readable", "-only=docker.ubuntu", "-force", "-var", "image_name=img", "-var", "image_tag=img:tag", "image.pkr.hcl"},
+			}},
+			expectedImages: []string{"img:tag"},
+		},
+		{
+			description: "single platform",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+			},
+			platforms: platform.Matcher{Platforms: []v1.Platform{{OS: "linux", Architecture: "arm64"}}},
+			expected: []*cloudbuild.BuildStep{{
+				Name: "docker/binfmt:a7996909642ee92942dcd6cff44b9b95f08dad64",
+			}, {
+				Name: "packer/image",
+				Args: []string{"init", "image.pkr.hcl"},
+			}, {
+				Name: "packer/image",
+				Args: []string{"build", "-machine-readable", "-var", "image_name=img", "-var", "image_tag=img:tag",
+					"-var", "target_platform=linux/arm64", "-var", "target_os=linux", "-var", "target_arch=arm64", "image.pkr.hcl"},
+			}},
+			expectedImages: []string{"img:tag"},
+		},
+		{
+			description: "multiple platforms",
+			artifact: &latest.PackerArtifact{
+				TemplatePath: "image.pkr.hcl",
+			},
+			platforms: platform.Matcher{Platforms: []v1.Platform{{OS: "linux", Architecture: "amd64"}, {OS: "linux", Architecture: "arm64"}}},
+			shouldErr: true,
+		},
+		{
+			description: "postProcessors and except",
+			artifact: &latest.PackerArtifact{
+				TemplatePath:   "image.pkr.hcl",
+				PostProcessors: []string{"docker.ubuntu"},
+				Except:         []string{"docker-push"},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			template := test.template
+			if template == "" {
+				template = dockerTemplate
+			}
+			tmpDir := t.NewTempDir().Write("image.pkr.hcl", template)
+			artifact := &latest.Artifact{
+				ImageName: "img",
+				Workspace: tmpDir.Root(),
+				ArtifactType: latest.ArtifactType{
+					PackerArtifact: test.artifact,
+				},
+			}
+			packerImage := test.packerImage
+			if packerImage == "" {
+				packerImage = "packer/image"
+			}
+			builder := NewBuilder(&mockBuilderContext{}, &latest.GoogleCloudBuild{
+				PackerImage: packerImage,
+			})
+
+			buildSpec, err := builder.buildSpec(context.Background(), artifact, "img:tag", test.platforms, "bucket", "object")
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, buildSpec.Steps)
+				t.CheckDeepEqual(test.expectedImages, buildSpec.Images)
+			}
+		})
+	}
+}
+
+func TestPackerBuildSpecAbsoluteTemplatePath(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// Templates of required configs have absolute paths on the host, that don't exist in the uploaded source
+		tmpDir := t.NewTempDir().Write("images/image.pkr.hcl", amiTemplate)
+		artifact := &latest.Artifact{
+			ImageName: "img",
+			Workspace: tmpDir.Root(),
+			ArtifactType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: tmpDir.Path("images/image.pkr.hcl")},
+			},
+		}
+		builder := NewBuilder(&mockBuilderContext{}, &latest.GoogleCloudBuild{PackerImage: "packer/image"})
+
+		buildSpec, err := builder.buildSpec(context.Background(), artifact, "img:tag", platform.Matcher{}, "bucket", "object")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]*cloudbuild.BuildStep{{
+			Name: "packer/image",
+			Args: []string{"init", "images/image.pkr.hcl"},
+		}, {
+			Name: "packer/image",
+			Args: []string{"build", "-machine-readable", "-var", "image_name=img", "-var", "image_tag=img:tag", "images/image.pkr.hcl"},
+		}}, buildSpec.Steps)
+	})
+}
+
+func TestPackerLogWriter(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var out bytes.Buffer
+		w := newPackerLogWriter(&out)
+
+		_, err := io.WriteString(w, `Starting Step #1
+Step #1: 1700000000,amazon-ebs.ubuntu,ui,say,==> Creating AMI
+Step #1 - "build": 1700000001,amazon-ebs.ubuntu,artifact,0,builder-id,mitchellh.amazonebs
+Step #1 - "build": 1700000001,amazon-ebs.ubuntu,artifact,0,id,us-east-1:ami-0123456789abcdef0`)
+		t.CheckNoError(err)
+		t.CheckNoError(w.Flush())
+
+		id, ok := w.MachineImage()
+		t.CheckTrue(ok)
+		t.CheckDeepEqual("us-east-1:ami-0123456789abcdef0", id)
+		t.CheckDeepEqual("Starting Step #1\n==> Creating AMI\n", out.String())
+	})
+}
@@ -106,6 +106,9 @@ func (b *Builder) buildSpecForArtifact(ctx context.Context, a *latest.Artifact,
 	case a.KoArtifact != nil:
 		return b.koBuildSpec(ctx, a, tag, platforms)
 
+	case a.PackerArtifact != nil:
+		return b.packerBuildSpec(a, tag, platforms)
+
 	default:
 		return cloudbuild.Build{}, fmt.Errorf("unexpected type %q for gcb artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
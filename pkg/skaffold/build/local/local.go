@@ -21,11 +21,13 @@ import (
 	"io"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build"
+	dockerbuilder "github.com/ryanharper/skaffold/v2/pkg/skaffold/build/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/tag"
 )
 
 // Build runs a docker build on the host and tags the resulting image with
@@ -50,8 +52,21 @@ func (b *Builder) PreBuild(_ context.Context, out io.Writer) error {
 	return nil
 }
 
+// PrepareBatch plans a single `docker buildx bake` for the docker artifacts when `useBuildxBake` is set.
+func (b *Builder) PrepareBatch(ctx context.Context, artifacts []*latest.Artifact, building []*latest.Artifact, tags tag.ImageTags, platforms platform.Resolver) {
+	b.bake = nil
+	if !b.local.UseBuildxBake {
+		return
+	}
+	b.bake = dockerbuilder.NewBake(b.localDocker, b.cfg, b.pushImages, b.artifactStore, artifacts, building, tags, platforms)
+	if b.bake == nil {
+		log.Entry(ctx).Debug("no artifact can be built with docker buildx bake")
+	}
+}
+
 func (b *Builder) PostBuild(ctx context.Context, _ io.Writer) error {
 	defer b.localDocker.Close()
+	b.bake = nil
 	if b.prune {
 		if b.mode == config.RunModes.Build {
 			b.localPruner.synchronousCleanupOldImages(ctx, b.builtImages)
@@ -85,16 +100,26 @@ func (b *Builder) PushImages() bool {
 
 func (b *Builder) SupportedPlatforms() platform.Matcher { return platform.All }
 
+// SupportsMultiPlatformBuild returns true for the artifacts built with docker buildx bake, which builds all the target platforms at once.
+func (b *Builder) SupportsMultiPlatformBuild(a *latest.Artifact) bool {
+	return b.bake.Includes(a.ImageName)
+}
+
 func (b *Builder) buildArtifact(ctx context.Context, out io.Writer, a *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
 	digestOrImageID, err := b.runBuildForArtifact(ctx, out, a, tag, platforms)
 	if err != nil {
 		return "", err
 	}
 
+	// Packer templates don't always produce images, so the Packer builder returns the reference to what it built
+	if a.PackerArtifact != nil {
+		return digestOrImageID, nil
+	}
+
 	if b.pushImages {
 		// only track images for pruning when building with docker
-		// if we're pushing a bazel image, it was built directly to the registry
-		if a.DockerArtifact != nil {
+		// if we're pushing a bazel image, or baking a docker image, it was built directly to the registry
+		if a.DockerArtifact != nil && !b.bake.Includes(a.ImageName) {
 			imageID, err := b.getImageIDForTag(ctx, tag)
 			if err != nil {
 				log.Entry(ctx).Warn("unable to inspect image: built images may not be cleaned up correctly by skaffold")
@@ -138,6 +163,10 @@ func (b *Builder) runBuildForArtifact(ctx context.Context, out io.Writer, a *lat
 		}
 	}
 
+	if b.bake.Includes(a.ImageName) {
+		return b.bake.Build(ctx, out, a)
+	}
+
 	builder, err := newPerArtifactBuilder(b, a)
 	if err != nil {
 		return "", err
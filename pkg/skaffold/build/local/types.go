@@ -59,6 +59,7 @@ type Builder struct {
 	localPruner        *pruner
 	artifactStore      build.ArtifactStore
 	sourceDependencies graph.SourceDependenciesCache
+	bake               *dockerbuilder.Bake
 }
 
 type Config interface {
@@ -154,7 +155,7 @@ func newPerArtifactBuilder(b *Builder, a *latest.Artifact) (artifactBuilder, err
 		return ko.NewArtifactBuilder(b.localDocker, b.pushImages, b.mode, b.insecureRegistries), nil
 
 	case a.PackerArtifact != nil:
-		return packer.NewBuilder(b.cfg, b.localDocker), nil
+		return packer.NewBuilder(b.cfg, b.localDocker, b.pushImages, a.PackerArtifact.Platforms)
 
 	default:
 		return nil, fmt.Errorf("unexpected type %q for local artifact:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
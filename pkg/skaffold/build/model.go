@@ -18,6 +18,9 @@ package build
 
 import (
 	"context"
+	"sort"
+	"sync"
+	"time"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 )
@@ -77,18 +80,102 @@ func createNodes(artifacts []*latest.Artifact) []node {
 	return nodes
 }
 
-// countingSemaphore uses a buffered channel of size `n` that acts like a counting semaphore, allowing up to `n` concurrent operations
-type countingSemaphore struct {
-	sem chan bool
+// buildSlots limits how many builds run at the same time, in total and per artifact type.
+// When a slot frees up, it goes to the waiting build with the longest critical path that fits its pool,
+// so that the builds which hold up the most work start first. Ties go to the artifact declared first.
+type buildSlots struct {
+	mu       sync.Mutex
+	expected int
+	limit    int
+	running  int
+	pools    map[string]int
+	inPool   map[string]int
+	waitlist []*slotRequest
 }
 
-func newCountingSemaphore(count int) countingSemaphore {
-	return countingSemaphore{sem: make(chan bool, count)}
+type slotRequest struct {
+	pool     string
+	priority time.Duration
+	order    int
+	granted  chan struct{}
 }
 
-func (c countingSemaphore) acquire() (release func()) {
-	c.sem <- true
-	return func() {
-		<-c.sem
+// newBuildSlots returns slots for up to `limit` concurrent builds, and up to `pools[type]` concurrent builds of an artifact type.
+// No slot is handed out until `initial` requests are waiting, so that the builds that can start right away are ordered too.
+func newBuildSlots(limit int, pools map[string]int, initial int) *buildSlots {
+	return &buildSlots{expected: initial, limit: limit, pools: pools, inPool: make(map[string]int)}
+}
+
+// expect holds back free slots until `n` more requests are waiting.
+// A build calls it for the dependents it unblocks, before it releases its own slot.
+func (s *buildSlots) expect(n int) {
+	s.mu.Lock()
+	s.expected += n
+	s.mu.Unlock()
+}
+
+// acquire waits for a slot in the given pool, or returns an error if the context is cancelled first.
+func (s *buildSlots) acquire(ctx context.Context, pool string, priority time.Duration, order int) (release func(), err error) {
+	r := &slotRequest{pool: pool, priority: priority, order: order, granted: make(chan struct{})}
+	s.mu.Lock()
+	s.waitlist = append(s.waitlist, r)
+	if s.expected > 0 {
+		s.expected--
+	}
+	s.dispatch()
+	s.mu.Unlock()
+
+	release = func() {
+		s.mu.Lock()
+		s.running--
+		s.inPool[pool]--
+		s.dispatch()
+		s.mu.Unlock()
+	}
+	select {
+	case <-r.granted:
+		return release, nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for i, w := range s.waitlist {
+			if w == r {
+				s.waitlist = append(s.waitlist[:i], s.waitlist[i+1:]...)
+				return nil, ctx.Err()
+			}
+		}
+		// the slot was granted in the meantime
+		s.running--
+		s.inPool[pool]--
+		s.dispatch()
+		return nil, ctx.Err()
+	}
+}
+
+// dispatch grants free slots to waiting requests. It must be called with the lock held.
+func (s *buildSlots) dispatch() {
+	if s.expected > 0 {
+		return
+	}
+	sort.SliceStable(s.waitlist, func(i, j int) bool {
+		if s.waitlist[i].priority != s.waitlist[j].priority {
+			return s.waitlist[i].priority > s.waitlist[j].priority
+		}
+		return s.waitlist[i].order < s.waitlist[j].order
+	})
+	var waiting []*slotRequest
+	for _, r := range s.waitlist {
+		if s.running >= s.limit {
+			waiting = append(waiting, r)
+			continue
+		}
+		if limit, found := s.pools[r.pool]; found && limit > 0 && s.inPool[r.pool] >= limit {
+			waiting = append(waiting, r)
+			continue
+		}
+		s.running++
+		s.inPool[r.pool]++
+		close(r.granted)
 	}
+	s.waitlist = waiting
 }
@@ -0,0 +1,169 @@
+package packer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+)
+
+// dockerBuilderIDs are the builder IDs of the artifacts that are images in the local Docker daemon.
+var dockerBuilderIDs = map[string]bool{
+	"packer.docker":                       true,
+	"packer.post-processor.docker-import": true,
+	"packer.post-processor.docker-tag":    true,
+	"packer.post-processor.docker-push":   true,
+}
+
+// packerArtifact is an artifact produced by a Packer build, as reported in its machine-readable output.
+type packerArtifact struct {
+	// Target is the name of the build that produced the artifact, for example `amazon-ebs.ubuntu`.
+	Target string
+	// BuilderID identifies the builder or post-processor that produced the artifact, for example `mitchellh.amazonebs`.
+	BuilderID string
+	// ID is the identifier of the artifact, for example `us-east-1:ami-0123456789abcdef0` for an AMI.
+	ID string
+}
+
+// IsImage returns true if the artifact is an image in the local Docker daemon.
+func (a packerArtifact) IsImage() bool {
+	return dockerBuilderIDs[a.BuilderID]
+}
+
+// machineReadableWriter parses the output of `packer build -machine-readable`.
+// UI messages are written to the underlying writer and artifacts are collected.
+type machineReadableWriter struct {
+	out       io.Writer
+	buf       bytes.Buffer
+	artifacts map[string]*packerArtifact
+	order     []string
+}
+
+func newMachineReadableWriter(out io.Writer) *machineReadableWriter {
+	return &machineReadableWriter{out: out, artifacts: map[string]*packerArtifact{}}
+}
+
+func (w *machineReadableWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := w.parseLine(string(w.buf.Next(i + 1))); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Flush parses the last line, if it isn't terminated by a newline.
+func (w *machineReadableWriter) Flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	return w.parseLine(string(w.buf.Next(w.buf.Len())))
+}
+
+// parseLine parses a `timestamp,target,type,data...` line.
+func (w *machineReadableWriter) parseLine(line string) error {
+	fields := strings.Split(strings.TrimRight(line, "\r\n"), ",")
+	if len(fields) < 3 {
+		// Not a machine-readable line, for instance the output of a plugin
+		_, err := io.WriteString(w.out, line)
+		return err
+	}
+	for i := range fields {
+		fields[i] = unescape(fields[i])
+	}
+
+	target, msgType, data := fields[1], fields[2], fields[3:]
+	switch msgType {
+	case "ui":
+		if len(data) >= 2 {
+			_, err := io.WriteString(w.out, strings.TrimRight(data[1], "\n")+"\n")
+			return err
+		}
+	case "artifact":
+		if len(data) >= 3 {
+			w.addArtifactField(target, data[0], data[1], data[2])
+		}
+	}
+	return nil
+}
+
+func (w *machineReadableWriter) addArtifactField(target, index, key, value string) {
+	id := target + "/" + index
+	a, found := w.artifacts[id]
+	if !found {
+		a = &packerArtifact{Target: target}
+		w.artifacts[id] = a
+		w.order = append(w.order, id)
+	}
+
+	switch key {
+	case "builder-id":
+		a.BuilderID = value
+	case "id":
+		a.ID = value
+	}
+}
+
+// Artifacts returns the artifacts in the order they were reported.
+func (w *machineReadableWriter) Artifacts() []packerArtifact {
+	var artifacts []packerArtifact
+	for _, id := range w.order {
+		artifacts = append(artifacts, *w.artifacts[id])
+	}
+	return artifacts
+}
+
+// unescape decodes the commas and newlines that Packer escapes in machine-readable output.
+func unescape(s string) string {
+	s = strings.ReplaceAll(s, "%!(PACKER_COMMA)", ",")
+	s = strings.ReplaceAll(s, `\n`, "\n")
+	s = strings.ReplaceAll(s, `\r`, "\r")
+	return s
+}
+
+// machineImage returns the ID of the last artifact produced by the build when none of the artifacts are images,
+// for instance the AMI built by the `amazon-ebs` builder.
+func machineImage(artifacts []packerArtifact) (string, bool) {
+	var id string
+	for _, a := range artifacts {
+		if a.IsImage() {
+			return "", false
+		}
+		if a.ID != "" {
+			id = a.ID
+		}
+	}
+	return id, id != ""
+}
+
+// machineImagePrefix marks the build results that are machine images instead of image references.
+const machineImagePrefix = "machine-image:"
+
+// MachineImageResult returns the build result of an artifact that was built into the machine image with the given ID.
+func MachineImageResult(id string) string {
+	return machineImagePrefix + id
+}
+
+// MachineImageID returns the ID of the machine image that a build result refers to, if any.
+func MachineImageID(result string) (string, bool) {
+	return strings.CutPrefix(result, machineImagePrefix)
+}
+
+// OutputWriter parses the output of a `packer build -machine-readable` that runs outside of Skaffold,
+// for instance in a pod.
+type OutputWriter struct {
+	*machineReadableWriter
+}
+
+// NewOutputWriter returns an OutputWriter that writes the UI messages to out.
+func NewOutputWriter(out io.Writer) *OutputWriter {
+	return &OutputWriter{newMachineReadableWriter(out)}
+}
+
+// MachineImage returns the ID of what the build produced when it didn't produce images, for instance an AMI.
+func (w *OutputWriter) MachineImage() (string, bool) {
+	return machineImage(w.Artifacts())
+}
@@ -0,0 +1,108 @@
+package packer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestMachineReadableWriter(t *testing.T) {
+	tests := []struct {
+		description       string
+		output            []string
+		expectedOut       string
+		expectedArtifacts []packerArtifact
+	}{
+		{
+			description: "ui messages",
+			output: []string{
+				"1700000000,,ui,say,==> docker.ubuntu: Creating a temporary directory\n",
+				"1700000000,,ui,message,    docker.ubuntu: Hello%!(PACKER_COMMA) Packer!\n",
+				"1700000000,,ui,error,Build 'docker.ubuntu' errored\n",
+			},
+			expectedOut: "==> docker.ubuntu: Creating a temporary directory\n    docker.ubuntu: Hello, Packer!\nBuild 'docker.ubuntu' errored\n",
+		},
+		{
+			description: "lines split across writes",
+			output: []string{
+				"1700000000,,ui,say,==> docker",
+				".ubuntu: Committing the container\n1700000000,,ui",
+				",say,Build finished",
+			},
+			expectedOut: "==> docker.ubuntu: Committing the container\nBuild finished\n",
+		},
+		{
+			description: "lines that aren't machine-readable",
+			output:      []string{"Error: Failed to initialize plugin\n"},
+			expectedOut: "Error: Failed to initialize plugin\n",
+		},
+		{
+			description: "artifacts",
+			output: []string{
+				"1700000001,docker.ubuntu,artifact,0,builder-id,packer.docker\n",
+				"1700000001,docker.ubuntu,artifact,0,id,sha256:0123\n",
+				"1700000001,docker.ubuntu,artifact,1,builder-id,packer.post-processor.docker-tag\n",
+				"1700000001,docker.ubuntu,artifact,1,id,sha256:0123\n",
+				"1700000001,googlecompute.base,artifact,0,builder-id,packer.googlecompute\n",
+				"1700000001,googlecompute.base,artifact,0,id,base-image-v1\n",
+			},
+			expectedArtifacts: []packerArtifact{
+				{Target: "docker.ubuntu", BuilderID: "packer.docker", ID: "sha256:0123"},
+				{Target: "docker.ubuntu", BuilderID: "packer.post-processor.docker-tag", ID: "sha256:0123"},
+				{Target: "googlecompute.base", BuilderID: "packer.googlecompute", ID: "base-image-v1"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			out := &bytes.Buffer{}
+			w := newMachineReadableWriter(out)
+			for _, o := range test.output {
+				_, err := w.Write([]byte(o))
+				t.CheckNoError(err)
+			}
+			t.CheckNoError(w.Flush())
+
+			t.CheckDeepEqual(test.expectedOut, out.String())
+			t.CheckDeepEqual(test.expectedArtifacts, w.Artifacts())
+		})
+	}
+}
+
+func TestMachineImage(t *testing.T) {
+	tests := []struct {
+		description string
+		artifacts   []packerArtifact
+		expectedID  string
+		expectedOk  bool
+	}{
+		{
+			description: "no artifacts",
+		},
+		{
+			description: "docker image",
+			artifacts: []packerArtifact{
+				{BuilderID: "packer.docker", ID: "sha256:0123"},
+				{BuilderID: "packer.post-processor.docker-push", ID: "sha256:0123"},
+			},
+		},
+		{
+			description: "machine images",
+			artifacts: []packerArtifact{
+				{BuilderID: "packer.googlecompute", ID: "base-image-v1"},
+				{BuilderID: "packer.post-processor.manifest"},
+			},
+			expectedID: "base-image-v1",
+			expectedOk: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			id, ok := machineImage(test.artifacts)
+
+			t.CheckDeepEqual(test.expectedID, id)
+			t.CheckDeepEqual(test.expectedOk, ok)
+		})
+	}
+}
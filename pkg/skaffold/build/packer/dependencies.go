@@ -0,0 +1,253 @@
+package packer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/list"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/hcl"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringset"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/walk"
+)
+
+// varsEnvPrefix prefixes the environment variables that set the variables of Packer templates.
+const varsEnvPrefix = "PKR_VAR_"
+
+// fileProvisioners are the provisioners that reference local files.
+var fileProvisioners = map[string]bool{"file": true, "shell": true, "shell-local": true, "ansible": true, "ansible-local": true}
+
+// fileAttributes are the provisioner attributes that reference local files or directories.
+var fileAttributes = []string{"source", "sources", "script", "scripts", "playbook_file", "playbook_dir", "galaxy_file", "inventory_file", "role_paths"}
+
+// GetDependencies returns the files a Packer artifact depends on, relative to the workspace.
+// Unless `dependencies` is set, these are the template, its variable files, and the files referenced by
+// its `file`, `shell` and `ansible` provisioners.
+func GetDependencies(ctx context.Context, workspace string, a *latest.PackerArtifact) ([]string, error) {
+	if a.Dependencies != nil && (a.Dependencies.Paths != nil || a.Dependencies.Ignore != nil) {
+		return list.Files(workspace, a.Dependencies.Paths, a.Dependencies.Ignore)
+	}
+
+	contextDir, template := templatePath(workspace, a)
+	templates, err := templateFiles(template)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := stringset.New()
+	deps.Insert(templates...)
+	for _, varFile := range varFiles(a.BuildArgs) {
+		deps.Insert(filepath.Join(contextDir, varFile))
+	}
+
+	for _, t := range templates {
+		if strings.HasSuffix(t, ".pkrvars.hcl") || strings.HasSuffix(t, ".pkrvars.json") {
+			continue
+		}
+		paths, err := provisionerFiles(t)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, p := range paths {
+			// Packer resolves relative paths from the directory it runs in
+			p = strings.ReplaceAll(p, "${path.root}", filepath.Dir(t))
+			p = strings.ReplaceAll(p, "${path.cwd}", contextDir)
+			if strings.Contains(p, "${") {
+				log.Entry(ctx).Debugf("Ignoring Packer dependency %q of %s that can't be resolved", p, t)
+				continue
+			}
+			if !filepath.IsAbs(p) {
+				p = filepath.Join(contextDir, p)
+			}
+
+			files, err := walkFiles(p)
+			if err != nil {
+				return nil, err
+			}
+			deps.Insert(files...)
+		}
+	}
+
+	return relativePaths(workspace, deps.ToList())
+}
+
+// templatePath returns the directory Packer runs in, like in `Builder.Build`, and the path of the template,
+// which is relative to it.
+func templatePath(workspace string, a *latest.PackerArtifact) (string, string) {
+	contextDir := workspace
+	template := a.TemplatePath
+	if contextDir == "" {
+		contextDir = filepath.Dir(a.TemplatePath)
+	} else if !filepath.IsAbs(template) {
+		template = filepath.Join(contextDir, template)
+	}
+	return contextDir, template
+}
+
+// templateFiles returns the template file, or the templates and variable files of a template directory.
+func templateFiles(template string) ([]string, error) {
+	info, err := os.Stat(template)
+	if err != nil {
+		return nil, fmt.Errorf("reading packer template %q: %w", template, err)
+	}
+
+	dir := template
+	files := []string{template}
+	if info.IsDir() {
+		files = nil
+	} else {
+		dir = filepath.Dir(template)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading packer template directory %q: %w", dir, err)
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || filepath.Join(dir, name) == template {
+			continue
+		}
+		if strings.HasSuffix(name, ".pkrvars.hcl") || strings.HasSuffix(name, ".pkrvars.json") ||
+			(info.IsDir() && (strings.HasSuffix(name, ".pkr.hcl") || strings.HasSuffix(name, ".pkr.json"))) {
+			files = append(files, filepath.Join(dir, name))
+		}
+	}
+	return files, nil
+}
+
+// varFiles returns the variable files passed with `-var-file` in the build arguments.
+func varFiles(args []string) []string {
+	var files []string
+	for i, arg := range args {
+		arg = strings.TrimPrefix(arg, "-")
+		switch {
+		case strings.HasPrefix(arg, "-var-file="), strings.HasPrefix(arg, "var-file="):
+			files = append(files, arg[strings.Index(arg, "=")+1:])
+		case (arg == "-var-file" || arg == "var-file") && i+1 < len(args):
+			files = append(files, args[i+1])
+		}
+	}
+	return files
+}
+
+// provisionerFiles returns the paths referenced by the provisioners of a template, as they are written in the template.
+func provisionerFiles(template string) ([]string, error) {
+	b, err := os.ReadFile(template)
+	if err != nil {
+		return nil, fmt.Errorf("reading packer template %q: %w", template, err)
+	}
+
+	if strings.HasSuffix(template, ".json") {
+		return jsonProvisionerFiles(template, b)
+	}
+
+	blocks, err := hcl.Parse(b, template)
+	if err != nil {
+		return nil, fmt.Errorf("parsing packer template %q: %w", template, err)
+	}
+
+	var paths []string
+	for _, p := range hcl.Find(blocks, "provisioner") {
+		if len(p.Labels) == 0 || !fileProvisioners[p.Labels[0]] {
+			continue
+		}
+		if direction, _ := p.Attribute("direction"); direction == "download" {
+			// Files downloaded from the machine being built aren't inputs of the build
+			continue
+		}
+		for _, attr := range fileAttributes {
+			paths = append(paths, p.Attributes[attr]...)
+		}
+	}
+	return paths, nil
+}
+
+// jsonProvisionerFiles returns the paths referenced by the provisioners of a JSON template.
+func jsonProvisionerFiles(template string, b []byte) ([]string, error) {
+	var t struct {
+		Provisioners []map[string]interface{} `json:"provisioners"`
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return nil, fmt.Errorf("parsing packer template %q: %w", template, err)
+	}
+
+	var paths []string
+	for _, p := range t.Provisioners {
+		if p["direction"] == "download" {
+			continue
+		}
+		for _, attr := range fileAttributes {
+			switch v := p[attr].(type) {
+			case string:
+				paths = append(paths, v)
+			case []interface{}:
+				for _, s := range v {
+					if s, ok := s.(string); ok {
+						paths = append(paths, s)
+					}
+				}
+			}
+		}
+	}
+	return paths, nil
+}
+
+// walkFiles returns the file, or the files in the directory, at a path. Missing paths are ignored.
+func walkFiles(path string) ([]string, error) {
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	var files []string
+	if err := walk.From(path).WhenIsFile().Do(func(p string, _ walk.Dirent) error {
+		files = append(files, p)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("walking %q: %w", path, err)
+	}
+	return files, nil
+}
+
+// relativePaths makes the paths relative to the workspace, keeping those outside of it absolute.
+func relativePaths(workspace string, paths []string) ([]string, error) {
+	absWorkspace, err := filepath.Abs(workspace)
+	if err != nil {
+		return nil, fmt.Errorf("could not get absolute path of workspace %q: %w", workspace, err)
+	}
+
+	var rel []string
+	for _, p := range paths {
+		abs, err := filepath.Abs(p)
+		if err != nil {
+			return nil, err
+		}
+		r, err := filepath.Rel(absWorkspace, abs)
+		if err != nil || strings.HasPrefix(r, "..") {
+			r = abs
+		}
+		rel = append(rel, r)
+	}
+	sort.Strings(rel)
+	return rel, nil
+}
+
+// GetEnv returns the environment variables that set the variables of a Packer template,
+// from Skaffold's environment and the artifact's `env`.
+func GetEnv(a *latest.PackerArtifact) map[string]string {
+	env := map[string]string{}
+	for _, kv := range append(util.OSEnviron(), a.Env...) {
+		if key, value, found := strings.Cut(kv, "="); found && strings.HasPrefix(key, varsEnvPrefix) {
+			env[key] = value
+		}
+	}
+	return env
+}
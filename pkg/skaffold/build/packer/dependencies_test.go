@@ -0,0 +1,192 @@
+package packer
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+const testTemplate = `
+source "docker" "ubuntu" {
+  image  = "ubuntu:22.04"
+  commit = true
+}
+
+build {
+  sources = ["source.docker.ubuntu"]
+
+  provisioner "file" {
+    source      = "config/app.conf"
+    destination = "/etc/app.conf"
+  }
+
+  provisioner "file" {
+    direction   = "download"
+    source      = "/var/log/build.log"
+    destination = "logs/build.log"
+  }
+
+  provisioner "shell" {
+    execute_command = "sudo sh -c '{{ .Vars }} {{ .Path }}'"
+    inline_shebang  = <<EOF
+/bin/sh -c '}'
+EOF
+    scripts = [
+      "scripts/setup.sh",
+      "${path.root}/scripts/cleanup.sh",
+    ]
+    environment_vars = ["FOO=${var.foo}"]
+  }
+
+  provisioner "ansible" {
+    playbook_file = "ansible/playbook.yml"
+    role_paths    = ["ansible/roles"]
+  }
+
+  provisioner "shell" {
+    script = "${var.script}"
+  }
+
+  provisioner "shell-local" {
+    inline = ["echo done"]
+  }
+}
+`
+
+const testJSONTemplate = `{
+  "builders": [{"type": "docker", "image": "ubuntu:22.04", "commit": true}],
+  "provisioners": [
+    {"type": "file", "source": "config/app.conf", "destination": "/etc/app.conf"},
+    {"type": "shell", "scripts": ["scripts/setup.sh"]}
+  ]
+}`
+
+func TestGetDependencies(t *testing.T) {
+	tests := []struct {
+		description  string
+		files        map[string]string
+		templatePath string
+		buildArgs    []string
+		dependencies *latest.PackerDependencies
+		expected     []string
+	}{
+		{
+			description:  "hcl template",
+			templatePath: "image.pkr.hcl",
+			files: map[string]string{
+				"image.pkr.hcl":              testTemplate,
+				"image.pkrvars.hcl":          `foo = "bar"`,
+				"config/app.conf":            "",
+				"scripts/setup.sh":           "",
+				"scripts/cleanup.sh":         "",
+				"ansible/playbook.yml":       "",
+				"ansible/roles/app/main.yml": "",
+				"unrelated.txt":              "",
+			},
+			expected: []string{
+				"ansible/playbook.yml",
+				"ansible/roles/app/main.yml",
+				"config/app.conf",
+				"image.pkr.hcl",
+				"image.pkrvars.hcl",
+				"scripts/cleanup.sh",
+				"scripts/setup.sh",
+			},
+		},
+		{
+			description:  "template directory",
+			templatePath: "packer",
+			files: map[string]string{
+				"packer/sources.pkr.hcl":    `source "docker" "ubuntu" {}`,
+				"packer/build.pkr.hcl":      "build {\n  provisioner \"shell\" {\n    script = \"scripts/setup.sh\"\n  }\n}",
+				"packer/prod.pkrvars.hcl":   "",
+				"packer/README.md":          "",
+				"scripts/setup.sh":          "",
+				"packer/modules/module.txt": "",
+			},
+			expected: []string{
+				"packer/build.pkr.hcl",
+				"packer/prod.pkrvars.hcl",
+				"packer/sources.pkr.hcl",
+				"scripts/setup.sh",
+			},
+		},
+		{
+			description:  "json template",
+			templatePath: "image.json",
+			files: map[string]string{
+				"image.json":       testJSONTemplate,
+				"config/app.conf":  "",
+				"scripts/setup.sh": "",
+			},
+			expected: []string{
+				"config/app.conf",
+				"image.json",
+				"scripts/setup.sh",
+			},
+		},
+		{
+			description:  "var files in build args",
+			templatePath: "image.pkr.hcl",
+			buildArgs:    []string{"-var-file=vars/dev.hcl", "-var-file", "vars/common.hcl", "-force"},
+			files: map[string]string{
+				"image.pkr.hcl":   `build {}`,
+				"vars/dev.hcl":    "",
+				"vars/common.hcl": "",
+			},
+			expected: []string{
+				"image.pkr.hcl",
+				"vars/common.hcl",
+				"vars/dev.hcl",
+			},
+		},
+		{
+			description:  "explicit dependencies",
+			templatePath: "image.pkr.hcl",
+			dependencies: &latest.PackerDependencies{Paths: []string{"**"}, Ignore: []string{"docs/**"}},
+			files: map[string]string{
+				"image.pkr.hcl":  testTemplate,
+				"src/main.go":    "",
+				"docs/README.md": "",
+			},
+			expected: []string{
+				"image.pkr.hcl",
+				"src/main.go",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+
+			deps, err := GetDependencies(context.Background(), tmpDir.Root(), &latest.PackerArtifact{
+				TemplatePath: test.templatePath,
+				BuildArgs:    test.buildArgs,
+				Dependencies: test.dependencies,
+			})
+
+			var expected []string
+			for _, e := range test.expected {
+				expected = append(expected, filepath.FromSlash(e))
+			}
+			t.CheckNoError(err)
+			t.CheckDeepEqual(expected, deps)
+		})
+	}
+}
+
+func TestGetEnv(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.OSEnviron, func() []string {
+			return []string{"PATH=/usr/bin", "PKR_VAR_region=us-east-1"}
+		})
+
+		env := GetEnv(&latest.PackerArtifact{Env: []string{"PKR_VAR_size=small", "PACKER_LOG=1"}})
+
+		t.CheckDeepEqual(map[string]string{"PKR_VAR_region": "us-east-1", "PKR_VAR_size": "small"}, env)
+	})
+}
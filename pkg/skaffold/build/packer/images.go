@@ -0,0 +1,95 @@
+package packer
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/hcl"
+)
+
+// ProducesImages returns true if the template of a Packer artifact builds Docker images, with the `docker` builder
+// or the `docker-import` post-processor. Building them requires the Docker CLI and a Docker daemon.
+func ProducesImages(workspace string, a *latest.PackerArtifact) (bool, error) {
+	_, template := templatePath(workspace, a)
+	templates, err := templateFiles(template)
+	if err != nil {
+		return false, err
+	}
+
+	for _, t := range templates {
+		if strings.HasSuffix(t, ".pkrvars.hcl") || strings.HasSuffix(t, ".pkrvars.json") {
+			continue
+		}
+		b, err := os.ReadFile(t)
+		if err != nil {
+			return false, fmt.Errorf("reading packer template %q: %w", t, err)
+		}
+
+		var images bool
+		if strings.HasSuffix(t, ".json") {
+			images, err = jsonProducesImages(t, b)
+		} else {
+			images, err = hclProducesImages(t, b)
+		}
+		if images || err != nil {
+			return images, err
+		}
+	}
+	return false, nil
+}
+
+func hclProducesImages(template string, b []byte) (bool, error) {
+	blocks, err := hcl.Parse(b, template)
+	if err != nil {
+		return false, fmt.Errorf("parsing packer template %q: %w", template, err)
+	}
+
+	for _, source := range hcl.Find(blocks, "source") {
+		if len(source.Labels) > 0 && source.Labels[0] == "docker" {
+			return true, nil
+		}
+	}
+	for _, pp := range hcl.Find(blocks, "post-processor") {
+		if len(pp.Labels) > 0 && pp.Labels[0] == "docker-import" {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func jsonProducesImages(template string, b []byte) (bool, error) {
+	var t struct {
+		Builders       []map[string]interface{} `json:"builders"`
+		PostProcessors []interface{}            `json:"post-processors"`
+	}
+	if err := json.Unmarshal(b, &t); err != nil {
+		return false, fmt.Errorf("parsing packer template %q: %w", template, err)
+	}
+
+	for _, builder := range t.Builders {
+		if builder["type"] == "docker" {
+			return true, nil
+		}
+	}
+	// Post-processors are names, definitions, or sequences of them.
+	var isImport func(pp interface{}) bool
+	isImport = func(pp interface{}) bool {
+		switch v := pp.(type) {
+		case string:
+			return v == "docker-import"
+		case map[string]interface{}:
+			return v["type"] == "docker-import"
+		case []interface{}:
+			for _, p := range v {
+				if isImport(p) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	return isImport(t.PostProcessors), nil
+}
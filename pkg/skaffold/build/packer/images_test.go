@@ -0,0 +1,70 @@
+package packer
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestProducesImages(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		expected    bool
+	}{
+		{
+			description: "docker source",
+			files:       map[string]string{"image.pkr.hcl": testTemplate},
+			expected:    true,
+		},
+		{
+			description: "machine image",
+			files: map[string]string{"image.pkr.hcl": `source "amazon-ebs" "ubuntu" {
+  ami_name = "app-{{timestamp}}"
+}
+
+build {
+  sources = ["source.amazon-ebs.ubuntu"]
+}`},
+		},
+		{
+			description: "docker-import post-processor",
+			files: map[string]string{"image.pkr.hcl": `build {
+  sources = ["source.qemu.ubuntu"]
+
+  post-processor "docker-import" {
+    repository = "app"
+  }
+}`},
+			expected: true,
+		},
+		{
+			description: "json docker builder",
+			files:       map[string]string{"image.json": testJSONTemplate},
+			expected:    true,
+		},
+		{
+			description: "json docker-import post-processor sequence",
+			files: map[string]string{"image.json": `{
+  "builders": [{"type": "qemu"}],
+  "post-processors": [[{"type": "docker-import", "repository": "app"}, "docker-push"]]
+}`},
+			expected: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().WriteFiles(test.files)
+			template := "image.pkr.hcl"
+			if _, found := test.files["image.json"]; found {
+				template = "image.json"
+			}
+
+			images, err := ProducesImages(tmpDir.Root(), &latest.PackerArtifact{TemplatePath: template})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, images)
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package packer
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// For testing
+var (
+	ValidateTemplate = validateTemplate
+)
+
+// Name is the name of the Packer builder
+var Name = "Packer"
+
+var (
+	// buildBlockRegex matches the `build` blocks of HCL templates, which separates them from the files that only declare variables or sources.
+	buildBlockRegex = regexp.MustCompile(`(?m)^\s*build\s*\{`)
+	// buildersKeyRegex matches the `builders` of legacy JSON templates.
+	buildersKeyRegex = regexp.MustCompile(`"builders"\s*:`)
+	// repositoryRegex matches the literal repository of a `docker-tag` post-processor.
+	repositoryRegex = regexp.MustCompile(`post-processor\s+"docker-tag"\s*\{[^}]*?\brepository\s*=\s*"([^"$]+)"`)
+)
+
+// ArtifactConfig holds information about a Packer template
+type ArtifactConfig struct {
+	File  string `json:"path,omitempty"`
+	Image string `json:"image,omitempty"`
+}
+
+// NewArtifactConfig returns the Packer builder for a template, with the image that its `docker-tag` post-processor configures.
+func NewArtifactConfig(path string) ArtifactConfig {
+	c := ArtifactConfig{File: path}
+	if b, err := os.ReadFile(path); err == nil {
+		if m := repositoryRegex.FindSubmatch(b); m != nil {
+			c.Image = string(m[1])
+		}
+	}
+	return c
+}
+
+// Name returns the name of the builder
+func (c ArtifactConfig) Name() string {
+	return Name
+}
+
+// Describe returns the initBuilder's string representation, used when prompting the user to choose a builder.
+func (c ArtifactConfig) Describe() string {
+	return fmt.Sprintf("%s (%s)", c.Name(), c.File)
+}
+
+// ArtifactType returns the type of the artifact to be built.
+// Templates split across several files of their directory are built from the directory.
+func (c ArtifactConfig) ArtifactType(workspace string) latest.ArtifactType {
+	template := filepath.Base(c.File)
+	if isSplitTemplate(c.File) {
+		template = "."
+	}
+	if dir, err := filepath.Rel(workspace, filepath.Dir(c.File)); err == nil && dir != "." {
+		template = filepath.Join(dir, template)
+	}
+
+	return latest.ArtifactType{
+		PackerArtifact: &latest.PackerArtifact{
+			TemplatePath: filepath.ToSlash(template),
+		},
+	}
+}
+
+// ConfiguredImage returns the target image configured by the builder, or empty string if no image is configured
+func (c ArtifactConfig) ConfiguredImage() string {
+	return c.Image
+}
+
+// Path returns the path to the build definition
+func (c ArtifactConfig) Path() string {
+	return c.File
+}
+
+// validateTemplate checks if a file is a Packer template that builds something.
+func validateTemplate(path string) bool {
+	var blockRegex *regexp.Regexp
+	switch {
+	case strings.HasSuffix(path, ".pkr.hcl"):
+		blockRegex = buildBlockRegex
+	case strings.HasSuffix(path, ".pkr.json"):
+		blockRegex = buildersKeyRegex
+	default:
+		return false
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return false
+	}
+	return blockRegex.Match(b)
+}
+
+// isSplitTemplate checks if the directory of a HCL template holds other template files, that Packer only reads
+// when it's given the directory.
+func isSplitTemplate(path string) bool {
+	if !strings.HasSuffix(path, ".pkr.hcl") {
+		return false
+	}
+	files, err := filepath.Glob(filepath.Join(filepath.Dir(path), "*.pkr.hcl"))
+	return err == nil && len(files) > 1
+}
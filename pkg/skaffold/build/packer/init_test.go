@@ -0,0 +1,136 @@
+package packer
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		description   string
+		path          string
+		content       string
+		expectedValid bool
+	}{
+		{
+			description:   "hcl template",
+			path:          "image.pkr.hcl",
+			content:       "build {\n  sources = [\"source.docker.ubuntu\"]\n}\n",
+			expectedValid: true,
+		},
+		{
+			description:   "hcl variables",
+			path:          "variables.pkr.hcl",
+			content:       "variable \"image_tag\" {}\n",
+			expectedValid: false,
+		},
+		{
+			description:   "json template",
+			path:          "image.pkr.json",
+			content:       `{"builders": [{"type": "docker"}]}`,
+			expectedValid: true,
+		},
+		{
+			description:   "variable file",
+			path:          "prod.pkrvars.hcl",
+			content:       "build = true\n",
+			expectedValid: false,
+		},
+		{
+			description:   "terraform",
+			path:          "main.tf",
+			content:       "build {}\n",
+			expectedValid: false,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Write(test.path, test.content).Chdir()
+
+			t.CheckDeepEqual(test.expectedValid, ValidateTemplate(test.path))
+		})
+	}
+}
+
+func TestArtifactConfig(t *testing.T) {
+	tests := []struct {
+		description   string
+		files         map[string]string
+		path          string
+		workspace     string
+		expectedImage string
+		expectedType  latest.ArtifactType
+	}{
+		{
+			description: "template file",
+			files:       map[string]string{"image/image.pkr.hcl": "build {}\n"},
+			path:        "image/image.pkr.hcl",
+			workspace:   "image",
+			expectedType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: "image.pkr.hcl"},
+			},
+		},
+		{
+			description: "template directory",
+			files: map[string]string{
+				"image/build.pkr.hcl":     "build {}\n",
+				"image/variables.pkr.hcl": "variable \"image_tag\" {}\n",
+			},
+			path:      "image/build.pkr.hcl",
+			workspace: "image",
+			expectedType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: "."},
+			},
+		},
+		{
+			description: "template in a sub-directory of the workspace",
+			files:       map[string]string{"packer/image.pkr.hcl": "build {}\n"},
+			path:        "packer/image.pkr.hcl",
+			workspace:   ".",
+			expectedType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: "packer/image.pkr.hcl"},
+			},
+		},
+		{
+			description: "docker-tag post-processor",
+			files: map[string]string{"image.pkr.hcl": `build {
+  post-processor "docker-tag" {
+    repository = "gcr.io/project/image"
+    tags       = ["latest"]
+  }
+}`},
+			path:          "image.pkr.hcl",
+			workspace:     ".",
+			expectedImage: "gcr.io/project/image",
+			expectedType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: "image.pkr.hcl"},
+			},
+		},
+		{
+			description: "docker-tag post-processor with a variable repository",
+			files: map[string]string{"image.pkr.hcl": `build {
+  post-processor "docker-tag" {
+    repository = "${var.image_name}"
+  }
+}`},
+			path:      "image.pkr.hcl",
+			workspace: ".",
+			expectedType: latest.ArtifactType{
+				PackerArtifact: &latest.PackerArtifact{TemplatePath: "image.pkr.hcl"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			c := NewArtifactConfig(test.path)
+
+			t.CheckDeepEqual(test.expectedImage, c.ConfiguredImage())
+			t.CheckDeepEqual(test.expectedType, c.ArtifactType(test.workspace))
+			t.CheckDeepEqual("Packer ("+test.path+")", c.Describe())
+		})
+	}
+}
@@ -6,6 +6,9 @@ import (
 	"io"
 	"os/exec"
 	"path/filepath"
+	"strings"
+
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
@@ -13,52 +16,194 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 )
 
+// for testing
+var createManifestList = docker.CreateManifestList
+
 type Builder struct {
 	cfg         docker.Config
 	localPacker docker.LocalDaemon
+	pushImages  bool
+	platforms   platform.Matcher
 }
 
-func NewBuilder(cfg docker.Config, localPacker docker.LocalDaemon) *Builder {
+// NewBuilder returns a builder for Packer artifacts.
+// `platforms` lists the platforms the artifact's template can build images for, any platform when empty.
+func NewBuilder(cfg docker.Config, localPacker docker.LocalDaemon, pushImages bool, platforms []string) (*Builder, error) {
+	supported := platform.All
+	if len(platforms) > 0 {
+		m, err := platform.Parse(platforms)
+		if err != nil {
+			return nil, fmt.Errorf("parsing packer platforms: %w", err)
+		}
+		supported = m
+	}
+
 	return &Builder{
 		cfg:         cfg,
 		localPacker: localPacker,
-	}
+		pushImages:  pushImages,
+		platforms:   supported,
+	}, nil
 }
 
+// Build runs `packer build` once per target platform, and returns a reference to what the template produced.
+// Images are referenced by tag and digest when pushed, by tag and image ID otherwise, and images built for
+// multiple platforms are assembled into a manifest list. Other artifacts, such as machine images, are referenced by their ID.
 func (b *Builder) Build(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string, platforms platform.Matcher) (string, error) {
 	if artifact.PackerArtifact == nil {
 		return "", fmt.Errorf("packer artifact is nil")
 	}
+	if err := Validate(artifact); err != nil {
+		return "", err
+	}
 
 	// Run packer init before building
 	if err := b.PackerInit(ctx, out, artifact); err != nil {
 		return "", fmt.Errorf("packer init failed: %w", err)
 	}
 
-	args := []string{"build"}
-	args = append(args, artifact.PackerArtifact.BuildArgs...)
-	args = append(args, "-var", fmt.Sprintf("image_name=%s", artifact.ImageName))
-	args = append(args, "-var", fmt.Sprintf("image_tag=%s", tag))
-	// if len(artifact.PackerArtifact.PostProcessors) > 0 {
-	// 	args = append(args, "-only", fmt.Sprintf("'%s'", artifact.PackerArtifact.PostProcessors))
-	// }
+	if !platforms.IsMultiPlatform() {
+		var pl *specs.Platform
+		if len(platforms.Platforms) == 1 {
+			pl = &platforms.Platforms[0]
+		}
+		artifacts, err := b.runBuild(ctx, out, artifact, tag, pl)
+		if err != nil {
+			return "", err
+		}
+		if id, ok := machineImage(artifacts); ok {
+			return MachineImageResult(id), nil
+		}
+		return b.imageRef(ctx, out, tag)
+	}
+
+	if !b.pushImages {
+		return "", fmt.Errorf("building packer artifact %q for multiple platforms requires pushing images to a registry", artifact.ImageName)
+	}
+
+	var images []docker.SinglePlatformImage
+	for i := range platforms.Platforms {
+		pl := platforms.Platforms[i]
+		tagWithPlatform := fmt.Sprintf("%s_%s", tag, strings.ReplaceAll(platform.Format(pl), "/", "_"))
+		artifacts, err := b.runBuild(ctx, out, artifact, tagWithPlatform, &pl)
+		if err != nil {
+			return "", err
+		}
+		if _, ok := machineImage(artifacts); ok {
+			return "", fmt.Errorf("packer artifact %q can only be built for multiple platforms when its template produces images", artifact.ImageName)
+		}
+
+		ref, err := b.imageRef(ctx, out, tagWithPlatform)
+		if err != nil {
+			return "", err
+		}
+		v1Platform := util.ConvertToV1Platform(pl)
+		images = append(images, docker.SinglePlatformImage{
+			Platform: &v1Platform,
+			Image:    ref,
+		})
+	}
+
+	ref, err := createManifestList(ctx, images, tag)
+	if err != nil {
+		return "", fmt.Errorf("creating manifest list for %q: %w", artifact.ImageName, err)
+	}
+	return ref, nil
+}
 
-	args = append(args, artifact.PackerArtifact.TemplatePath)
+// runBuild runs `packer build` for a single platform, or the template's default platform when pl is nil,
+// and returns the artifacts that were produced.
+func (b *Builder) runBuild(ctx context.Context, out io.Writer, artifact *latest.Artifact, tag string, pl *specs.Platform) ([]packerArtifact, error) {
+	args := append([]string{"build", "-machine-readable"}, BuildArgs(artifact, artifact.PackerArtifact.TemplatePath, tag, pl)...)
 
+	w := newMachineReadableWriter(out)
 	cmd := exec.CommandContext(ctx, "packer", args...)
 	cmd.Env = append(util.OSEnviron(), artifact.PackerArtifact.Env...)
 	cmd.Dir = b.getContextDir(artifact)
-	cmd.Stdout = out
-	cmd.Stderr = out
+	cmd.Stdout = w
+	cmd.Stderr = w
 
-	if err := util.RunCmd(ctx, cmd); err != nil {
-		return "", fmt.Errorf("packer build failed: %w", err)
+	err := util.RunCmd(ctx, cmd)
+	if flushErr := w.Flush(); err == nil {
+		err = flushErr
+	}
+	if err != nil {
+		return nil, fmt.Errorf("packer build failed: %w", err)
+	}
+	return w.Artifacts(), nil
+}
+
+// imageRef returns the reference to an image built in the local Docker daemon, which is pushed when images are pushed.
+func (b *Builder) imageRef(ctx context.Context, out io.Writer, tag string) (string, error) {
+	if b.pushImages {
+		digest, err := b.localPacker.Push(ctx, out, tag)
+		if err != nil {
+			return "", err
+		}
+		return docker.TagWithDigest(tag, digest), nil
+	}
+
+	imageID, err := b.localPacker.ImageID(ctx, tag)
+	if err != nil {
+		return "", err
+	}
+	return b.localPacker.TagWithImageID(ctx, tag, imageID)
+}
+
+// Validate checks the options of a Packer artifact that can't be combined.
+func Validate(artifact *latest.Artifact) error {
+	if len(artifact.PackerArtifact.PostProcessors) > 0 && len(artifact.PackerArtifact.Except) > 0 {
+		return fmt.Errorf("packer artifact %q can't set both postProcessors and except", artifact.ImageName)
+	}
+	return nil
+}
+
+// BuildArgs returns the arguments passed to `packer build` to build an artifact from the given template with the given tag,
+// for a single platform, or the template's default platform when pl is nil.
+// The template receives the image name and tag in the `image_name` and `image_tag` variables.
+func BuildArgs(artifact *latest.Artifact, template string, tag string, pl *specs.Platform) []string {
+	var args []string
+	if len(artifact.PackerArtifact.PostProcessors) > 0 {
+		args = append(args, "-only="+strings.Join(artifact.PackerArtifact.PostProcessors, ","))
 	}
+	if len(artifact.PackerArtifact.Except) > 0 {
+		args = append(args, "-except="+strings.Join(artifact.PackerArtifact.Except, ","))
+	}
+	args = append(args, artifact.PackerArtifact.BuildArgs...)
+	args = append(args, "-var", fmt.Sprintf("image_name=%s", artifact.ImageName))
+	args = append(args, "-var", fmt.Sprintf("image_tag=%s", tag))
+	if pl != nil {
+		args = append(args, platformVars(*pl)...)
+	}
+	return append(args, template)
+}
 
-	// TODO: Implement platform-specific logic if needed
-	// For now, we're ignoring the platforms parameter
+// RemoteTemplatePath returns the path of the artifact's template relative to its build context, with forward slashes,
+// for the builds that run on a copy of the build context, in a cluster or on Cloud Build.
+func RemoteTemplatePath(artifact *latest.Artifact) (string, error) {
+	contextDir, template := templatePath(artifact.Workspace, artifact.PackerArtifact)
+	absContextDir, err := filepath.Abs(contextDir)
+	if err != nil {
+		return "", err
+	}
+	absTemplate, err := filepath.Abs(template)
+	if err != nil {
+		return "", err
+	}
+	rel, err := filepath.Rel(absContextDir, absTemplate)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("packer template %q of artifact %q isn't in its build context %q", template, artifact.ImageName, contextDir)
+	}
+	return filepath.ToSlash(rel), nil
+}
 
-	return b.localPacker.ImageID(ctx, tag)
+// platformVars returns the variables that tell the template which platform to build the image for.
+func platformVars(pl specs.Platform) []string {
+	return []string{
+		"-var", fmt.Sprintf("target_platform=%s", platform.Format(pl)),
+		"-var", fmt.Sprintf("target_os=%s", pl.OS),
+		"-var", fmt.Sprintf("target_arch=%s", pl.Architecture),
+	}
 }
 
 func (b *Builder) PackerInit(ctx context.Context, out io.Writer, a *latest.Artifact) error {
@@ -81,10 +226,9 @@ func (b *Builder) PackerInit(ctx context.Context, out io.Writer, a *latest.Artif
 	return nil
 }
 
+// SupportedPlatforms returns the platforms listed in the artifact's `platforms`, or all platforms when none are listed.
 func (b *Builder) SupportedPlatforms() platform.Matcher {
-	// TODO: Implement proper platform support for Packer
-	// For now, we're returning platform.All, which might not be accurate for all cases
-	return platform.All
+	return b.platforms
 }
 
 func (b *Builder) getContextDir(artifact *latest.Artifact) string {
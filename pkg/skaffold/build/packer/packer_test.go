@@ -8,11 +8,16 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
+	specs "github.com/opencontainers/image-spec/specs-go/v1"
+
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -86,7 +91,8 @@ func TestPackerBuild(t *testing.T) {
 
 	cfg := &mockConfig{}
 	localDaemon := &mockLocalDaemon{}
-	b := NewBuilder(cfg, localDaemon)
+	b, err := NewBuilder(cfg, localDaemon, false, nil)
+	assert.NoError(t, err)
 
 	ctx := context.Background()
 	out := &bytes.Buffer{}
@@ -112,17 +118,263 @@ func TestPackerInit(t *testing.T) {
 
 	cfg := &mockConfig{}
 	localDaemon := &mockLocalDaemon{}
-	b := NewBuilder(cfg, localDaemon)
+	b, err := NewBuilder(cfg, localDaemon, false, nil)
+	assert.NoError(t, err)
 
 	ctx := context.Background()
 	out := &bytes.Buffer{}
 
-	err := b.PackerInit(ctx, out, artifact)
+	err = b.PackerInit(ctx, out, artifact)
 
 	assert.NoError(t, err)
 	assert.Contains(t, out.String(), "No plugins requirement found")
 }
 
+func TestSupportedPlatforms(t *testing.T) {
+	tests := []struct {
+		description string
+		platforms   []string
+		expected    platform.Matcher
+		shouldErr   bool
+	}{
+		{
+			description: "any platform",
+			expected:    platform.All,
+		},
+		{
+			description: "listed platforms",
+			platforms:   []string{"linux/amd64", "linux/arm64"},
+			expected: platform.Matcher{Platforms: []specs.Platform{
+				{OS: "linux", Architecture: "amd64"},
+				{OS: "linux", Architecture: "arm64"},
+			}},
+		},
+		{
+			description: "unknown platform",
+			platforms:   []string{"linux/foo"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			b, err := NewBuilder(&mockConfig{}, &mockLocalDaemon{}, false, test.platforms)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, b.SupportedPlatforms())
+			}
+		})
+	}
+}
+
+func TestPackerBuildForPlatform(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("packer init template.pkr.hcl").
+			AndRun("packer build -machine-readable -var image_name=test-image -var image_tag=test-image:latest -var target_platform=linux/arm64 -var target_os=linux -var target_arch=arm64 template.pkr.hcl"))
+
+		artifact := &latest.Artifact{
+			ImageName:    "test-image",
+			ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{TemplatePath: "template.pkr.hcl"}},
+		}
+		b, err := NewBuilder(&mockConfig{}, &mockLocalDaemon{}, false, nil)
+		t.CheckNoError(err)
+
+		imageID, err := b.Build(context.Background(), &bytes.Buffer{}, artifact, "test-image:latest", platform.Matcher{Platforms: []specs.Platform{{OS: "linux", Architecture: "arm64"}}})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("test-image:image-id", imageID)
+	})
+}
+
+func TestPackerBuildMultiPlatform(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("packer init template.pkr.hcl").
+			AndRun("packer build -machine-readable -var image_name=gcr.io/p/img -var image_tag=gcr.io/p/img:v1_linux_amd64 -var target_platform=linux/amd64 -var target_os=linux -var target_arch=amd64 template.pkr.hcl").
+			AndRun("packer build -machine-readable -var image_name=gcr.io/p/img -var image_tag=gcr.io/p/img:v1_linux_arm64 -var target_platform=linux/arm64 -var target_os=linux -var target_arch=arm64 template.pkr.hcl"))
+
+		var manifestImages []docker.SinglePlatformImage
+		t.Override(&createManifestList, func(_ context.Context, images []docker.SinglePlatformImage, targetTag string) (string, error) {
+			manifestImages = images
+			return targetTag + "@sha256:list", nil
+		})
+
+		artifact := &latest.Artifact{
+			ImageName:    "gcr.io/p/img",
+			ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{TemplatePath: "template.pkr.hcl"}},
+		}
+		localDaemon := &mockLocalDaemon{}
+		b, err := NewBuilder(&mockConfig{}, localDaemon, true, nil)
+		t.CheckNoError(err)
+
+		digest, err := b.Build(context.Background(), &bytes.Buffer{}, artifact, "gcr.io/p/img:v1", platform.Matcher{Platforms: []specs.Platform{
+			{OS: "linux", Architecture: "amd64"},
+			{OS: "linux", Architecture: "arm64"},
+		}})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("gcr.io/p/img:v1@sha256:list", digest)
+		t.CheckDeepEqual([]string{"gcr.io/p/img:v1_linux_amd64", "gcr.io/p/img:v1_linux_arm64"}, localDaemon.pushed)
+		t.CheckDeepEqual(2, len(manifestImages))
+		t.CheckDeepEqual("gcr.io/p/img:v1_linux_amd64@sha256:v1linuxamd64", manifestImages[0].Image)
+		t.CheckDeepEqual("arm64", manifestImages[1].Platform.Architecture)
+	})
+}
+
+func TestPackerBuildMachineImage(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    latest.PackerArtifact
+		buildCmd    string
+	}{
+		{
+			description: "all builds",
+			artifact:    latest.PackerArtifact{TemplatePath: "ami.pkr.hcl"},
+			buildCmd:    "packer build -machine-readable -var image_name=base-ami -var image_tag=base-ami:v1 ami.pkr.hcl",
+		},
+		{
+			description: "only",
+			artifact:    latest.PackerArtifact{TemplatePath: "ami.pkr.hcl", PostProcessors: []string{"amazon-ebs.ubuntu", "manifest"}},
+			buildCmd:    "packer build -machine-readable -only=amazon-ebs.ubuntu,manifest -var image_name=base-ami -var image_tag=base-ami:v1 ami.pkr.hcl",
+		},
+		{
+			description: "except",
+			artifact:    latest.PackerArtifact{TemplatePath: "ami.pkr.hcl", Except: []string{"docker.ubuntu"}},
+			buildCmd:    "packer build -machine-readable -except=docker.ubuntu -var image_name=base-ami -var image_tag=base-ami:v1 ami.pkr.hcl",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, testutil.
+				CmdRun("packer init ami.pkr.hcl").
+				AndRunWithOutput(test.buildCmd, testAMIOutput))
+
+			artifact := &latest.Artifact{
+				ImageName:    "base-ami",
+				ArtifactType: latest.ArtifactType{PackerArtifact: &test.artifact},
+			}
+			b, err := NewBuilder(&mockConfig{}, &mockLocalDaemon{}, true, nil)
+			t.CheckNoError(err)
+
+			out := &bytes.Buffer{}
+			ref, err := b.Build(context.Background(), out, artifact, "base-ami:v1", platform.Matcher{})
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(MachineImageResult("us-east-1:ami-0123456789abcdef0,us-west-2:ami-0fedcba9876543210"), ref)
+			t.CheckDeepEqual("==> amazon-ebs.ubuntu: Creating AMI base-ami\n", out.String())
+		})
+	}
+}
+
+func TestPackerBuildOnlyAndExcept(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		artifact := &latest.Artifact{
+			ImageName: "test-image",
+			ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{
+				TemplatePath:   "template.pkr.hcl",
+				PostProcessors: []string{"docker-push"},
+				Except:         []string{"manifest"},
+			}},
+		}
+		b, err := NewBuilder(&mockConfig{}, &mockLocalDaemon{}, false, nil)
+		t.CheckNoError(err)
+
+		_, err = b.Build(context.Background(), &bytes.Buffer{}, artifact, "test-image:latest", platform.Matcher{})
+
+		t.CheckErrorContains("can't set both postProcessors and except", err)
+	})
+}
+
+func TestRemoteTemplatePath(t *testing.T) {
+	tests := []struct {
+		description string
+		workspace   string
+		template    string
+		absolute    bool
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "relative to the workspace",
+			workspace:   "app",
+			template:    "images/image.pkr.hcl",
+			expected:    "images/image.pkr.hcl",
+		},
+		{
+			description: "absolute path in the workspace",
+			workspace:   "app",
+			template:    "app/images/image.pkr.hcl",
+			absolute:    true,
+			expected:    "images/image.pkr.hcl",
+		},
+		{
+			description: "no workspace",
+			template:    "app/image.pkr.hcl",
+			absolute:    true,
+			expected:    "image.pkr.hcl",
+		},
+		{
+			description: "absolute path outside of the workspace",
+			workspace:   "app",
+			template:    "other/image.pkr.hcl",
+			absolute:    true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			root := t.NewTempDir().Root()
+			// absolute paths are like the paths of the templates of required configs
+			template := test.template
+			if test.absolute {
+				template = filepath.Join(root, test.template)
+			}
+			workspace := test.workspace
+			if workspace != "" {
+				workspace = filepath.Join(root, workspace)
+			}
+			artifact := &latest.Artifact{
+				ImageName:    "img",
+				Workspace:    workspace,
+				ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{TemplatePath: template}},
+			}
+
+			path, err := RemoteTemplatePath(artifact)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, path)
+		})
+	}
+}
+
+func TestPackerBuildMultiPlatformRequiresPush(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("packer init template.pkr.hcl"))
+
+		artifact := &latest.Artifact{
+			ImageName:    "test-image",
+			ArtifactType: latest.ArtifactType{PackerArtifact: &latest.PackerArtifact{TemplatePath: "template.pkr.hcl"}},
+		}
+		b, err := NewBuilder(&mockConfig{}, &mockLocalDaemon{}, false, nil)
+		t.CheckNoError(err)
+
+		_, err = b.Build(context.Background(), &bytes.Buffer{}, artifact, "test-image:latest", platform.Matcher{Platforms: []specs.Platform{
+			{OS: "linux", Architecture: "amd64"},
+			{OS: "linux", Architecture: "arm64"},
+		}})
+
+		t.CheckErrorContains("requires pushing images", err)
+	})
+}
+
+// testAMIOutput is the machine-readable output of a build that produces AMIs in two regions.
+const testAMIOutput = `1700000000,,ui,say,==> amazon-ebs.ubuntu: Creating AMI base-ami
+1700000001,amazon-ebs.ubuntu,artifact-count,1
+1700000001,amazon-ebs.ubuntu,artifact,0,builder-id,mitchellh.amazonebs
+1700000001,amazon-ebs.ubuntu,artifact,0,id,us-east-1:ami-0123456789abcdef0%!(PACKER_COMMA)us-west-2:ami-0fedcba9876543210
+1700000001,amazon-ebs.ubuntu,artifact,0,end
+`
+
 // Mock exec.Command
 func mockExecCommand(command string, args ...string) *exec.Cmd {
 	cs := []string{"-test.run=TestHelperProcess", "--", command}
@@ -180,12 +432,22 @@ type mockLocalDaemon struct {
 	built []struct {
 		tag string
 	}
+	pushed []string
 }
 
 func (m *mockLocalDaemon) ImageID(ctx context.Context, ref string) (string, error) {
 	return "image-id", nil
 }
 
+func (m *mockLocalDaemon) TagWithImageID(ctx context.Context, tag string, imageID string) (string, error) {
+	return strings.Split(tag, ":")[0] + ":" + imageID, nil
+}
+
+func (m *mockLocalDaemon) Push(ctx context.Context, out io.Writer, ref string) (string, error) {
+	m.pushed = append(m.pushed, ref)
+	return "sha256:" + strings.ReplaceAll(ref[strings.LastIndex(ref, ":")+1:], "_", ""), nil
+}
+
 func (m *mockLocalDaemon) Build(ctx context.Context, out io.Writer, workspace string, artifact string, dockerArtifact *latest.DockerArtifact, opts docker.BuildOptions) (string, error) {
 	m.built = append(m.built, struct {
 		tag string
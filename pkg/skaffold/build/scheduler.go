@@ -22,9 +22,12 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"sync/atomic"
+	"time"
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/misc"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/event"
@@ -45,18 +48,44 @@ type scheduler struct {
 	artifactBuilder ArtifactBuilder
 	logger          logAggregator
 	results         ArtifactStore
-	concurrencySem  countingSemaphore
+	slots           *buildSlots
+	dependents      [][]int         // size len(artifacts)
+	pending         []int32         // size len(artifacts)
+	criticalPaths   []time.Duration // size len(artifacts)
+	timeline        []TimelineEntry // size len(artifacts)
 	reportFailure   bool
 }
 
-func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, out io.Writer, store ArtifactStore) *scheduler {
+func newScheduler(artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, pools map[string]int, durations Durations, out io.Writer, store ArtifactStore) *scheduler {
+	nodes := createNodes(artifacts)
+	index := make(map[string]int)
+	for i, n := range nodes {
+		index[n.imageName] = i
+	}
+	dependents := make([][]int, len(nodes))
+	pending := make([]int32, len(nodes))
+	// every artifact without dependencies asks for a slot as soon as the build starts
+	var initial int
+	for i, n := range nodes {
+		for _, d := range n.dependencies {
+			dependents[index[d.imageName]] = append(dependents[index[d.imageName]], i)
+		}
+		pending[i] = int32(len(n.dependencies))
+		if len(n.dependencies) == 0 {
+			initial++
+		}
+	}
 	s := scheduler{
 		artifacts:       artifacts,
-		nodes:           createNodes(artifacts),
+		nodes:           nodes,
 		artifactBuilder: artifactBuilder,
 		logger:          newLogAggregator(out, len(artifacts), concurrency),
 		results:         store,
-		concurrencySem:  newCountingSemaphore(concurrency),
+		slots:           newBuildSlots(concurrency, pools, initial),
+		dependents:      dependents,
+		pending:         pending,
+		criticalPaths:   criticalPaths(artifacts, durations),
+		timeline:        make([]TimelineEntry, len(artifacts)),
 
 		// avoid visual stutters from reporting failures inline and Skaffold's final command output
 		reportFailure: concurrency != 1 && len(artifacts) > 1,
@@ -86,19 +115,31 @@ func (s *scheduler) run(ctx context.Context, tags tag.ImageTags, platforms platf
 	return s.results.GetArtifacts(s.artifacts)
 }
 
-func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, platforms platform.Resolver, i int) error {
+func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, platforms platform.Resolver, i int) (err error) {
 	n := s.nodes[i]
 	a := s.artifacts[i]
-	err := n.waitForDependencies(ctx)
+	err = n.waitForDependencies(ctx)
 	if err != nil {
 		// `waitForDependencies` only returns `context.Canceled` error
 		event.BuildCanceled(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
 		eventV2.BuildCanceled(a.ImageName, platforms.GetPlatforms(a.ImageName).String(), err)
 		return err
 	}
-	release := s.concurrencySem.acquire()
+	builder := misc.ArtifactType(a)
+	release, err := s.slots.acquire(ctx, builder, s.criticalPaths[i], i)
+	if err != nil {
+		event.BuildCanceled(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
+		eventV2.BuildCanceled(a.ImageName, platforms.GetPlatforms(a.ImageName).String(), err)
+		return err
+	}
 	defer release()
 
+	s.timeline[i] = TimelineEntry{ImageName: a.ImageName, Builder: builder, Start: time.Now()}
+	defer func() {
+		s.timeline[i].End = time.Now()
+		s.timeline[i].Failed = err != nil
+	}()
+
 	event.BuildInProgress(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
 	eventV2.BuildInProgress(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
 	ctx, endTrace := instrumentation.StartTrace(ctx, "build_BuildInProgress", map[string]string{
@@ -136,14 +177,34 @@ func (s *scheduler) build(ctx context.Context, tags tag.ImageTags, platforms pla
 
 	output.Default.Fprintf(w, "Build [%s] succeeded\n", a.ImageName)
 	s.results.Record(a, finalTag)
+	s.slots.expect(s.unblocked(i))
 	n.markComplete()
 	event.BuildComplete(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
 	eventV2.BuildSucceeded(a.ImageName, platforms.GetPlatforms(a.ImageName).String())
 	return nil
 }
 
+// unblocked returns how many dependents of the i-th artifact have all their dependencies built once it completes.
+func (s *scheduler) unblocked(i int) int {
+	var n int
+	for _, j := range s.dependents[i] {
+		if atomic.AddInt32(&s.pending[j], -1) == 0 {
+			n++
+		}
+	}
+	return n
+}
+
 // InOrder builds a list of artifacts in dependency order.
 func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, platforms platform.Resolver, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, store ArtifactStore) ([]graph.Artifact, error) {
+	ar, _, err := inOrder(ctx, out, tags, platforms, artifacts, artifactBuilder, concurrency, nil, nil, store)
+	return ar, err
+}
+
+// inOrder builds a list of artifacts in dependency order, starting first the artifacts on the critical path according to
+// the durations of previous builds, and limiting the concurrent builds of each artifact type to its pool.
+// It returns the timeline of the builds that started, even if the build failed.
+func inOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, platforms platform.Resolver, artifacts []*latest.Artifact, artifactBuilder ArtifactBuilder, concurrency int, pools map[string]int, durations Durations, store ArtifactStore) ([]graph.Artifact, Timeline, error) {
 	// `concurrency` specifies the max number of builds that can run at any one time. If concurrency is 0, then all builds can run in parallel.
 	if concurrency == 0 {
 		concurrency = len(artifacts)
@@ -151,10 +212,11 @@ func InOrder(ctx context.Context, out io.Writer, tags tag.ImageTags, platforms p
 	if concurrency > 1 {
 		output.Default.Fprintf(out, "Building %d artifacts in parallel\n", concurrency)
 	}
-	s := newScheduler(artifacts, artifactBuilder, concurrency, out, store)
+	s := newScheduler(artifacts, artifactBuilder, concurrency, pools, durations, out, store)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
-	return s.run(ctx, tags, platforms)
+	ar, err := s.run(ctx, tags, platforms)
+	return ar, newTimeline(s.timeline), err
 }
 
 func performBuild(ctx context.Context, cw io.Writer, tags tag.ImageTags, platforms platform.Resolver, artifact *latest.Artifact, build ArtifactBuilder) (string, error) {
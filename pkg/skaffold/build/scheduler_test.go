@@ -391,6 +391,86 @@ func TestInOrderForArgs(t *testing.T) {
 //
 // }
 // implies that a[0] artifact depends on a[1] and a[2]; and a[2] depends on a[3].
+func TestInOrderCriticalPathFirst(t *testing.T) {
+	tests := []struct {
+		description string
+		durations   Durations
+		expected    []string
+	}{
+		{
+			description: "declaration order without history",
+			expected:    []string{"quick", "base", "app"},
+		},
+		{
+			description: "longest chain first",
+			durations:   byImageName(map[string]time.Duration{"quick": 2 * time.Second, "base": time.Second, "app": 5 * time.Second}),
+			expected:    []string{"base", "app", "quick"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			artifacts := []*latest.Artifact{
+				{ImageName: "quick"},
+				{ImageName: "base"},
+				{ImageName: "app", Dependencies: []*latest.ArtifactDependency{{ImageName: "base"}}},
+			}
+			tags := tag.ImageTags{"quick": "quick:tag", "base": "base:tag", "app": "app:tag"}
+
+			var built []string
+			builder := func(_ context.Context, _ io.Writer, a *latest.Artifact, tag string, _ platform.Matcher) (string, error) {
+				built = append(built, a.ImageName)
+				return tag, nil
+			}
+
+			initializeEvents()
+			_, timeline, err := inOrder(context.Background(), io.Discard, tags, platform.Resolver{}, artifacts, builder, 1, nil, test.durations, NewArtifactStore())
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, built)
+			t.CheckDeepEqual(len(test.expected), len(timeline))
+		})
+	}
+}
+
+func TestInOrderConcurrencyPools(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		var artifacts []*latest.Artifact
+		tags := tag.ImageTags{}
+		for i := 0; i < 6; i++ {
+			a := &latest.Artifact{ImageName: fmt.Sprintf("docker%d", i), ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}}}
+			if i%2 == 0 {
+				a = &latest.Artifact{ImageName: fmt.Sprintf("jib%d", i), ArtifactType: latest.ArtifactType{JibArtifact: &latest.JibArtifact{}}}
+			}
+			artifacts = append(artifacts, a)
+			tags[a.ImageName] = a.ImageName + ":tag"
+		}
+
+		var jibBuilds, maxJibBuilds int32
+		builder := func(_ context.Context, _ io.Writer, a *latest.Artifact, tag string, _ platform.Matcher) (string, error) {
+			if a.JibArtifact != nil {
+				n := atomic.AddInt32(&jibBuilds, 1)
+				defer atomic.AddInt32(&jibBuilds, -1)
+				for {
+					m := atomic.LoadInt32(&maxJibBuilds)
+					if n <= m || atomic.CompareAndSwapInt32(&maxJibBuilds, m, n) {
+						break
+					}
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return tag, nil
+		}
+
+		initializeEvents()
+		results, timeline, err := inOrder(context.Background(), io.Discard, tags, platform.Resolver{}, artifacts, builder, 6, map[string]int{"jib": 1}, nil, NewArtifactStore())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(6, len(results))
+		t.CheckDeepEqual(int32(1), maxJibBuilds)
+		t.CheckDeepEqual(6, len(timeline))
+	})
+}
+
 func setDependencies(a []*latest.Artifact, d map[int][]int) {
 	for k, dep := range d {
 		for i := range dep {
@@ -0,0 +1,91 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	timeutil "github.com/ryanharper/skaffold/v2/pkg/skaffold/util/time"
+)
+
+const timelineWidth = 40
+
+// TimelineEntry records when a single artifact build started and ended.
+type TimelineEntry struct {
+	ImageName string
+	Builder   string
+	Start     time.Time
+	End       time.Time
+	Failed    bool
+}
+
+// Timeline records the artifact builds of a single build run, in the order they started.
+type Timeline []TimelineEntry
+
+// Lines renders the timeline as one line per artifact, with a bar showing when the build ran relative to the others.
+func (t Timeline) Lines() []string {
+	if len(t) == 0 {
+		return nil
+	}
+	start, end := t[0].Start, t[0].End
+	nameWidth, builderWidth := 0, 0
+	for _, e := range t {
+		if e.Start.Before(start) {
+			start = e.Start
+		}
+		if e.End.After(end) {
+			end = e.End
+		}
+		nameWidth = max(nameWidth, len(e.ImageName))
+		builderWidth = max(builderWidth, len(e.Builder))
+	}
+	total := end.Sub(start)
+
+	var lines []string
+	for _, e := range t {
+		from, to := 0, timelineWidth
+		if total > 0 {
+			from = int(int64(timelineWidth) * int64(e.Start.Sub(start)) / int64(total))
+			to = int(int64(timelineWidth) * int64(e.End.Sub(start)) / int64(total))
+		}
+		if to == from && to < timelineWidth {
+			to++
+		}
+		status := ""
+		if e.Failed {
+			status = " failed"
+		}
+		bar := strings.Repeat(" ", from) + strings.Repeat("=", to-from) + strings.Repeat(" ", timelineWidth-to)
+		lines = append(lines, fmt.Sprintf("%-*s  %-*s  |%s|  %s%s", nameWidth, e.ImageName, builderWidth, e.Builder, bar, timeutil.Humanize(e.End.Sub(e.Start)), status))
+	}
+	return lines
+}
+
+func newTimeline(entries []TimelineEntry) Timeline {
+	var t Timeline
+	for _, e := range entries {
+		// artifacts that never started, because of a failure or a cancellation, are left out
+		if !e.Start.IsZero() {
+			t = append(t, e)
+		}
+	}
+	sort.SliceStable(t, func(i, j int) bool { return t[i].Start.Before(t[j].Start) })
+	return t
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package build
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestTimelineLines(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	timeline := newTimeline([]TimelineEntry{
+		{ImageName: "app", Builder: "docker", Start: start.Add(10 * time.Second), End: start.Add(20 * time.Second)},
+		{ImageName: "base-image", Builder: "jib", Start: start, End: start.Add(10 * time.Second)},
+		{ImageName: "canceled"},
+		{ImageName: "web", Builder: "docker", Start: start.Add(10 * time.Second), End: start.Add(15 * time.Second), Failed: true},
+	})
+
+	testutil.CheckDeepEqual(t, []string{
+		"base-image  jib     |====================                    |  10 seconds",
+		"app         docker  |                    ====================|  10 seconds",
+		"web         docker  |                    ==========          |  5 seconds failed",
+	}, timeline.Lines())
+}
+
+func TestEmptyTimelineLines(t *testing.T) {
+	testutil.CheckDeepEqual(t, []string(nil), newTimeline(nil).Lines())
+}
@@ -18,7 +18,6 @@ package build
 
 import (
 	"context"
-	"strings"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
@@ -55,11 +54,7 @@ func MergeWithPreviousBuilds(builds, previous []graph.Artifact) []graph.Artifact
 }
 
 func TagWithDigest(tag, digest string) string {
-	digestSuffix := "@" + digest
-	if strings.HasSuffix(tag, digestSuffix) {
-		return tag
-	}
-	return tag + digestSuffix
+	return docker.TagWithDigest(tag, digest)
 }
 
 func TagWithImageID(ctx context.Context, tag string, imageID string, localDocker docker.LocalDaemon) (string, error) {
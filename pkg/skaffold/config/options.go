@@ -39,6 +39,7 @@ type SkaffoldOptions struct {
 	AutoSync                    bool
 	AssumeYes                   bool
 	CacheArtifacts              bool
+	ExplainCache                bool
 	ContainerDebugging          bool
 	Cleanup                     bool
 	DetectMinikube              bool
@@ -58,6 +59,7 @@ type SkaffoldOptions struct {
 	RenderOnly                  bool
 	SkipTests                   bool
 	SkipConfigDefaults          bool
+	UpdateLock                  bool
 	Tail                        bool
 	WaitForConnection           bool
 	AutoInit                    bool
@@ -96,6 +98,8 @@ type SkaffoldOptions struct {
 	TargetImages                []string
 	Profiles                    []string
 	InsecureRegistries          []string
+	SharedCaches                []string
+	BuildConcurrencyPools       []string
 	ConfigurationFilter         []string
 	HydratedManifests           []string
 	Platforms                   []string
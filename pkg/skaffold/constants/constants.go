@@ -51,12 +51,17 @@ const (
 
 	DefaultBusyboxImage = "gcr.io/k8s-skaffold/skaffold-helpers/busybox"
 
+	// DefaultPackerImage runs Packer builds on Cloud Build and in clusters. It doesn't have the Docker CLI.
+	DefaultPackerImage = "hashicorp/packer:light"
+
 	// DefaultDebugHelpersRegistry is the default location used for the helper images for `debug`.
 	DefaultDebugHelpersRegistry = "gcr.io/k8s-skaffold/skaffold-debug-support"
 
-	DefaultSkaffoldDir = ".skaffold"
-	DefaultCacheFile   = "cache"
-	DefaultMetricFile  = "metrics"
+	DefaultSkaffoldDir         = ".skaffold"
+	DefaultCacheFile           = "cache"
+	DefaultBuildDurationsFile  = "build-durations"
+	DefaultMetricFile          = "metrics"
+	DefaultVulnerabilitiesFile = "vulnerabilities"
 
 	// SkaffoldEnvFile is the file that is parsed to set environment variables in the process
 	SkaffoldEnvFile = "skaffold.env"
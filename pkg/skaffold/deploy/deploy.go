@@ -67,3 +67,10 @@ type Deployer interface {
 	// Returns the unique name of the config yaml file related with the Deployer
 	ConfigName() string
 }
+
+// Planner is implemented by deployers that can compute the changes a deploy
+// would make without applying them, for instance during `skaffold render`.
+type Planner interface {
+	// Plan previews the deployment of the build results without applying it.
+	Plan(context.Context, io.Writer, []graph.Artifact) error
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/status"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/sync"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringset"
 )
 
@@ -41,6 +42,8 @@ import (
 type DeployerMux struct {
 	iterativeStatusCheck bool
 	deployers            []Deployer
+	// templateVars are the values produced by the deployers, like Terraform outputs, that the manifests of the next deployers can reference.
+	templateVars *util.TemplateVars
 }
 
 type deployerWithHooks interface {
@@ -49,8 +52,14 @@ type deployerWithHooks interface {
 	PostDeployHooks(context.Context, io.Writer) error
 }
 
-func NewDeployerMux(deployers []Deployer, iterativeStatusCheck bool) Deployer {
-	return DeployerMux{deployers: deployers, iterativeStatusCheck: iterativeStatusCheck}
+// machineImageDeployer is implemented by the deployers that can deploy machine images, like the AMIs built by Packer.
+// The other deployers only get the artifacts that were built into container images.
+type machineImageDeployer interface {
+	DeploysMachineImages() bool
+}
+
+func NewDeployerMux(deployers []Deployer, iterativeStatusCheck bool, templateVars *util.TemplateVars) Deployer {
+	return DeployerMux{deployers: deployers, iterativeStatusCheck: iterativeStatusCheck, templateVars: templateVars}
 }
 
 func (m DeployerMux) GetDeployers() []Deployer {
@@ -130,7 +139,14 @@ func (m DeployerMux) Deploy(ctx context.Context, w io.Writer, as []graph.Artifac
 				return err
 			}
 		}
-		if err := deployer.Deploy(ctx, w, as, l); err != nil {
+		// Earlier deployers, like Terraform, can produce values that the manifests reference
+		manifests, err := l.SubstituteTemplateVars(m.templateVars.Values())
+		if err != nil {
+			eventV2.DeployFailed(i, err)
+			endTrace(instrumentation.TraceEndError(err))
+			return err
+		}
+		if err := deployer.Deploy(ctx, w, artifactsFor(deployer, as), manifests); err != nil {
 			eventV2.DeployFailed(i, err)
 			endTrace(instrumentation.TraceEndError(err))
 			return err
@@ -156,6 +172,28 @@ func (m DeployerMux) Deploy(ctx context.Context, w io.Writer, as []graph.Artifac
 	return nil
 }
 
+// Plan previews the deployment for every deployer that supports it.
+func (m DeployerMux) Plan(ctx context.Context, w io.Writer, as []graph.Artifact) error {
+	for _, deployer := range m.deployers {
+		planner, ok := deployer.(Planner)
+		if !ok {
+			continue
+		}
+		if err := planner.Plan(ctx, w, artifactsFor(deployer, as)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// artifactsFor returns the artifacts that the deployer can deploy.
+func artifactsFor(deployer Deployer, as []graph.Artifact) []graph.Artifact {
+	if d, ok := deployer.(machineImageDeployer); ok && d.DeploysMachineImages() {
+		return as
+	}
+	return graph.ContainerImages(as)
+}
+
 func (m DeployerMux) Dependencies() ([]string, error) {
 	deps := stringset.New()
 	for _, deployer := range m.deployers {
@@ -161,7 +161,7 @@ func TestDeployerMux_Deploy(t *testing.T) {
 			deployerMux := NewDeployerMux([]Deployer{
 				NewMockDeployer().WithDeployErr(test.err1),
 				NewMockDeployer().WithDeployErr(test.err2),
-			}, false)
+			}, false, nil)
 
 			err := deployerMux.Deploy(context.Background(), nil, nil, manifest.NewManifestListByConfig())
 
@@ -170,6 +170,24 @@ func TestDeployerMux_Deploy(t *testing.T) {
 	}
 }
 
+type machineImageMockDeployer struct {
+	*MockDeployer
+}
+
+func (m machineImageMockDeployer) DeploysMachineImages() bool {
+	return true
+}
+
+func TestArtifactsFor(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		image := graph.Artifact{ImageName: "app", Tag: "app:tag"}
+		ami := graph.Artifact{ImageName: "ami", MachineImage: "us-east-1:ami-0123456789abcdef0"}
+
+		t.CheckDeepEqual([]graph.Artifact{image}, artifactsFor(NewMockDeployer(), []graph.Artifact{image, ami}))
+		t.CheckDeepEqual([]graph.Artifact{image, ami}, artifactsFor(machineImageMockDeployer{NewMockDeployer()}, []graph.Artifact{image, ami}))
+	})
+}
+
 func TestDeployerMux_Dependencies(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -213,7 +231,7 @@ func TestDeployerMux_Dependencies(t *testing.T) {
 			deployerMux := NewDeployerMux([]Deployer{
 				NewMockDeployer().WithDependencies(test.deps1).WithDependenciesErr(test.err1),
 				NewMockDeployer().WithDependencies(test.deps2).WithDependenciesErr(test.err2),
-			}, false)
+			}, false, nil)
 
 			dependencies, err := deployerMux.Dependencies()
 			testutil.CheckErrorAndDeepEqual(t, test.shouldErr, err, test.expectedDeps, dependencies)
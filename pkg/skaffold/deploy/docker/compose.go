@@ -0,0 +1,409 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/compose-spec/compose-go/v2/cli"
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+
+	dockerutil "github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	schemautil "github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/util"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringslice"
+)
+
+const (
+	// composeProjectLabel and composeServiceLabel are the labels that Docker Compose sets on the containers of a project.
+	composeProjectLabel = "com.docker.compose.project"
+	composeServiceLabel = "com.docker.compose.service"
+
+	// composeDefaultNetwork is the network that Compose attaches services to when they don't list any.
+	composeDefaultNetwork = "default"
+)
+
+// supportedServiceKeys are the keys of a Compose service that the docker deployer applies to its container.
+// Services with `build` run the artifact built by Skaffold.
+var supportedServiceKeys = map[string]bool{
+	"build":          true,
+	"command":        true,
+	"container_name": true,
+	"entrypoint":     true,
+	"env_file":       true,
+	"environment":    true,
+	"image":          true,
+	"labels":         true,
+	"ports":          true,
+	"user":           true,
+	"volumes":        true,
+	"working_dir":    true,
+}
+
+// composeProject is a Docker Compose project, loaded by compose-go like `docker compose` does.
+type composeProject struct {
+	*types.Project
+
+	// dotEnvFiles are the files whose variables the Compose files are interpolated with.
+	dotEnvFiles []string
+	// unsupported lists the keys of the Compose files that the docker deployer ignores.
+	unsupported []string
+}
+
+// loadComposeProject loads the Compose file, or the default Compose files of the directory of the Skaffold config.
+// Compose interpolates them with the environment and the `.env` file, and resolves their env files and relative paths.
+func loadComposeProject(ctx context.Context, composeFile string, configDir string) (*composeProject, error) {
+	var paths []string
+	var opts []cli.ProjectOptionsFn
+	if composeFile != "" {
+		paths = []string{composeFile}
+	} else {
+		opts = append(opts, cli.WithWorkingDirectory(configDir), cli.WithDefaultConfigPath)
+	}
+	opts = append(opts, cli.WithEnvFiles(), cli.WithOsEnv, cli.WithDotEnv)
+
+	options, err := cli.NewProjectOptions(paths, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("reading Compose options: %w", err)
+	}
+	if len(options.ConfigPaths) == 0 {
+		return nil, fmt.Errorf("no Compose file found in %s: set `composeFile` or add one of %s", configDir, strings.Join(cli.DefaultFileNames, ", "))
+	}
+
+	p, err := options.LoadProject(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("loading Compose file %s: %w", strings.Join(options.ConfigPaths, ", "), err)
+	}
+	if len(p.Services) == 0 {
+		return nil, fmt.Errorf("no services defined in Compose file %s", strings.Join(options.ConfigPaths, ", "))
+	}
+
+	unsupported, err := unsupportedKeys(p)
+	if err != nil {
+		return nil, err
+	}
+	return &composeProject{Project: p, dotEnvFiles: options.EnvFiles, unsupported: unsupported}, nil
+}
+
+// unsupportedKeys lists the keys of a Compose project that the docker deployer ignores, like `services.web.healthcheck`.
+// Services are started in the order of their dependencies, but conditions other than `service_started` aren't waited for.
+func unsupportedKeys(p *types.Project) ([]string, error) {
+	var keys []string
+	for name, s := range p.Services {
+		b, err := json.Marshal(s)
+		if err != nil {
+			return nil, err
+		}
+		var fields map[string]json.RawMessage
+		if err := json.Unmarshal(b, &fields); err != nil {
+			return nil, err
+		}
+
+		prefix := "services." + name
+		for key, value := range fields {
+			switch {
+			case supportedServiceKeys[key] || string(value) == "null":
+			case key == "networks":
+				for network, cfg := range s.Networks {
+					if network != composeDefaultNetwork || cfg != nil {
+						keys = append(keys, fmt.Sprintf("%s.networks.%s", prefix, network))
+					}
+				}
+			case key == "depends_on":
+				for dep, cfg := range s.DependsOn {
+					if cfg.Condition != types.ServiceConditionStarted {
+						keys = append(keys, fmt.Sprintf("%s.depends_on.%s.condition", prefix, dep))
+					}
+					if cfg.Restart {
+						keys = append(keys, fmt.Sprintf("%s.depends_on.%s.restart", prefix, dep))
+					}
+				}
+			default:
+				keys = append(keys, prefix+"."+key)
+			}
+		}
+	}
+
+	for name := range p.Networks {
+		if name != composeDefaultNetwork {
+			keys = append(keys, "networks."+name)
+		}
+	}
+	// named volumes are created by Docker when they are first mounted
+	for name, v := range p.Volumes {
+		if v.Driver != "" || len(v.DriverOpts) > 0 || len(v.Labels) > 0 {
+			keys = append(keys, "volumes."+name)
+		}
+	}
+	if len(p.Secrets) > 0 {
+		keys = append(keys, "secrets")
+	}
+	if len(p.Configs) > 0 {
+		keys = append(keys, "configs")
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// order sorts the services so that each one comes after the services it depends on.
+func (p *composeProject) order() ([]string, error) {
+	var names []string
+	for name := range p.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var ordered []string
+	visited := map[string]bool{}
+	visiting := map[string]bool{}
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		if visited[name] {
+			return nil
+		}
+		if visiting[name] {
+			return fmt.Errorf("cycle detected in the dependencies of the Compose services: %s", strings.Join(append(path, name), " -> "))
+		}
+		visiting[name] = true
+		var deps []string
+		for dep := range p.Services[name].DependsOn {
+			deps = append(deps, dep)
+		}
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if _, found := p.Services[dep]; !found {
+				return fmt.Errorf("service %q depends on undefined service %q", name, dep)
+			}
+			if err := visit(dep, append(path, name)); err != nil {
+				return err
+			}
+		}
+		visiting[name] = false
+		visited[name] = true
+		ordered = append(ordered, name)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// artifact returns the image that a service runs, and whether it's one built by Skaffold.
+// Services with no image run the artifact named after them.
+func (p *composeProject) artifact(name string, images []string, builds []graph.Artifact) (graph.Artifact, bool) {
+	s := p.Services[name]
+	imageName := s.Image
+	if ref, err := dockerutil.ParseReference(s.Image); err == nil {
+		imageName = ref.BaseName
+	}
+	if s.Image == "" {
+		imageName = name
+	}
+
+	for _, b := range builds {
+		if b.ImageName == imageName && stringslice.Contains(images, b.ImageName) {
+			return graph.Artifact{ImageName: name, Tag: b.Tag}, true
+		}
+	}
+	return graph.Artifact{ImageName: name, Tag: s.Image}, false
+}
+
+// environment returns the variables set on a service by its env files and its `environment`, sorted by name.
+// Variables without value, that aren't set in the environment of Skaffold either, are left out.
+func (p *composeProject) environment(name string) []string {
+	var vars []string
+	for k, v := range p.Services[name].Environment {
+		if v != nil {
+			vars = append(vars, k+"="+*v)
+		}
+	}
+	sort.Strings(vars)
+	return vars
+}
+
+// dependencies lists the Compose files and the env files used by the project.
+func (p *composeProject) dependencies() []string {
+	files := append([]string{}, p.ComposeFiles...)
+	files = append(files, p.dotEnvFiles...)
+	for _, s := range p.Services {
+		for _, f := range s.EnvFiles {
+			if _, err := os.Stat(f.Path); err == nil || f.Required {
+				files = append(files, f.Path)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// configure applies the definition of a service to the container config of its image.
+func (p *composeProject) configure(name string, env []string, cfg *container.Config) {
+	s := p.Services[name]
+	if len(s.Entrypoint) > 0 {
+		cfg.Entrypoint = []string(s.Entrypoint)
+		// like `docker run --entrypoint`, overriding the entrypoint resets the command of the image
+		cfg.Cmd = nil
+	}
+	if len(s.Command) > 0 {
+		cfg.Cmd = []string(s.Command)
+	}
+	if s.WorkingDir != "" {
+		cfg.WorkingDir = s.WorkingDir
+	}
+	if s.User != "" {
+		cfg.User = s.User
+	}
+	cfg.Env = mergeEnv(cfg.Env, env)
+
+	labels := map[string]string{}
+	for k, v := range s.Labels {
+		labels[k] = v
+	}
+	for k, v := range cfg.Labels {
+		labels[k] = v
+	}
+	labels[composeProjectLabel] = p.Name
+	labels[composeServiceLabel] = name
+	cfg.Labels = labels
+}
+
+// mergeEnv overrides the variables of an image with the ones of a service.
+func mergeEnv(image, service []string) []string {
+	overridden := map[string]bool{}
+	for _, e := range service {
+		k, _, _ := strings.Cut(e, "=")
+		overridden[k] = true
+	}
+
+	var env []string
+	for _, e := range image {
+		if k, _, _ := strings.Cut(e, "="); !overridden[k] {
+			env = append(env, e)
+		}
+	}
+	return append(env, service...)
+}
+
+// mounts returns the bind mounts and the volumes of a service.
+func (p *composeProject) mounts(name string, labels map[string]string) []mount.Mount {
+	var mounts []mount.Mount
+	for _, v := range p.Services[name].Volumes {
+		m := mount.Mount{
+			Type:     mount.Type(v.Type),
+			Source:   v.Source,
+			Target:   v.Target,
+			ReadOnly: v.ReadOnly,
+		}
+		if m.Type == mount.TypeVolume {
+			m.Source = p.volumeName(v.Source)
+			m.VolumeOptions = &mount.VolumeOptions{}
+			// the labels tell the volumes that Skaffold creates, and removes on cleanup, from the ones that are shared
+			if v.Source == "" || p.scopedVolume(v.Source) {
+				m.VolumeOptions.Labels = labels
+			}
+		}
+		mounts = append(mounts, m)
+	}
+	return mounts
+}
+
+// volumes lists the named volumes of the project mounted by a service, that are removed on cleanup when Skaffold created them.
+// External volumes and volumes with an explicit `name` are left out, since they may be shared with other projects.
+func (p *composeProject) volumes(name string) []string {
+	var volumes []string
+	for _, v := range p.Services[name].Volumes {
+		if v.Type != types.VolumeTypeVolume || v.Source == "" || !p.scopedVolume(v.Source) {
+			continue
+		}
+		volumes = append(volumes, p.volumeName(v.Source))
+	}
+	return volumes
+}
+
+// scopedVolume tells whether a volume of the project has the name that Compose scopes to the project,
+// which is the case of the volumes that aren't external and don't set `name`.
+func (p *composeProject) scopedVolume(source string) bool {
+	if p.Volumes[source].External {
+		return false
+	}
+	return p.volumeName(source) == fmt.Sprintf("%s_%s", p.Name, source)
+}
+
+// volumeName returns the name of a volume of the project, which Compose scopes to the project unless it's set.
+func (p *composeProject) volumeName(source string) string {
+	if source == "" {
+		return ""
+	}
+	if v, found := p.Volumes[source]; found && v.Name != "" {
+		return v.Name
+	}
+	return fmt.Sprintf("%s_%s", p.Name, source)
+}
+
+// portForwardResources returns the ports published by a service, as container port forwards.
+func (p *composeProject) portForwardResources(name string) []*latest.PortForwardResource {
+	var resources []*latest.PortForwardResource
+	for _, port := range p.Services[name].Ports {
+		if port.Protocol != "" && port.Protocol != "tcp" {
+			continue
+		}
+		address := port.HostIP
+		if address == "" {
+			address = util.Loopback
+		}
+		// ports published on a range of host ports are forwarded to any free local port
+		localPort, _ := strconv.Atoi(port.Published)
+		resources = append(resources, &latest.PortForwardResource{
+			Type:      "container",
+			Name:      name,
+			Port:      schemautil.FromInt(int(port.Target)),
+			Address:   address,
+			LocalPort: localPort,
+		})
+	}
+	return resources
+}
+
+// hash identifies the configuration of a service, so that it's only recreated when it changes.
+func (p *composeProject) hash(name string, image string, env []string) (string, error) {
+	b, err := json.Marshal(struct {
+		Service types.ServiceConfig
+		Image   string
+		Env     []string
+	}{p.Services[name], image, env})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,495 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package docker
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/compose-spec/compose-go/v2/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/util"
+	pkgutil "github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestLoadComposeProject(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.SetEnvs(map[string]string{"WEB_PORT": "9090", "TOKEN": "secret"})
+		tmpDir := t.NewTempDir().WriteFiles(map[string]string{
+			"My App/.env":    "DB_TAG=15\n# comment\nDB_USER='app'\n",
+			"My App/web.env": "MODE=dev\nLEVEL=info\n",
+			"My App/compose.yaml": `services:
+  web:
+    image: web
+    command: npm run "dev server"
+    env_file: web.env
+    environment:
+      - LEVEL=debug
+      - TOKEN
+      - MISSING
+    ports:
+      - "${WEB_PORT}:8080"
+      - 127.0.0.1:9229:9229
+    depends_on: [db]
+  db:
+    image: postgres:${DB_TAG:-14}
+    environment:
+      POSTGRES_USER: ${DB_USER}
+      POSTGRES_PASSWORD: $${SECRET}
+    volumes:
+      - data:/var/lib/postgresql/data
+      - ./init.sql:/docker-entrypoint-initdb.d/init.sql:ro
+    depends_on:
+      cache:
+        condition: service_healthy
+  cache:
+    image: redis
+volumes:
+  data:
+`,
+		})
+
+		p, err := loadComposeProject(context.Background(), tmpDir.Path("My App/compose.yaml"), "")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("myapp", p.Name)
+		t.CheckDeepEqual([]string{"cache", "db", "web"}, p.ServiceNames())
+		t.CheckDeepEqual("postgres:15", p.Services["db"].Image)
+		t.CheckDeepEqual(types.ShellCommand{"npm", "run", "dev server"}, p.Services["web"].Command)
+		t.CheckDeepEqual([]string{"LEVEL=debug", "MODE=dev", "TOKEN=secret"}, p.environment("web"))
+		t.CheckDeepEqual([]string{"POSTGRES_PASSWORD=${SECRET}", "POSTGRES_USER=app"}, p.environment("db"))
+		t.CheckDeepEqual([]*latest.PortForwardResource{
+			{Type: "container", Name: "web", Port: util.FromInt(8080), Address: "127.0.0.1", LocalPort: 9090},
+			{Type: "container", Name: "web", Port: util.FromInt(9229), Address: "127.0.0.1", LocalPort: 9229},
+		}, p.portForwardResources("web"))
+		t.CheckDeepEqual([]string{
+			tmpDir.Path("My App/.env"),
+			tmpDir.Path("My App/compose.yaml"),
+			tmpDir.Path("My App/web.env"),
+		}, p.dependencies())
+		t.CheckDeepEqual([]string{"services.db.depends_on.cache.condition"}, p.unsupported)
+	})
+}
+
+func TestLoadComposeProjectDefaultFiles(t *testing.T) {
+	tests := []struct {
+		description string
+		files       []string
+		composeFile string
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "configured",
+			files:       []string{"compose.yaml", "dev/compose.yaml"},
+			composeFile: "dev/compose.yaml",
+			expected:    []string{"dev/compose.yaml"},
+		},
+		{
+			description: "default",
+			files:       []string{"docker-compose.yml", "compose.yaml"},
+			expected:    []string{"app/compose.yaml"},
+		},
+		{
+			description: "legacy default",
+			files:       []string{"docker-compose.yml"},
+			expected:    []string{"app/docker-compose.yml"},
+		},
+		{
+			description: "default with override",
+			files:       []string{"compose.yaml", "compose.override.yml"},
+			expected:    []string{"app/compose.yaml", "app/compose.override.yml"},
+		},
+		{
+			description: "not found",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			tmpDir.Mkdir("app")
+			for _, f := range test.files {
+				tmpDir.Write(filepath.Join("app", f), "services:\n  web:\n    image: web\n")
+			}
+			composeFile := test.composeFile
+			if composeFile != "" {
+				composeFile = tmpDir.Path(filepath.Join("app", composeFile))
+			}
+
+			p, err := loadComposeProject(context.Background(), composeFile, tmpDir.Path("app"))
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				var expected []string
+				for _, f := range test.expected {
+					if test.composeFile != "" {
+						f = filepath.Join("app", f)
+					}
+					expected = append(expected, tmpDir.Path(f))
+				}
+				t.CheckDeepEqual(expected, p.ComposeFiles)
+			}
+		})
+	}
+}
+
+func TestComposeUnsupportedKeys(t *testing.T) {
+	tests := []struct {
+		description string
+		compose     string
+		expected    []string
+	}{
+		{
+			description: "supported keys",
+			compose: `version: "3.8"
+name: app
+x-common: {}
+services:
+  web:
+    image: web
+    build: .
+    x-tags: [a]
+    depends_on:
+      db:
+        condition: service_started
+        required: true
+  db:
+    image: postgres
+volumes:
+  data:
+  shared:
+    external: true
+`,
+		},
+		{
+			description: "unsupported keys",
+			compose: `services:
+  web:
+    image: web
+    healthcheck:
+      test: ["CMD", "true"]
+    restart: always
+    networks: [front]
+    secrets: [token]
+    depends_on:
+      db:
+        condition: service_healthy
+        restart: true
+  db:
+    image: postgres
+networks:
+  front: {}
+secrets:
+  token:
+    file: token.txt
+volumes:
+  data:
+    driver: local
+`,
+			expected: []string{
+				"networks.front",
+				"secrets",
+				"services.web.depends_on.db.condition",
+				"services.web.depends_on.db.restart",
+				"services.web.healthcheck",
+				"services.web.networks.front",
+				"services.web.restart",
+				"services.web.secrets",
+				"volumes.data",
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("compose.yaml", test.compose).Touch("token.txt")
+
+			p, err := loadComposeProject(context.Background(), tmpDir.Path("compose.yaml"), "")
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, p.unsupported)
+		})
+	}
+}
+
+func TestLoadComposeProjectErrors(t *testing.T) {
+	tests := []struct {
+		description string
+		compose     string
+	}{
+		{
+			description: "no services",
+			compose:     "name: app",
+		},
+		{
+			description: "required variable",
+			compose:     "services:\n  web:\n    image: ${IMAGE:?set the image}",
+		},
+		{
+			description: "unknown key",
+			compose:     "services:\n  web:\n    imag: web",
+		},
+		{
+			description: "no image",
+			compose:     "services:\n  web:\n    command: run",
+		},
+		{
+			description: "invalid yaml",
+			compose:     "services: [",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Write("compose.yaml", test.compose)
+
+			_, err := loadComposeProject(context.Background(), tmpDir.Path("compose.yaml"), "")
+
+			t.CheckError(true, err)
+		})
+	}
+}
+
+func TestComposeOrder(t *testing.T) {
+	dependsOn := func(services ...string) types.DependsOnConfig {
+		deps := types.DependsOnConfig{}
+		for _, s := range services {
+			deps[s] = types.ServiceDependency{Condition: types.ServiceConditionStarted, Required: true}
+		}
+		return deps
+	}
+
+	tests := []struct {
+		description string
+		services    types.Services
+		expected    []string
+		shouldErr   bool
+	}{
+		{
+			description: "no dependencies",
+			services:    types.Services{"web": {}, "db": {}, "cache": {}},
+			expected:    []string{"cache", "db", "web"},
+		},
+		{
+			description: "dependencies first",
+			services: types.Services{
+				"api":    {DependsOn: dependsOn("db", "cache")},
+				"web":    {DependsOn: dependsOn("api")},
+				"db":     {},
+				"cache":  {DependsOn: dependsOn("db")},
+				"worker": {DependsOn: dependsOn("cache")},
+			},
+			expected: []string{"db", "cache", "api", "web", "worker"},
+		},
+		{
+			description: "cycle",
+			services: types.Services{
+				"a": {DependsOn: dependsOn("b")},
+				"b": {DependsOn: dependsOn("a")},
+			},
+			shouldErr: true,
+		},
+		{
+			description: "undefined service",
+			services: types.Services{
+				"web": {DependsOn: dependsOn("db")},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p := &composeProject{Project: &types.Project{Services: test.services}}
+
+			order, err := p.order()
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, order)
+		})
+	}
+}
+
+func TestComposeArtifact(t *testing.T) {
+	p := &composeProject{Project: &types.Project{Services: types.Services{
+		"web":    {Image: "gcr.io/project/web:latest"},
+		"worker": {Build: &types.BuildConfig{Context: "."}},
+		"db":     {Image: "postgres:15"},
+		"admin":  {Image: "admin"},
+	}}}
+	builds := []graph.Artifact{
+		{ImageName: "gcr.io/project/web", Tag: "gcr.io/project/web:abc"},
+		{ImageName: "worker", Tag: "worker:def"},
+		{ImageName: "admin", Tag: "admin:ghi"},
+	}
+	images := []string{"gcr.io/project/web", "worker"}
+
+	tests := []struct {
+		service       string
+		expected      graph.Artifact
+		expectedBuilt bool
+	}{
+		{
+			service:       "web",
+			expected:      graph.Artifact{ImageName: "web", Tag: "gcr.io/project/web:abc"},
+			expectedBuilt: true,
+		},
+		{
+			service:       "worker",
+			expected:      graph.Artifact{ImageName: "worker", Tag: "worker:def"},
+			expectedBuilt: true,
+		},
+		{
+			service:  "db",
+			expected: graph.Artifact{ImageName: "db", Tag: "postgres:15"},
+		},
+		{
+			service:  "admin",
+			expected: graph.Artifact{ImageName: "admin", Tag: "admin"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.service, func(t *testutil.T) {
+			artifact, built := p.artifact(test.service, images, builds)
+
+			t.CheckDeepEqual(test.expected, artifact)
+			t.CheckDeepEqual(test.expectedBuilt, built)
+		})
+	}
+}
+
+func TestComposeConfigure(t *testing.T) {
+	p := &composeProject{Project: &types.Project{
+		Name: "app",
+		Services: types.Services{
+			"web": {
+				Entrypoint: types.ShellCommand{"/bin/sh", "-c"},
+				Command:    types.ShellCommand{"run"},
+				Environment: types.MappingWithEquals{
+					"LEVEL":   pkgutil.Ptr("debug"),
+					"MODE":    pkgutil.Ptr("dev"),
+					"MISSING": nil,
+				},
+				Labels:     types.Labels{"team": "web", "skaffold.dev/run-id": "ignored"},
+				WorkingDir: "/app",
+				User:       "node",
+			},
+		},
+	}}
+	cfg := &container.Config{
+		Image:      "web:abc",
+		Entrypoint: []string{"docker-entrypoint.sh"},
+		Cmd:        []string{"node", "index.js"},
+		Env:        []string{"PATH=/usr/bin", "MODE=prod"},
+		Labels:     map[string]string{"skaffold.dev/run-id": "1234"},
+	}
+
+	p.configure("web", p.environment("web"), cfg)
+
+	testutil.CheckDeepEqual(t, &container.Config{
+		Image:      "web:abc",
+		Entrypoint: []string{"/bin/sh", "-c"},
+		Cmd:        []string{"run"},
+		Env:        []string{"PATH=/usr/bin", "LEVEL=debug", "MODE=dev"},
+		WorkingDir: "/app",
+		User:       "node",
+		Labels: map[string]string{
+			"skaffold.dev/run-id":        "1234",
+			"team":                       "web",
+			"com.docker.compose.project": "app",
+			"com.docker.compose.service": "web",
+		},
+	}, cfg)
+}
+
+func TestComposeMounts(t *testing.T) {
+	p := &composeProject{Project: &types.Project{
+		Name: "app",
+		Services: types.Services{
+			"db": {
+				Volumes: []types.ServiceVolumeConfig{
+					{Type: "volume", Source: "data", Target: "/data"},
+					{Type: "volume", Source: "shared", Target: "/shared"},
+					{Type: "volume", Source: "pgdata", Target: "/var/lib/postgresql/data"},
+					{Type: "volume", Target: "/cache"},
+					{Type: "bind", Source: filepath.FromSlash("/src/app/init.sql"), Target: "/init.sql", ReadOnly: true},
+					{Type: "tmpfs", Target: "/tmp"},
+				},
+			},
+		},
+		Volumes: types.Volumes{
+			"data":   {Name: "app_data"},
+			"shared": {Name: "shared", External: true},
+			"pgdata": {Name: "pgdata"},
+		},
+	}}
+	labels := map[string]string{"skaffold.dev/run-id": "1234"}
+
+	mounts := p.mounts("db", labels)
+
+	testutil.CheckDeepEqual(t, []mount.Mount{
+		{Type: mount.TypeVolume, Source: "app_data", Target: "/data", VolumeOptions: &mount.VolumeOptions{Labels: labels}},
+		{Type: mount.TypeVolume, Source: "shared", Target: "/shared", VolumeOptions: &mount.VolumeOptions{}},
+		{Type: mount.TypeVolume, Source: "pgdata", Target: "/var/lib/postgresql/data", VolumeOptions: &mount.VolumeOptions{}},
+		{Type: mount.TypeVolume, Target: "/cache", VolumeOptions: &mount.VolumeOptions{Labels: labels}},
+		{Type: mount.TypeBind, Source: filepath.FromSlash("/src/app/init.sql"), Target: "/init.sql", ReadOnly: true},
+		{Type: mount.TypeTmpfs, Target: "/tmp"},
+	}, mounts)
+	testutil.CheckDeepEqual(t, []string{"app_data"}, p.volumes("db"))
+}
+
+func TestComposePortForwardResources(t *testing.T) {
+	p := &composeProject{Project: &types.Project{Services: types.Services{
+		"web": {
+			Ports: []types.ServicePortConfig{
+				{Target: 8080, Published: "9090", Protocol: "tcp"},
+				{Target: 9229, Published: "9229", HostIP: "0.0.0.0", Protocol: "tcp"},
+				{Target: 3000, Protocol: "tcp"},
+				{Target: 53, Protocol: "udp"},
+			},
+		},
+	}}}
+
+	resources := p.portForwardResources("web")
+
+	testutil.CheckDeepEqual(t, []*latest.PortForwardResource{
+		{Type: "container", Name: "web", Port: util.FromInt(8080), Address: "127.0.0.1", LocalPort: 9090},
+		{Type: "container", Name: "web", Port: util.FromInt(9229), Address: "0.0.0.0", LocalPort: 9229},
+		{Type: "container", Name: "web", Port: util.FromInt(3000), Address: "127.0.0.1"},
+	}, resources)
+}
+
+func TestComposeHash(t *testing.T) {
+	p := &composeProject{Project: &types.Project{Services: types.Services{
+		"web": {Image: "web", Command: types.ShellCommand{"run"}},
+	}}}
+
+	hash, err := p.hash("web", "web:abc", []string{"A=1"})
+	testutil.CheckError(t, false, err)
+
+	same, _ := p.hash("web", "web:abc", []string{"A=1"})
+	otherImage, _ := p.hash("web", "web:def", []string{"A=1"})
+	otherEnv, _ := p.hash("web", "web:abc", []string{"A=2"})
+
+	testutil.CheckDeepEqual(t, hash, same)
+	if hash == otherImage || hash == otherEnv {
+		t.Errorf("expected the hash to change with the image and the environment")
+	}
+}
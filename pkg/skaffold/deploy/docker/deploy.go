@@ -58,6 +58,8 @@ import (
 
 type Deployer struct {
 	configName string
+	// configDir is the directory of the Skaffold config, that the default Compose files are looked up in.
+	configDir string
 
 	debugger *debugger.DebugManager
 	logger   log.Logger
@@ -75,9 +77,12 @@ type Deployer struct {
 	resources          []*latest.PortForwardResource
 	once               sync.Once
 	labeller           *label.DefaultLabeller
+	composeServices    map[string]string // Compose service name -> hash of its deployed configuration
+	composeVolumes     map[string]bool   // named volumes of the Compose services
+	composeWarned      map[string]bool   // unsupported Compose keys already warned about
 }
 
-func NewDeployer(ctx context.Context, cfg dockerutil.Config, labeller *label.DefaultLabeller, d *latest.DockerDeploy, resources []*latest.PortForwardResource, configName string) (*Deployer, error) {
+func NewDeployer(ctx context.Context, cfg dockerutil.Config, labeller *label.DefaultLabeller, d *latest.DockerDeploy, resources []*latest.PortForwardResource, configName string, configDir string) (*Deployer, error) {
 	client, err := dockerutil.NewAPIClient(ctx, cfg)
 	if err != nil {
 		return nil, err
@@ -100,6 +105,7 @@ func NewDeployer(ctx context.Context, cfg dockerutil.Config, labeller *label.Def
 
 	return &Deployer{
 		configName:         configName,
+		configDir:          configDir,
 		cfg:                d,
 		client:             client,
 		network:            fmt.Sprintf("skaffold-network-%s", labeller.GetRunID()),
@@ -114,6 +120,9 @@ func NewDeployer(ctx context.Context, cfg dockerutil.Config, labeller *label.Def
 		monitor:            &status.NoopMonitor{},
 		syncer:             pkgsync.NewContainerSyncer(),
 		labeller:           labeller,
+		composeServices:    map[string]string{},
+		composeVolumes:     map[string]bool{},
+		composeWarned:      map[string]bool{},
 	}, nil
 }
 
@@ -139,6 +148,10 @@ func (d *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 		return fmt.Errorf("creating skaffold network %s: %w", d.network, err)
 	}
 
+	if d.cfg.UseCompose {
+		return d.deployCompose(ctx, out, builds)
+	}
+
 	// TODO(nkubala)[07/20/21]: parallelize with sync.Errgroup
 	for _, b := range builds {
 		if err := d.deploy(ctx, out, b); err != nil {
@@ -168,11 +181,6 @@ func (d *Deployer) deploy(ctx context.Context, out io.Writer, artifact graph.Art
 		}
 		d.portManager.RelinquishPorts(container.Name)
 	}
-	if d.cfg.UseCompose {
-		// TODO(nkubala): implement
-		return fmt.Errorf("docker compose not yet supported by skaffold")
-	}
-
 	containerCfg, err := d.containerConfigFromImage(ctx, artifact.Tag)
 	if err != nil {
 		return err
@@ -185,25 +193,29 @@ func (d *Deployer) deploy(ctx context.Context, out io.Writer, artifact graph.Art
 		ContainerConfig: containerCfg,
 	}
 
+	// Filter for the port resource for the given artifact.ImageName
+	filteredPFResources := d.filterPortForwardingResources(artifact.ImageName)
+
+	return d.run(ctx, out, artifact, opts, filteredPFResources, d.debugger != nil)
+}
+
+// run creates a container from an artifact's image, after setting up its debugging support and its port bindings.
+func (d *Deployer) run(ctx context.Context, out io.Writer, artifact graph.Artifact, opts dockerutil.ContainerCreateOpts, resources []*latest.PortForwardResource, debug bool) error {
 	var debugBindings nat.PortMap
-	if d.debugger != nil {
-		debugBindings, err = d.setupDebugging(ctx, out, artifact, containerCfg)
+	if debug {
+		var err error
+		debugBindings, err = d.setupDebugging(ctx, out, artifact, opts.ContainerConfig)
 		if err != nil {
 			return errors.Wrap(err, "setting up debugger")
 		}
 
 		// mount all debug support container volumes into the application container
-		var mounts []mount.Mount
 		for _, m := range d.debugger.SupportMounts() {
-			mounts = append(mounts, m)
+			opts.Mounts = append(opts.Mounts, m)
 		}
-		opts.Mounts = mounts
 	}
 
-	// Filter for the port resource for the given artifact.ImageName
-	filteredPFResources := d.filterPortForwardingResources(artifact.ImageName)
-
-	bindings, err := d.portManager.AllocatePorts(artifact.ImageName, filteredPFResources, containerCfg, debugBindings)
+	bindings, err := d.portManager.AllocatePorts(artifact.ImageName, resources, opts.ContainerConfig, debugBindings)
 	if err != nil {
 		return err
 	}
@@ -213,10 +225,119 @@ func (d *Deployer) deploy(ctx context.Context, out io.Writer, artifact graph.Art
 	if err != nil {
 		return errors.Wrap(err, "creating container in local docker")
 	}
-	d.TrackContainerFromBuild(artifact, tracker.Container{Name: containerName, ID: id})
+	d.TrackContainerFromBuild(artifact, tracker.Container{Name: opts.Name, ID: id})
 	return nil
 }
 
+// deployCompose runs the services of a Compose project in the local docker daemon, in the order of their
+// dependencies and with the images built by Skaffold. Services are only recreated when their configuration changes.
+func (d *Deployer) deployCompose(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	project, err := loadComposeProject(ctx, d.cfg.ComposeFile, d.configDir)
+	if err != nil {
+		return err
+	}
+	services, err := project.order()
+	if err != nil {
+		return err
+	}
+
+	var unsupported []string
+	for _, key := range project.unsupported {
+		if !d.composeWarned[key] {
+			d.composeWarned[key] = true
+			unsupported = append(unsupported, key)
+		}
+	}
+	if len(unsupported) > 0 {
+		olog.Entry(ctx).Warnf("ignoring the keys of Compose file %s that the docker deployer doesn't support: %s", strings.Join(project.ComposeFiles, ", "), strings.Join(unsupported, ", "))
+	}
+
+	var artifacts []graph.Artifact
+	for _, name := range services {
+		artifact, err := d.deployService(ctx, out, project, name, builds)
+		if err != nil {
+			return fmt.Errorf("deploying Compose service %q: %w", name, err)
+		}
+		artifacts = append(artifacts, artifact)
+	}
+	d.TrackBuildArtifacts(artifacts, nil)
+
+	// remove the services that were deleted from the Compose file
+	for name := range d.composeServices {
+		if _, found := project.Services[name]; found {
+			continue
+		}
+		if container, found := d.tracker.ContainerForImage(name); found {
+			olog.Entry(ctx).Debugf("removing container %s of deleted Compose service %s", container.ID, name)
+			if err := d.client.Delete(ctx, out, container.ID); err != nil {
+				return fmt.Errorf("failed to remove container %s of Compose service %s: %w", container.ID, name, err)
+			}
+			d.portManager.RelinquishPorts(name)
+			d.tracker.Remove(name)
+		}
+		delete(d.composeServices, name)
+	}
+	return nil
+}
+
+// deployService creates the container of a Compose service, unless the one already deployed is up to date.
+func (d *Deployer) deployService(ctx context.Context, out io.Writer, project *composeProject, name string, builds []graph.Artifact) (graph.Artifact, error) {
+	artifact, built := project.artifact(name, d.cfg.Images, builds)
+	if artifact.Tag == "" {
+		return artifact, fmt.Errorf("no image set, and no artifact named %q built by Skaffold", name)
+	}
+	env := project.environment(name)
+	hash, err := project.hash(name, artifact.Tag, env)
+	if err != nil {
+		return artifact, err
+	}
+
+	if container, found := d.tracker.ContainerForImage(name); found {
+		if d.composeServices[name] == hash {
+			olog.Entry(ctx).Debugf("Compose service %s is up to date", name)
+			return artifact, nil
+		}
+		olog.Entry(ctx).Debugf("removing old container %s for Compose service %s", container.ID, name)
+		if err := d.client.Delete(ctx, out, container.ID); err != nil {
+			return artifact, fmt.Errorf("failed to remove old container %s: %w", container.ID, err)
+		}
+		d.portManager.RelinquishPorts(name)
+	}
+
+	if !built && !d.client.ImageExists(ctx, artifact.Tag) {
+		if err := d.client.Pull(ctx, out, artifact.Tag, v1.Platform{}); err != nil {
+			return artifact, fmt.Errorf("pulling image %s: %w", artifact.Tag, err)
+		}
+	}
+	containerCfg, err := d.containerConfigFromImage(ctx, artifact.Tag)
+	if err != nil {
+		return artifact, err
+	}
+	project.configure(name, env, containerCfg)
+
+	containerName := project.Services[name].ContainerName
+	if containerName == "" {
+		containerName = d.getContainerName(ctx, fmt.Sprintf("%s-%s", project.Name, name))
+	}
+	opts := dockerutil.ContainerCreateOpts{
+		Name:            containerName,
+		Network:         d.network,
+		NetworkAliases:  []string{name},
+		ContainerConfig: containerCfg,
+		Mounts:          project.mounts(name, d.labeller.Labels()),
+	}
+	resources := append(project.portForwardResources(name), d.filterPortForwardingResources(name)...)
+
+	if err := d.run(ctx, out, artifact, opts, resources, built && d.debugger != nil); err != nil {
+		return artifact, err
+	}
+	d.composeServices[name] = hash
+	for _, v := range project.volumes(name) {
+		d.composeVolumes[v] = true
+	}
+	return artifact, nil
+}
+
 func (d *Deployer) filterPortForwardingResources(imageName string) []*latest.PortForwardResource {
 	filteredPFResources := []*latest.PortForwardResource{}
 	for _, p := range d.resources {
@@ -346,8 +467,16 @@ func (d *Deployer) getContainerName(ctx context.Context, name string) string {
 }
 
 func (d *Deployer) Dependencies() ([]string, error) {
-	// noop since there is no deploy config
-	return nil, nil
+	if !d.cfg.UseCompose {
+		// noop since there is no deploy config
+		return nil, nil
+	}
+
+	project, err := loadComposeProject(context.TODO(), d.cfg.ComposeFile, d.configDir)
+	if err != nil {
+		return nil, err
+	}
+	return project.dependencies(), nil
 }
 
 func (d *Deployer) Cleanup(ctx context.Context, out io.Writer, dryRun bool, _ manifest.ManifestListByConfig) error {
@@ -371,6 +500,16 @@ func (d *Deployer) Cleanup(ctx context.Context, out io.Writer, dryRun bool, _ ma
 		}
 	}
 
+	var composeVolumes []string
+	for v := range d.composeVolumes {
+		composeVolumes = append(composeVolumes, v)
+	}
+	runLabelFilter := filters.Arg("label", fmt.Sprintf("%s=%s", label.RunIDLabel, d.labeller.GetRunID()))
+	if err := d.removeCreatedVolumes(ctx, runLabelFilter, composeVolumes); err != nil {
+		return errors.Wrap(err, "cleaning up Compose volume")
+	}
+	d.composeVolumes = map[string]bool{}
+
 	if err := d.client.NetworkRemove(ctx, d.network); d.networkDeployed && err != nil {
 		return errors.Wrap(err, "cleaning up skaffold created network")
 	}
@@ -417,10 +556,47 @@ func (d *Deployer) cleanPreviousDeployments(ctx context.Context) error {
 		}
 	}
 
+	return d.removeCreatedVolumes(ctx, runIDLabelFilter, composeVolumesToDelete(ctd))
+}
+
+// removeCreatedVolumes removes the given Compose volumes that Skaffold created, which are labelled with a run ID.
+// Volumes that existed before, like the data volume of a database, are kept, like `docker compose down` does.
+func (d *Deployer) removeCreatedVolumes(ctx context.Context, runIDLabelFilter filters.KeyValuePair, volumes []string) error {
+	if len(volumes) == 0 {
+		return nil
+	}
+	labelled, err := d.client.VolumeList(ctx, volume.ListOptions{Filters: filters.NewArgs(runIDLabelFilter)})
+	if err != nil {
+		return err
+	}
+	for _, v := range createdVolumes(volumes, labelled) {
+		if err := d.client.VolumeRemove(ctx, v); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// createdVolumes filters the volumes that are in the list of volumes labelled by Skaffold.
+func createdVolumes(volumes []string, labelled []*volume.Volume) []string {
+	created := map[string]bool{}
+	for _, v := range labelled {
+		created[v.Name] = true
+	}
+	var filtered []string
+	for _, v := range volumes {
+		if created[v] {
+			filtered = append(filtered, v)
+		}
+	}
+	return filtered
+}
+
 func (d *Deployer) containersToDelete(ctx context.Context, runIDLabelFilter filters.KeyValuePair) ([]types.Container, error) {
+	if d.cfg.UseCompose {
+		return d.composeContainersToDelete(ctx, runIDLabelFilter)
+	}
+
 	csToDelete := []types.Container{}
 	for _, img := range d.cfg.Images {
 		cs, err := d.getContainersCreated(ctx, img, runIDLabelFilter)
@@ -433,6 +609,18 @@ func (d *Deployer) containersToDelete(ctx context.Context, runIDLabelFilter filt
 	return csToDelete, nil
 }
 
+// composeContainersToDelete lists the containers of the Compose project created by Skaffold.
+func (d *Deployer) composeContainersToDelete(ctx context.Context, runIDLabelFilter filters.KeyValuePair) ([]types.Container, error) {
+	project, err := loadComposeProject(ctx, d.cfg.ComposeFile, d.configDir)
+	if err != nil {
+		return nil, err
+	}
+	return d.client.ContainerList(ctx, types.ContainerListOptions{
+		All:     true,
+		Filters: filters.NewArgs(runIDLabelFilter, filters.Arg("label", fmt.Sprintf("%s=%s", composeProjectLabel, project.Name))),
+	})
+}
+
 func (d *Deployer) getContainersCreated(ctx context.Context, img string, runIDLabelFilter filters.KeyValuePair) ([]types.Container, error) {
 	nameFilter := filters.Arg("name", img)
 	cl, err := d.client.ContainerList(ctx, types.ContainerListOptions{
@@ -505,6 +693,26 @@ func (d *Deployer) volumesToDelete(containers []types.Container) map[string]bool
 	return vtd
 }
 
+// composeVolumesToDelete lists the named volumes of the Compose projects that the containers belong to,
+// that are scoped to the project.
+func composeVolumesToDelete(containers []types.Container) []string {
+	seen := map[string]bool{}
+	var volumes []string
+	for _, c := range containers {
+		project, found := c.Labels[composeProjectLabel]
+		if !found {
+			continue
+		}
+		for _, m := range c.Mounts {
+			if m.Type == mount.TypeVolume && strings.HasPrefix(m.Name, project+"_") && !seen[m.Name] {
+				seen[m.Name] = true
+				volumes = append(volumes, m.Name)
+			}
+		}
+	}
+	return volumes
+}
+
 func (d *Deployer) debugContainersToDelete(ctx context.Context, runIDLabelFilter filters.KeyValuePair, volumes map[string]bool) ([]types.Container, error) {
 	containersFilters := []filters.KeyValuePair{runIDLabelFilter, filters.Arg("label", label.DebugContainerLabel)}
 	for v := range volumes {
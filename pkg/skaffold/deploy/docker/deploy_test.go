@@ -20,7 +20,10 @@ import (
 	"context"
 	"testing"
 
+	dockertypes "github.com/docker/docker/api/types"
 	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/go-connections/nat"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
@@ -175,7 +178,7 @@ func TestDebugBindings(t *testing.T) {
 				return configs, nil, nil
 			})
 
-			d, _ := NewDeployer(context.TODO(), mockConfig{}, &label.DefaultLabeller{}, nil, test.portForwardResources, "default")
+			d, _ := NewDeployer(context.TODO(), mockConfig{}, &label.DefaultLabeller{}, nil, test.portForwardResources, "default", "")
 
 			for _, a := range test.artifacts {
 				config := container.Config{
@@ -272,6 +275,34 @@ func TestFilterPortForwardingResources(t *testing.T) {
 	}
 }
 
+func TestComposeVolumesToDelete(t *testing.T) {
+	containers := []dockertypes.Container{
+		{
+			Labels: map[string]string{composeProjectLabel: "app"},
+			Mounts: []dockertypes.MountPoint{
+				{Type: mount.TypeVolume, Name: "app_data"},
+				{Type: mount.TypeVolume, Name: "0f3c9e"},
+				{Type: mount.TypeBind, Source: "/src/app/init.sql"},
+			},
+		},
+		{
+			Labels: map[string]string{composeProjectLabel: "app"},
+			Mounts: []dockertypes.MountPoint{{Type: mount.TypeVolume, Name: "app_data"}},
+		},
+		{
+			Mounts: []dockertypes.MountPoint{{Type: mount.TypeVolume, Name: "app_other"}},
+		},
+	}
+
+	testutil.CheckDeepEqual(t, []string{"app_data"}, composeVolumesToDelete(containers))
+}
+
+func TestCreatedVolumes(t *testing.T) {
+	labelled := []*volume.Volume{{Name: "app_data"}, {Name: "other_cache"}}
+
+	testutil.CheckDeepEqual(t, []string{"app_data"}, createdVolumes([]string{"app_data", "app_db"}, labelled))
+}
+
 type mockConfig struct{}
 
 func (m mockConfig) ContainerDebugging() bool               { return true }
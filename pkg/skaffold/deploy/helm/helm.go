@@ -105,6 +105,9 @@ type Deployer struct {
 
 	labels map[string]string
 
+	// templateVars are the values produced by earlier deployers, like Terraform outputs, that `setValueTemplates` can reference
+	templateVars *util.TemplateVars
+
 	forceDeploy       bool
 	enableDebug       bool
 	overrideProtocols []string
@@ -136,6 +139,7 @@ type Config interface {
 	GetNamespace() string
 	IsMultiConfig() bool
 	JSONParseConfig() latest.JSONParseConfig
+	TemplateVars() *util.TemplateVars
 }
 
 // NewDeployer returns a configured Deployer.  Returns an error if current version of helm is less than 3.1.0.
@@ -190,6 +194,7 @@ func NewDeployer(ctx context.Context, cfg Config, labeller *label.DefaultLabelle
 		forceDeploy:            cfg.ForceDeploy(),
 		configFile:             cfg.ConfigurationFile(),
 		labels:                 labeller.Labels(),
+		templateVars:           cfg.TemplateVars(),
 		bV:                     hv,
 		enableDebug:            cfg.Mode() == config.RunModes.Debug,
 		overrideProtocols:      debug.Protocols,
@@ -0,0 +1,104 @@
+package terraform
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringset"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/walk"
+)
+
+// moduleSourceRegex matches the sources of modules that are stored locally, like `source = "../modules/network"`.
+var moduleSourceRegex = regexp.MustCompile(`(?m)^\s*source\s*=\s*"(\.\.?/[^"]*)"`)
+
+// Dependencies lists the files that `skaffold dev` watches to redeploy: the Terraform configuration and variable files
+// under the directory of each deployment, its var files, and the files of the local modules it uses.
+func (t *Deployer) Dependencies() ([]string, error) {
+	deps := stringset.New()
+	visited := map[string]bool{}
+
+	for _, d := range t.Deployments {
+		dir := d.Dir
+		if dir == "" {
+			dir = "."
+		}
+
+		files, err := configFiles(dir, visited)
+		if err != nil {
+			return nil, err
+		}
+		deps.Insert(files...)
+
+		for _, varFile := range d.VarFiles {
+			if !filepath.IsAbs(varFile) {
+				varFile = filepath.Join(dir, varFile)
+			}
+			deps.Insert(varFile)
+		}
+	}
+	return deps.ToList(), nil
+}
+
+// configFiles lists the Terraform files in dir and in the local modules it uses, skipping the directories that were already visited.
+func configFiles(dir string, visited map[string]bool) ([]string, error) {
+	dir = filepath.Clean(dir)
+	if visited[dir] {
+		return nil, nil
+	}
+	visited[dir] = true
+
+	// .terraform holds the providers and modules downloaded by `terraform init`
+	var files []string
+	err := walk.From(dir).When(func(path string, info walk.Dirent) (bool, error) {
+		if info.IsDir() && path != dir && strings.HasPrefix(info.Name(), ".") {
+			return false, filepath.SkipDir
+		}
+		return !info.IsDir() && isConfigFile(info.Name()), nil
+	}).AppendPaths(&files)
+	if err != nil {
+		return nil, err
+	}
+
+	deps := files
+	for _, file := range files {
+		if !strings.HasSuffix(file, ".tf") {
+			continue
+		}
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		for _, source := range LocalModuleSources(b) {
+			module := filepath.Join(filepath.Dir(file), source)
+			if _, err := os.Stat(module); err != nil {
+				continue
+			}
+			moduleFiles, err := configFiles(module, visited)
+			if err != nil {
+				return nil, err
+			}
+			deps = append(deps, moduleFiles...)
+		}
+	}
+	return deps, nil
+}
+
+// LocalModuleSources lists the sources of the modules that a Terraform configuration file uses from the local filesystem.
+func LocalModuleSources(config []byte) []string {
+	var sources []string
+	for _, m := range moduleSourceRegex.FindAllSubmatch(config, -1) {
+		sources = append(sources, string(m[1]))
+	}
+	return sources
+}
+
+func isConfigFile(name string) bool {
+	for _, ext := range []string{".tf", ".tf.json", ".tfvars", ".tfvars.json"} {
+		if strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	return false
+}
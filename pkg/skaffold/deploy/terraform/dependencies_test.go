@@ -0,0 +1,49 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.NewTempDir().
+			Write("app/main.tf", `module "network" {
+  source = "../modules/network"
+}
+
+module "registry" {
+  source  = "terraform-google-modules/network/google"
+}`).
+			Write("app/variables.tf", "").
+			Write("app/prod.tfvars", "").
+			Write("app/README.md", "").
+			Write("app/.terraform/modules/registry/main.tf", "").
+			Write("app/skaffold.tfplan", "").
+			Write("modules/network/main.tf", "").
+			Write("modules/network/outputs.tf.json", "").
+			Write("vars/shared.tfvars", "").
+			Chdir()
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app", VarFiles: []string{"../vars/shared.tfvars"}},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		deps, err := deployer.Dependencies()
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{
+			"app/main.tf",
+			"app/prod.tfvars",
+			"app/variables.tf",
+			"modules/network/main.tf",
+			"modules/network/outputs.tf.json",
+			"vars/shared.tfvars",
+		}, deps)
+	})
+}
@@ -1,37 +1,142 @@
 package terraform
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/access"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/debug"
+	deployerr "github.com/ryanharper/skaffold/v2/pkg/skaffold/deploy/error"
+	eventV2 "github.com/ryanharper/skaffold/v2/pkg/skaffold/event/v2"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/hooks"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
 	olog "github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/status"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/sync"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 )
 
+// for testing
+var tempDir = os.MkdirTemp
+
+// Config contains config options needed for the Terraform deployer.
+type Config interface {
+	// DryRun stops every deployment after `terraform plan`.
+	DryRun() bool
+	// RenderOnly is set by `skaffold render`, which also stops after `terraform plan`.
+	RenderOnly() bool
+	// GetRunID identifies the Skaffold run in the environment of the lifecycle hooks.
+	GetRunID() string
+	// TemplateVars receives the outputs of the deployments, for the deployments, deployers and renderers that reference them.
+	TemplateVars() *util.TemplateVars
+}
+
 type Deployer struct {
+	cfg        Config
 	configName string
 	*latest.TerraformDeploy
+
+	// builds are the artifacts that were last deployed, used to set `imageVars` when destroying
+	builds []graph.Artifact
+
+	monitor    *Monitor
+	hookRunner hooks.Runner
 }
 
-func NewDeployer(cfg latest.TerraformDeploy, configName string) (*Deployer, error) {
+func NewDeployer(cfg Config, tfDeploy *latest.TerraformDeploy, configName string) (*Deployer, error) {
 	return &Deployer{
+		cfg:             cfg,
 		configName:      configName,
-		TerraformDeploy: &cfg,
+		TerraformDeploy: tfDeploy,
+		monitor:         &Monitor{},
+		hookRunner:      hooks.NewTerraformDeployRunner(tfDeploy.LifecycleHooks, hooks.NewDeployEnvOpts(cfg.GetRunID(), "", []string{}), nil, cfg.TemplateVars().Values),
 	}, nil
 }
 
 func (t *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Artifact, labellers manifest.ManifestListByConfig) error {
 	olog.Entry(ctx).Infof("Terraform Deployer: Starting deployment for config %s", t.configName)
 
+	t.TrackBuildArtifacts(builds, nil)
+
+	// Independent deployments run concurrently, each one after the deployments it depends on
+	err := t.runInOrder(ctx, out, func(ctx context.Context, out io.Writer, _ int, deployment *latest.TerrformDeployments) error {
+		if err := t.deployTerraform(ctx, out, deployment, builds); err != nil {
+			return fmt.Errorf("failed to deploy %s: %w", deployment.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	olog.Entry(ctx).Infof("Terraform Deployer: All deployments completed for config %s", t.configName)
+	return nil
+}
+
+// Plan runs `terraform plan` for every deployment without applying the saved plans.
+func (t *Deployer) Plan(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	_, err := t.PlanSummaries(ctx, out, builds)
+	return err
+}
+
+// PlanSummaries runs `terraform plan` for every deployment, in dependency order, and returns what each plan would change.
+func (t *Deployer) PlanSummaries(ctx context.Context, out io.Writer, builds []graph.Artifact) ([]PlanSummary, error) {
+	return t.planAll(ctx, out, builds, func(deployment *latest.TerrformDeployments) *latest.TerrformDeployments {
+		return deployment
+	})
+}
+
+// DriftSummaries plans every deployment like PlanSummaries.
+// Only the `imageVars` of the given builds are set, so that the images that weren't built don't show up as changes.
+func (t *Deployer) DriftSummaries(ctx context.Context, out io.Writer, builds []graph.Artifact) ([]PlanSummary, error) {
+	built := map[string]bool{}
+	for _, build := range builds {
+		built[build.ImageName] = true
+	}
+	return t.planAll(ctx, out, builds, func(deployment *latest.TerrformDeployments) *latest.TerrformDeployments {
+		preview := *deployment
+		preview.ImageVars = map[string]string{}
+		for name, image := range deployment.ImageVars {
+			if built[image] {
+				preview.ImageVars[name] = image
+			}
+		}
+		return &preview
+	})
+}
+
+// planAll plans every deployment, in dependency order, after applying the given changes to its configuration.
+func (t *Deployer) planAll(ctx context.Context, out io.Writer, builds []graph.Artifact, configure func(*latest.TerrformDeployments) *latest.TerrformDeployments) ([]PlanSummary, error) {
+	summaries := make([]PlanSummary, len(t.Deployments))
+	err := t.runInOrder(ctx, out, func(ctx context.Context, out io.Writer, i int, deployment *latest.TerrformDeployments) error {
+		summary, err := t.planTerraform(ctx, out, configure(deployment), builds, planOptions{preview: true})
+		if err != nil {
+			return fmt.Errorf("failed to plan %s: %w", deployment.Name, err)
+		}
+		summaries[i] = summary
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+// deploymentOrder sorts the deployments so that each one comes after the deployments it depends on.
+func (t *Deployer) deploymentOrder() ([]*latest.TerrformDeployments, error) {
 	// Create a map of deployments by name for easy lookup
 	deploymentMap := make(map[string]*latest.TerrformDeployments)
 	for i := range t.Deployments {
@@ -67,12 +172,12 @@ func (t *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 
 		// Add dependencies first
 		for _, depName := range deployment.DependsOn {
-			if dep, ok := deploymentMap[depName]; ok {
-				if err := addToOrder(dep); err != nil {
-					return err
-				}
-			} else {
-				olog.Entry(ctx).Warnf("Dependency %s not found for deployment %s", depName, deployment.Name)
+			dep, ok := deploymentMap[depName]
+			if !ok {
+				return fmt.Errorf("deployment %s depends on unknown deployment %s", deployment.Name, depName)
+			}
+			if err := addToOrder(dep); err != nil {
+				return err
 			}
 		}
 
@@ -85,19 +190,10 @@ func (t *Deployer) Deploy(ctx context.Context, out io.Writer, builds []graph.Art
 	// Build the deployment order
 	for _, deployment := range t.Deployments {
 		if err := addToOrder(&deployment); err != nil {
-			return err
+			return nil, err
 		}
 	}
-
-	// Execute deployments in order
-	for _, deployment := range deploymentOrder {
-		if err := t.deployTerraform(ctx, out, deployment); err != nil {
-			return fmt.Errorf("failed to deploy %s: %w", deployment.Name, err)
-		}
-	}
-
-	olog.Entry(ctx).Infof("Terraform Deployer: All deployments completed for config %s", t.configName)
-	return nil
+	return deploymentOrder, nil
 }
 
 // Helper function to check if a slice contains a string
@@ -110,85 +206,247 @@ func contains(slice []string, str string) bool {
 	return false
 }
 
-func (t *Deployer) deployTerraform(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments) error {
-	workingDir := deployment.Dir
+func (t *Deployer) deployTerraform(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments, builds []graph.Artifact) error {
+	// Hooks only run when the plan gets applied
+	applying := !t.cfg.DryRun() && !t.cfg.RenderOnly()
+	hookRunner := hooks.NewTerraformDeployRunner(deployment.LifecycleHooks, hooks.NewDeployEnvOpts(t.cfg.GetRunID(), "", []string{}), &hooks.TerraformEnvOpts{
+		TerraformDeployment: deployment.Name,
+		TerraformWorkspace:  deployment.Workspace,
+		TerraformDir:        deployment.Dir,
+	}, t.cfg.TemplateVars().Values)
+	if applying {
+		if err := hookRunner.RunPreHooks(ctx, out); err != nil {
+			return err
+		}
+	}
+
+	summary, err := t.planTerraform(ctx, out, deployment, builds, planOptions{preview: !applying})
+	if err != nil {
+		return err
+	}
+
+	if !applying {
+		output.Yellow.Fprintf(out, "Terraform Deployer: Not applying the plan for %s (dry run)\n", deployment.Name)
+		return nil
+	}
+
+	// Apply the saved plan, so that exactly the changes that were shown get applied.
+	// Terraform doesn't ask for confirmation before applying a saved plan.
+	var applyOut bytes.Buffer
+	if err := t.runLockingCommand(ctx, io.MultiWriter(out, &applyOut), deployment, "apply", planFile(deployment)); err != nil {
+		return fmt.Errorf("failed to run terraform apply: %w", err)
+	}
+	t.monitor.record(parseApply(deployment.Name, applyOut.Bytes(), summary))
+
+	// Expose the outputs to the deployments and renderers that run afterwards
+	if err := t.readOutputs(ctx, deployment); err != nil {
+		return err
+	}
+
+	if err := hookRunner.RunPostHooks(ctx, out); err != nil {
+		return err
+	}
+
+	olog.Entry(ctx).Infof("Terraform Deployer: Deployment completed for %s (%s)", deployment.Name, summary)
+	return nil
+}
+
+// planOptions changes how a deployment gets planned.
+type planOptions struct {
+	// destroy plans to destroy every resource of the deployment.
+	destroy bool
+	// preview is set when the plan won't be applied, so that the images and the outputs
+	// of the deployments it depends on don't need to be known, and the saved plan is left untouched.
+	preview bool
+}
+
+// planTerraform initializes the deployment, saves its plan and reports what the plan would change.
+// The plan is saved to the plan file only when it's going to be applied, previews use a temporary file.
+func (t *Deployer) planTerraform(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments, builds []graph.Artifact, opts planOptions) (PlanSummary, error) {
+	if err := t.initTerraform(ctx, out, deployment); err != nil {
+		return PlanSummary{}, err
+	}
+
+	plan := planFile(deployment)
+	if opts.preview {
+		planDir, err := tempDir("", "skaffold-terraform-plan")
+		if err != nil {
+			return PlanSummary{}, fmt.Errorf("creating plan directory: %w", err)
+		}
+		defer os.RemoveAll(planDir)
+		plan = filepath.Join(planDir, deployment.Name+".tfplan")
+	}
+
+	// Outputs of the deployments this one depends on are only guaranteed to be known when they were applied
+	vars, err := deploymentVars(ctx, deployment, builds, t.cfg.TemplateVars().Values(), !opts.destroy && !opts.preview)
+	if err != nil {
+		return PlanSummary{}, err
+	}
+
+	// Prepare plan command with vars, var-files, and extra args
+	planArgs := []string{"-input=false"}
+	if opts.destroy {
+		planArgs = append(planArgs, "-destroy")
+	}
+	planArgs = append(planArgs, "-out="+plan)
+	planArgs = append(planArgs, varArgs(vars, deployment.VarFiles)...)
+	planArgs = append(planArgs, deployment.ExtraArgs...)
+
+	// Run terraform plan
+	if err := t.runLockingCommand(ctx, out, deployment, "plan", planArgs...); err != nil {
+		return PlanSummary{}, fmt.Errorf("failed to run terraform plan: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "terraform", "show", "-json", plan)
+	cmd.Dir = deployment.Dir
+	b, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return PlanSummary{}, fmt.Errorf("failed to run terraform show: %w", err)
+	}
 
-	// Run terraform init
+	summary, err := parsePlan(deployment.Name, b)
+	if err != nil {
+		return PlanSummary{}, err
+	}
+	summary.Print(out)
+
+	// Outputs known at plan time let `skaffold render` and dry runs resolve templates without applying
+	if !opts.destroy {
+		outputs, err := plannedOutputs(deployment.Name, b)
+		if err != nil {
+			return PlanSummary{}, err
+		}
+		t.cfg.TemplateVars().Set(outputsSource(deployment.Name), outputs)
+	}
+
+	eventV2.TerraformPlanned(deployment.Name, len(summary.Add), len(summary.Change), len(summary.Destroy), summary.Drifted)
+	return summary, nil
+}
+
+// initTerraform runs `terraform init` with the deployment's backend configuration, and selects its workspace.
+func (t *Deployer) initTerraform(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments) error {
 	initArgs := []string{"init"}
-	for key, value := range deployment.BackendConfig {
-		initArgs = append(initArgs, fmt.Sprintf("-backend-config=%s=%s", key, value))
+	for _, key := range sortedKeys(deployment.BackendConfig) {
+		initArgs = append(initArgs, fmt.Sprintf("-backend-config=%s=%s", key, deployment.BackendConfig[key]))
 	}
-	if err := t.runTerraformCommand(ctx, out, workingDir, initArgs...); err != nil {
+	if err := t.runTerraformCommand(ctx, out, deployment.Dir, initArgs...); err != nil {
 		return fmt.Errorf("failed to run terraform init: %w", err)
 	}
 
 	// Set or select workspace if specified
 	if deployment.Workspace != "" {
 		workspaceArgs := []string{"workspace", "select", "-or-create", deployment.Workspace}
-		if err := t.runTerraformCommand(ctx, out, workingDir, workspaceArgs...); err != nil {
+		if err := t.runTerraformCommand(ctx, out, deployment.Dir, workspaceArgs...); err != nil {
 			return fmt.Errorf("failed to set terraform workspace: %w", err)
 		}
 	}
+	return nil
+}
 
-	// Prepare apply command with vars, var-files, and extra args
-	applyArgs := []string{"apply"}
-	for key, value := range deployment.Vars {
-		applyArgs = append(applyArgs, "-var", fmt.Sprintf("%s=%s", key, value))
-	}
-	for _, varFile := range deployment.VarFiles {
-		applyArgs = append(applyArgs, "-var-file", varFile)
-	}
-	applyArgs = append(applyArgs, deployment.ExtraArgs...)
-	if deployment.AutoApprove {
-		applyArgs = append(applyArgs, "-auto-approve")
+// planFile returns the path of the deployment's saved plan, relative to its directory.
+func planFile(deployment *latest.TerrformDeployments) string {
+	if deployment.PlanFile != "" {
+		return deployment.PlanFile
 	}
+	return defaultPlanFile
+}
 
-	// Run terraform apply
-	if err := t.runTerraformCommand(ctx, out, workingDir, applyArgs...); err != nil {
-		return fmt.Errorf("failed to run terraform apply: %w", err)
+// varArgs returns the `-var` and `-var-file` arguments for a deployment.
+func varArgs(vars map[string]string, varFiles []string) []string {
+	var args []string
+	for _, key := range sortedKeys(vars) {
+		args = append(args, "-var", fmt.Sprintf("%s=%s", key, vars[key]))
 	}
-
-	olog.Entry(ctx).Infof("Terraform Deployer: Deployment completed for %s", deployment.Name)
-	return nil
+	for _, varFile := range varFiles {
+		args = append(args, "-var-file", varFile)
+	}
+	return args
 }
 
-func (t *Deployer) Dependencies() ([]string, error) {
-	olog.Entry(context.Background()).Infof("Terraform Deployer: Checking dependencies")
-	return nil, nil
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
+// Cleanup destroys the deployments in reverse dependency order, so that each deployment is destroyed before the deployments it depends on.
+// A failure doesn't stop the other deployments from being destroyed.
 func (t *Deployer) Cleanup(ctx context.Context, out io.Writer, dryRun bool, _ manifest.ManifestListByConfig) error {
 	olog.Entry(ctx).Infof("Terraform Deployer: Starting cleanup for config %s", t.configName)
 
-	for _, deployment := range t.Deployments {
-		workingDir := deployment.Dir
-
-		if dryRun {
-			fmt.Fprintf(out, "Terraform Deployer: Would run 'terraform destroy' for %s (dry run)\n", deployment.Dir)
-		} else {
-			// Prepare destroy command with vars, var-files, and extra args
-			destroyArgs := []string{"destroy"}
-			for key, value := range deployment.Vars {
-				destroyArgs = append(destroyArgs, "-var", fmt.Sprintf("%s=%s", key, value))
-			}
-			for _, varFile := range deployment.VarFiles {
-				destroyArgs = append(destroyArgs, "-var-file", varFile)
-			}
-			destroyArgs = append(destroyArgs, deployment.ExtraArgs...)
-			destroyArgs = append(destroyArgs, "-auto-approve")
+	deploymentOrder, err := t.deploymentOrder()
+	if err != nil {
+		return deployerr.CleanupErr(err)
+	}
 
-			if err := t.runTerraformCommand(ctx, out, workingDir, destroyArgs...); err != nil {
-				return fmt.Errorf("failed to run terraform destroy: %w", err)
-			}
+	var errMsgs []string
+	for i := len(deploymentOrder) - 1; i >= 0; i-- {
+		deployment := deploymentOrder[i]
+		if err := t.destroyTerraform(ctx, out, deployment, dryRun); err != nil {
+			errMsgs = append(errMsgs, fmt.Sprintf("failed to destroy %s: %v", deployment.Name, err))
+			continue
 		}
-
-		olog.Entry(ctx).Infof("Terraform Deployer: Cleanup completed for %s", deployment.Dir)
+		olog.Entry(ctx).Infof("Terraform Deployer: Cleanup completed for %s", deployment.Name)
 	}
 
+	if len(errMsgs) != 0 {
+		return deployerr.CleanupErr(errors.New(strings.Join(errMsgs, "\n")))
+	}
 	olog.Entry(ctx).Infof("Terraform Deployer: All cleanups completed for config %s", t.configName)
 	return nil
 }
 
+// destroyTerraform destroys a deployment. A dry run only shows the plan to destroy it.
+func (t *Deployer) destroyTerraform(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments, dryRun bool) error {
+	// The deployments this one depends on haven't been destroyed yet, so their outputs can still be read
+	for _, dep := range deployment.DependsOn {
+		if err := t.loadOutputs(ctx, out, dep); err != nil {
+			return err
+		}
+	}
+
+	if dryRun {
+		_, err := t.planTerraform(ctx, out, deployment, t.builds, planOptions{destroy: true, preview: true})
+		return err
+	}
+
+	if err := t.initTerraform(ctx, out, deployment); err != nil {
+		return err
+	}
+
+	vars, err := deploymentVars(ctx, deployment, t.builds, t.cfg.TemplateVars().Values(), false)
+	if err != nil {
+		return err
+	}
+
+	// Prepare destroy command with vars, var-files, and extra args
+	destroyArgs := varArgs(vars, deployment.VarFiles)
+	destroyArgs = append(destroyArgs, deployment.ExtraArgs...)
+	destroyArgs = append(destroyArgs, "-auto-approve")
+
+	if err := t.runLockingCommand(ctx, out, deployment, "destroy", destroyArgs...); err != nil {
+		return fmt.Errorf("failed to run terraform destroy: %w", err)
+	}
+	t.cfg.TemplateVars().Set(outputsSource(deployment.Name), nil)
+	return nil
+}
+
+// loadOutputs registers the outputs of an existing deployment, for the deployments that depend on it.
+func (t *Deployer) loadOutputs(ctx context.Context, out io.Writer, name string) error {
+	for i := range t.Deployments {
+		if deployment := &t.Deployments[i]; deployment.Name == name {
+			if err := t.initTerraform(ctx, out, deployment); err != nil {
+				return err
+			}
+			return t.readOutputs(ctx, deployment)
+		}
+	}
+	return nil
+}
+
 func (t *Deployer) runTerraformCommand(ctx context.Context, out io.Writer, workingDir string, args ...string) error {
 	cmd := exec.CommandContext(ctx, "terraform", args...)
 	cmd.Dir = workingDir
@@ -196,7 +454,35 @@ func (t *Deployer) runTerraformCommand(ctx context.Context, out io.Writer, worki
 	cmd.Stderr = out
 
 	olog.Entry(ctx).Infof("Running terraform command: %s", cmd.String())
-	return cmd.Run()
+	return util.RunCmd(ctx, cmd)
+}
+
+// HasRunnableHooks returns true if the deployer hooks run, which they don't when the plans aren't applied.
+func (t *Deployer) HasRunnableHooks() bool {
+	if t.cfg.DryRun() || t.cfg.RenderOnly() {
+		return false
+	}
+	return len(t.LifecycleHooks.PreHooks) > 0 || len(t.LifecycleHooks.PostHooks) > 0
+}
+
+func (t *Deployer) PreDeployHooks(ctx context.Context, out io.Writer) error {
+	childCtx, endTrace := instrumentation.StartTrace(ctx, "Deploy_PreHooks")
+	if err := t.hookRunner.RunPreHooks(childCtx, out); err != nil {
+		endTrace(instrumentation.TraceEndError(err))
+		return err
+	}
+	endTrace()
+	return nil
+}
+
+func (t *Deployer) PostDeployHooks(ctx context.Context, out io.Writer) error {
+	childCtx, endTrace := instrumentation.StartTrace(ctx, "Deploy_PostHooks")
+	if err := t.hookRunner.RunPostHooks(childCtx, out); err != nil {
+		endTrace(instrumentation.TraceEndError(err))
+		return err
+	}
+	endTrace()
+	return nil
 }
 
 func (t *Deployer) ConfigName() string {
@@ -213,10 +499,16 @@ func (t *Deployer) GetLogger() log.Logger {
 
 // func (t *Deployer) GetStatusMonitor() status.Monitor                      { return nil }
 // func (t *Deployer) GetSyncer() sync.Syncer                                { return nil }
-func (t *Deployer) TrackBuildArtifacts(builds, deployed []graph.Artifact) {}
-func (t *Deployer) RegisterLocalImages(images []graph.Artifact)           {}
+func (t *Deployer) TrackBuildArtifacts(builds, _ []graph.Artifact) {
+	t.builds = builds
+}
+
+func (t *Deployer) RegisterLocalImages(images []graph.Artifact) {}
+
+// DeploysMachineImages returns true since the `imageVars` of a deployment can reference machine images, like AMIs.
+func (t *Deployer) DeploysMachineImages() bool { return true }
 
 func (t *Deployer) GetAccessor() access.Accessor     { return &access.NoopAccessor{} }
 func (t *Deployer) GetDebugger() debug.Debugger      { return &debug.NoopDebugger{} }
-func (t *Deployer) GetStatusMonitor() status.Monitor { return &status.NoopMonitor{} }
+func (t *Deployer) GetStatusMonitor() status.Monitor { return t.monitor }
 func (t *Deployer) GetSyncer() sync.Syncer           { return &sync.NoopSyncer{} }
@@ -7,20 +7,33 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes/manifest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 var (
-	execCommand = exec.Command
-	testTmpDir  string
-	testCfg     latest.TerraformDeploy
+	testTmpDir string
+	testCfg    latest.TerraformDeploy
 )
 
+// testPlan is the `terraform show -json` output for a plan that creates a single resource.
+const testPlan = `{
+  "format_version": "1.2",
+  "resource_changes": [
+    {"address": "null_resource.example", "change": {"actions": ["create"]}}
+  ]
+}`
+
 func TestMain(m *testing.M) {
 	// Setup
 	var err error
@@ -62,10 +75,9 @@ output "key_value" {
 	testCfg = latest.TerraformDeploy{
 		Deployments: []latest.TerrformDeployments{
 			{
-				Name:        "test-deployment",
-				Dir:         testTmpDir,
-				Vars:        map[string]string{"key": "test_value"},
-				AutoApprove: true,
+				Name: "test-deployment",
+				Dir:  testTmpDir,
+				Vars: map[string]string{"key": "test_value"},
 			},
 		},
 	}
@@ -79,26 +91,25 @@ output "key_value" {
 	os.Exit(code)
 }
 
-// Update the mock struct to implement manifest.ManifestList
-type mockManifestList struct{}
-
-func (m *mockManifestList) GetManifests() manifest.ManifestList {
-	return manifest.ManifestList{}
-}
-
-func (m *mockManifestList) Append(manifest manifest.ManifestList) {
-	// Do nothing for the mock
+type mockConfig struct {
+	dryRun       bool
+	renderOnly   bool
+	templateVars *util.TemplateVars
 }
 
-func (m *mockManifestList) String() string {
-	return ""
+func (c *mockConfig) DryRun() bool     { return c.dryRun }
+func (c *mockConfig) RenderOnly() bool { return c.renderOnly }
+func (c *mockConfig) GetRunID() string { return "run_id" }
+func (c *mockConfig) TemplateVars() *util.TemplateVars {
+	if c.templateVars == nil {
+		c.templateVars = util.NewTemplateVars()
+	}
+	return c.templateVars
 }
 
 // Helper function to create a mock ManifestListByConfig
 func createMockManifestListByConfig() manifest.ManifestListByConfig {
-	return manifest.ManifestListByConfig{
-		//"test-config": &mockManifestList{},
-	}
+	return manifest.ManifestListByConfig{}
 }
 
 func TestNewDeployer(t *testing.T) {
@@ -109,122 +120,439 @@ func TestNewDeployer(t *testing.T) {
 	}
 	configName := "test-config"
 
-	deployer, err := NewDeployer(cfg, configName)
+	deployer, err := NewDeployer(&mockConfig{}, &cfg, configName)
 	require.NoError(t, err)
 	assert.Equal(t, configName, deployer.ConfigName())
 	assert.Equal(t, &cfg, deployer.TerraformDeploy)
 }
 
 func TestDeploy(t *testing.T) {
-	// Mock exec.Command
-	execCommand = mockExecCommand
-	defer func() { execCommand = exec.Command }()
+	testutil.Run(t, "", func(tt *testutil.T) {
+		tt.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan -var key=test_value").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRunWithOutput("terraform apply skaffold.tfplan", "Creation complete\n").
+			AndRunOut("terraform output -json", `{"key_value": {"sensitive": false, "type": "string", "value": "test_value"}}`))
+
+		deployer, err := NewDeployer(&mockConfig{}, &testCfg, "test-config")
+		require.NoError(t, err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		assert.NoError(t, err)
+		output := out.String()
+		assert.Contains(t, output, "Terraform plan for test-deployment: 1 to add, 0 to change, 0 to destroy")
+		assert.Contains(t, output, "Creation complete")
+	})
+}
 
-	deployer, err := NewDeployer(testCfg, "test-config")
-	require.NoError(t, err)
+func TestDeployDryRun(t *testing.T) {
+	tests := []struct {
+		description string
+		cfg         *mockConfig
+	}{
+		{
+			description: "dry run",
+			cfg:         &mockConfig{dryRun: true},
+		},
+		{
+			description: "render only",
+			cfg:         &mockConfig{renderOnly: true},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			// terraform apply isn't expected to be called, and the saved plan isn't replaced
+			plan := previewPlan(t, "test-deployment")
+			t.Override(&util.DefaultExecCommand, testutil.
+				CmdRun("terraform init").
+				AndRun("terraform plan -input=false -out="+plan+" -var key=test_value").
+				AndRunOut("terraform show -json "+plan, testPlan))
+
+			deployer, err := NewDeployer(test.cfg, &testCfg, "test-config")
+			t.CheckNoError(err)
+
+			out := &bytes.Buffer{}
+			err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+			t.CheckNoError(err)
+			t.CheckContains("Not applying the plan for test-deployment", out.String())
+		})
+	}
+}
 
-	ctx := context.Background()
-	out := &bytes.Buffer{}
+func TestPlanWithPlanFileAndWorkspace(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// Previews don't replace the saved plan
+		plan := previewPlan(t, "app")
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init -backend-config=bucket=state -backend-config=prefix=app").
+			AndRun("terraform workspace select -or-create staging").
+			AndRun("terraform plan -input=false -out="+plan+" -var a=1 -var b=2 -var-file prod.tfvars -refresh=false").
+			AndRunOut("terraform show -json "+plan, `{}`))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{
+				Name:          "app",
+				Dir:           ".",
+				BackendConfig: map[string]string{"prefix": "app", "bucket": "state"},
+				Workspace:     "staging",
+				Vars:          map[string]string{"b": "2", "a": "1"},
+				VarFiles:      []string{"prod.tfvars"},
+				ExtraArgs:     []string{"-refresh=false"},
+				PlanFile:      "app.tfplan",
+			}},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		summaries, err := deployer.PlanSummaries(context.Background(), &bytes.Buffer{}, nil)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]PlanSummary{{Deployment: "app"}}, summaries)
+	})
+}
 
-	mockLabellers := createMockManifestListByConfig()
+// previewPlan returns where the plan of a deployment that isn't applied gets saved.
+func previewPlan(t *testutil.T, deployment string) string {
+	planDir := t.NewTempDir().Root()
+	t.Override(&tempDir, func(string, string) (string, error) { return planDir, nil })
+	return filepath.Join(planDir, deployment+".tfplan")
+}
+
+func TestCleanup(t *testing.T) {
+	testutil.Run(t, "", func(tt *testutil.T) {
+		tt.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRunWithOutput("terraform destroy -var key=test_value -auto-approve", "Destroy complete\n"))
 
-	err = deployer.Deploy(ctx, out, nil, mockLabellers)
+		deployer, err := NewDeployer(&mockConfig{}, &testCfg, "test-config")
+		require.NoError(t, err)
 
-	assert.NoError(t, err)
-	output := out.String()
-	assert.Contains(t, output, "Creation complete")
+		out := &bytes.Buffer{}
+		err = deployer.Cleanup(context.Background(), out, false, createMockManifestListByConfig())
+
+		assert.NoError(t, err)
+		output := out.String()
+		assert.Contains(t, output, "Destroy complete")
+	})
 }
 
-func TestCleanup(t *testing.T) {
-	// Mock exec.Command
-	execCommand = mockExecCommand
-	defer func() { execCommand = exec.Command }()
+func TestDeployAndCleanupWithImageVars(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan -var api_image=gcr.io/x/api:v1@sha256:abc -var region=us").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRun("terraform apply skaffold.tfplan").
+			AndRunOut("terraform output -json", `{}`).
+			AndRun("terraform init").
+			AndRun("terraform destroy -var api_image=gcr.io/x/api:v1@sha256:abc -var region=us -auto-approve"))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{
+				Name:      "app",
+				Dir:       "app",
+				Vars:      map[string]string{"region": "us"},
+				ImageVars: map[string]string{"api_image": "gcr.io/x/api"},
+			}},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		builds := []graph.Artifact{{ImageName: "gcr.io/x/api", Tag: "gcr.io/x/api:v1@sha256:abc"}}
+		err = deployer.Deploy(context.Background(), &bytes.Buffer{}, builds, createMockManifestListByConfig())
+		t.CheckNoError(err)
+
+		err = deployer.Cleanup(context.Background(), &bytes.Buffer{}, false, createMockManifestListByConfig())
+		t.CheckNoError(err)
+	})
+}
 
-	deployer, err := NewDeployer(testCfg, "test-config")
-	require.NoError(t, err)
+func TestCleanupInReverseOrder(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		// app is destroyed first, with the outputs of network that still exists, and a failure doesn't stop network from being destroyed
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init -backend-config=bucket=state").
+			AndRun("terraform workspace select -or-create staging").
+			AndRunOut("terraform output -json", `{"subnet_id": {"sensitive": false, "type": "string", "value": "subnet-2"}}`).
+			AndRun("terraform init").
+			AndRunErr("terraform destroy -var subnet=subnet-2 -auto-approve", fmt.Errorf("state is locked")).
+			AndRun("terraform init -backend-config=bucket=state").
+			AndRun("terraform workspace select -or-create staging").
+			AndRun("terraform destroy -auto-approve"))
+
+		cfg := &mockConfig{}
+		deployer, err := NewDeployer(cfg, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app", Vars: map[string]string{"subnet": "{{.TF_network_subnet_id}}"}, DependsOn: []string{"network"}},
+				{Name: "network", Dir: "network", BackendConfig: map[string]string{"bucket": "state"}, Workspace: "staging"},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
 
-	ctx := context.Background()
-	out := &bytes.Buffer{}
+		err = deployer.Cleanup(context.Background(), &bytes.Buffer{}, false, createMockManifestListByConfig())
 
-	mockLabellers := createMockManifestListByConfig()
+		t.CheckErrorContains("failed to destroy app", err)
+		t.CheckFalse(strings.Contains(err.Error(), "failed to destroy network"))
+		// The outputs of the destroyed network are no longer available to templates
+		t.CheckDeepEqual(map[string]string{}, cfg.TemplateVars().Values())
+	})
+}
+
+func TestCleanupDryRun(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		plan := previewPlan(t, "test-deployment")
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform plan -input=false -destroy -out="+plan+" -var key=test_value").
+			AndRunOut("terraform show -json "+plan, `{"resource_changes": [{"address": "null_resource.example", "change": {"actions": ["delete"]}}]}`))
+
+		deployer, err := NewDeployer(&mockConfig{}, &testCfg, "test-config")
+		t.CheckNoError(err)
 
-	err = deployer.Cleanup(ctx, out, false, mockLabellers)
+		out := &bytes.Buffer{}
+		err = deployer.Cleanup(context.Background(), out, true, createMockManifestListByConfig())
 
-	assert.NoError(t, err)
-	output := out.String()
-	assert.Contains(t, output, "Destroy complete")
+		t.CheckNoError(err)
+		t.CheckContains("Terraform plan for test-deployment: 0 to add, 0 to change, 1 to destroy", out.String())
+	})
 }
 
-// Add this function near the top of the file, after the imports
-func setupDependencyTest() (string, error) {
-	// Create a temporary directory for the dependency test
-	tmpDir, err := os.MkdirTemp("", "skaffold-terraform-dependency-test")
-	if err != nil {
-		return "", fmt.Errorf("failed to create temp dir: %v", err)
-	}
+func TestDeployHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		if runtime.GOOS == "windows" {
+			t.Skip("hooks use sh")
+		}
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform workspace select -or-create staging").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRun("terraform apply skaffold.tfplan").
+			AndRunOut("terraform output -json", `{"url": {"sensitive": false, "type": "string", "value": "https://app.example.com"}}`))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{
+				Name:      "app",
+				Dir:       ".",
+				Workspace: "staging",
+				LifecycleHooks: latest.TerraformDeployHooks{
+					PreHooks:  []latest.HostHook{{Command: []string{"sh", "-c", "echo before $SKAFFOLD_TERRAFORM_DEPLOYMENT in $SKAFFOLD_TERRAFORM_WORKSPACE"}}},
+					PostHooks: []latest.HostHook{{Command: []string{"sh", "-c", "echo after $SKAFFOLD_TERRAFORM_DEPLOYMENT: $TF_app_url"}}},
+				},
+			}},
+			LifecycleHooks: latest.TerraformDeployHooks{
+				PostHooks: []latest.HostHook{{Command: []string{"sh", "-c", "echo all done for $SKAFFOLD_RUN_ID: $TF_app_url"}}},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+		t.CheckNoError(err)
+		t.CheckContains("before app in staging\n", out.String())
+		t.CheckContains("after app: https://app.example.com\n", out.String())
+
+		t.CheckDeepEqual(true, deployer.HasRunnableHooks())
+		out.Reset()
+		err = deployer.PostDeployHooks(context.Background(), out)
+		t.CheckNoError(err)
+		t.CheckContains("all done for run_id: https://app.example.com\n", out.String())
+	})
+}
 
-	// Create subdirectories for each deployment
-	dirs := []string{"dep1", "dep2", "dep3"}
-	for _, dir := range dirs {
-		err := os.Mkdir(filepath.Join(tmpDir, dir), 0755)
-		if err != nil {
-			os.RemoveAll(tmpDir)
-			return "", fmt.Errorf("failed to create directory %s: %v", dir, err)
+func TestDeployWithDependencies(t *testing.T) {
+	testutil.Run(t, "", func(tt *testutil.T) {
+		// Deployments are declared out of order, and must run after the deployments they depend on.
+		tt.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRunWithOutput("terraform apply skaffold.tfplan", "Creation complete for dep1\n").
+			AndRunOut("terraform output -json", `{}`).
+			AndRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRunWithOutput("terraform apply skaffold.tfplan", "Creation complete for dep2\n").
+			AndRunOut("terraform output -json", `{}`).
+			AndRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRunWithOutput("terraform apply skaffold.tfplan", "Creation complete for dep3\n").
+			AndRunOut("terraform output -json", `{}`))
+
+		cfg := latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "dep3", Dir: "dep3", DependsOn: []string{"dep2"}, AutoApprove: true},
+				{Name: "dep1", Dir: "dep1", AutoApprove: true},
+				{Name: "dep2", Dir: "dep2", DependsOn: []string{"dep1"}, AutoApprove: true},
+			},
 		}
+		deployer, err := NewDeployer(&mockConfig{}, &cfg, "test-config")
+		require.NoError(t, err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		assert.NoError(t, err)
+		// Check if the order is correct
+		dep1Index := bytes.Index(out.Bytes(), []byte("Creation complete for dep1"))
+		dep2Index := bytes.Index(out.Bytes(), []byte("Creation complete for dep2"))
+		dep3Index := bytes.Index(out.Bytes(), []byte("Creation complete for dep3"))
+		assert.True(t, dep1Index >= 0 && dep1Index < dep2Index && dep2Index < dep3Index)
+	})
+}
 
-		// Create a simple main.tf file in each directory
-		tfContent := fmt.Sprintf(`
-resource "null_resource" "%s" {
-  provisioner "local-exec" {
-    command = "echo This is %s"
-  }
+func TestDeployWithDependencyOutputs(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRun("terraform apply skaffold.tfplan").
+			AndRunOut("terraform output -json", `{"subnet-id": {"sensitive": false, "type": "string", "value": "subnet-1"}}`).
+			AndRun("terraform init").
+			AndRun("terraform plan -input=false -out=skaffold.tfplan -var subnet=subnet-1").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRun("terraform apply skaffold.tfplan").
+			AndRunOut("terraform output -json", `{}`))
+
+		cfg := &mockConfig{}
+		deployer, err := NewDeployer(cfg, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app", Vars: map[string]string{"subnet": "{{.TF_network_subnet_id}}"}, DependsOn: []string{"network"}, AutoApprove: true},
+				{Name: "network", Dir: "network", AutoApprove: true},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		err = deployer.Deploy(context.Background(), &bytes.Buffer{}, nil, createMockManifestListByConfig())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("subnet-1", cfg.TemplateVars().Values()["TF_network_subnet_id"])
+	})
 }
-`, dir, dir)
-		err = os.WriteFile(filepath.Join(tmpDir, dir, "main.tf"), []byte(tfContent), 0644)
-		if err != nil {
-			os.RemoveAll(tmpDir)
-			return "", fmt.Errorf("failed to write main.tf for %s: %v", dir, err)
-		}
-	}
 
-	return tmpDir, nil
+// concurrentCmd fakes terraform for deployments that run concurrently, where the order of the commands isn't known.
+// It records the commands that ran, and prints the directory of the deployment for every command.
+type concurrentCmd struct {
+	lock sync.Mutex
+	ran  []string
 }
 
-// Update the TestDeployWithDependencies function
-func TestDeployWithDependencies(t *testing.T) {
-	// Set up the test environment
-	tmpDir, err := setupDependencyTest()
-	require.NoError(t, err)
-	defer os.RemoveAll(tmpDir)
+func (c *concurrentCmd) RunCmdOut(_ context.Context, cmd *exec.Cmd) ([]byte, error) {
+	c.record(cmd)
+	return []byte(testPlan), nil
+}
 
-	// Mock exec.Command
-	execCommand = mockExecCommand
-	defer func() { execCommand = exec.Command }()
+func (c *concurrentCmd) RunCmdOutOnce(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	return c.RunCmdOut(ctx, cmd)
+}
 
-	cfg := latest.TerraformDeploy{
-		Deployments: []latest.TerrformDeployments{
-			{Name: "dep1", Dir: filepath.Join(tmpDir, "dep1"), AutoApprove: true},
-			{Name: "dep2", Dir: filepath.Join(tmpDir, "dep2"), DependsOn: []string{"dep1"}, AutoApprove: true},
-			{Name: "dep3", Dir: filepath.Join(tmpDir, "dep3"), DependsOn: []string{"dep2"}, AutoApprove: true},
-		},
-	}
-	deployer, err := NewDeployer(cfg, "test-config")
-	require.NoError(t, err)
+func (c *concurrentCmd) RunCmd(_ context.Context, cmd *exec.Cmd) error {
+	c.record(cmd)
+	_, err := fmt.Fprintf(cmd.Stdout, "%s in %s\n", cmd.Args[1], cmd.Dir)
+	return err
+}
 
-	ctx := context.Background()
+func (c *concurrentCmd) record(cmd *exec.Cmd) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.ran = append(c.ran, cmd.Dir+": "+strings.Join(cmd.Args, " "))
+}
+
+func TestDeployInParallel(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fake := &concurrentCmd{}
+		t.Override(&util.DefaultExecCommand, fake)
+		plan := previewPlan(t, "network")
+
+		deployer, err := NewDeployer(&mockConfig{dryRun: true}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "api", Dir: "api", DependsOn: []string{"network"}},
+				{Name: "network", Dir: "network"},
+				{Name: "web", Dir: "web", DependsOn: []string{"network"}},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		t.CheckNoError(err)
+		t.CheckContains("[api] init in api\n", out.String())
+		t.CheckContains("[web] Terraform plan for web: 1 to add, 0 to change, 0 to destroy\n", out.String())
+
+		// The deployments depending on network only start once it has completed
+		t.CheckDeepEqual(9, len(fake.ran))
+		t.CheckDeepEqual([]string{
+			"network: terraform init",
+			"network: terraform plan -input=false -out=" + plan,
+			"network: terraform show -json " + plan,
+		}, fake.ran[:3])
+	})
+}
+
+func TestDeployConcurrencyLimit(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fake := &concurrentCmd{}
+		t.Override(&util.DefaultExecCommand, fake)
+
+		deployer, err := NewDeployer(&mockConfig{dryRun: true}, &latest.TerraformDeploy{
+			Concurrency: 1,
+			Deployments: []latest.TerrformDeployments{
+				{Name: "api", Dir: "api"},
+				{Name: "web", Dir: "web"},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		// Deployments that run one at a time aren't prefixed
+		t.CheckNoError(err)
+		t.CheckContains("Terraform plan for api", out.String())
+		t.CheckFalse(strings.Contains(out.String(), "[api]"))
+	})
+}
+
+func TestDeployNegativeConcurrency(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		fake := &concurrentCmd{}
+		t.Override(&util.DefaultExecCommand, fake)
+
+		deployer, err := NewDeployer(&mockConfig{dryRun: true}, &latest.TerraformDeploy{
+			Concurrency: -1,
+			Deployments: []latest.TerrformDeployments{
+				{Name: "api", Dir: "api"},
+				{Name: "web", Dir: "web"},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		// A negative concurrency means no limit
+		t.CheckNoError(err)
+		t.CheckContains("[api] init in api\n", out.String())
+		t.CheckContains("[web] init in web\n", out.String())
+	})
+}
+
+func TestPrefixedWriter(t *testing.T) {
+	var lock sync.Mutex
 	out := &bytes.Buffer{}
-	mockLabellers := createMockManifestListByConfig()
-	err = deployer.Deploy(ctx, out, nil, mockLabellers)
-
-	assert.NoError(t, err)
-	output := out.String()
-	assert.Contains(t, output, "Creation complete")
-	assert.Contains(t, output, "-auto-approve")
-	// Check if the order is correct
-	dep1Index := bytes.Index(out.Bytes(), []byte("dep1"))
-	dep2Index := bytes.Index(out.Bytes(), []byte("dep2"))
-	dep3Index := bytes.Index(out.Bytes(), []byte("dep3"))
-	assert.True(t, dep1Index < dep2Index && dep2Index < dep3Index)
+	w := newPrefixedWriter(out, &lock, "[app] ")
+
+	fmt.Fprint(w, "first line\nsecond")
+	fmt.Fprint(w, " line\nlast")
+	testutil.CheckDeepEqual(t, "[app] first line\n[app] second line\n", out.String())
+
+	w.Flush()
+	testutil.CheckDeepEqual(t, "[app] first line\n[app] second line\n[app] last\n", out.String())
 }
 
 func TestDeployCircularDependency(t *testing.T) {
@@ -234,7 +562,7 @@ func TestDeployCircularDependency(t *testing.T) {
 			{Name: "dep2", Dir: "./dep2", DependsOn: []string{"dep1"}},
 		},
 	}
-	deployer, _ := NewDeployer(cfg, "test-config")
+	deployer, _ := NewDeployer(&mockConfig{}, &cfg, "test-config")
 
 	ctx := context.Background()
 	out := &bytes.Buffer{}
@@ -245,76 +573,17 @@ func TestDeployCircularDependency(t *testing.T) {
 	assert.Contains(t, err.Error(), "circular dependency detected")
 }
 
-// Mock exec.Command
-func mockExecCommand(command string, args ...string) *exec.Cmd {
-	cs := []string{"-test.run=TestHelperProcess", "--", command}
-	cs = append(cs, args...)
-	cmd := exec.Command(os.Args[0], cs...)
-	cmd.Env = []string{"GO_WANT_HELPER_PROCESS=1"}
-	return cmd
-}
-
-// TestHelperProcess isn't a real test. It's used to mock exec.Command
-// This is called by the mock exec.Command to handle the command execution
-func TestHelperProcess(t *testing.T) {
-	if os.Getenv("GO_WANT_HELPER_PROCESS") != "1" {
-		return
-	}
-	defer os.Exit(0)
-
-	args := os.Args
-	for i, val := range args {
-		if val == "--" {
-			args = args[i+1:]
-			break
-		}
-	}
+func TestDeployUnknownDependency(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{
+				{Name: "api", Dir: "./api", DependsOn: []string{"network"}},
+			},
+		}, "test-config")
+		t.CheckNoError(err)
 
-	if len(args) == 0 {
-		fmt.Fprintf(os.Stderr, "No command\n")
-		os.Exit(2)
-	}
+		err = deployer.Deploy(context.Background(), &bytes.Buffer{}, nil, createMockManifestListByConfig())
 
-	cmd, args := args[0], args[1:]
-	switch cmd {
-	case "terraform":
-		switch args[0] {
-		case "init":
-			fmt.Fprintf(os.Stdout, "Mocked terraform init in %s\n", args[len(args)-1])
-		case "apply":
-			autoApprove := false
-			for _, arg := range args {
-				if arg == "-auto-approve" {
-					autoApprove = true
-					break
-				}
-			}
-			if !autoApprove {
-				fmt.Fprintf(os.Stderr, "Expected -auto-approve flag for apply\n")
-				os.Exit(2)
-			}
-			fmt.Fprintf(os.Stdout, "Mocked terraform apply -auto-approve in %s\n", args[len(args)-1])
-			fmt.Fprintf(os.Stdout, "Creation complete for %s\n", filepath.Base(args[len(args)-1]))
-		case "destroy":
-			autoApprove := false
-			for _, arg := range args {
-				if arg == "-auto-approve" {
-					autoApprove = true
-					break
-				}
-			}
-			if !autoApprove {
-				fmt.Fprintf(os.Stderr, "Expected -auto-approve flag for destroy\n")
-				os.Exit(2)
-			}
-			fmt.Fprintf(os.Stdout, "Mocked terraform destroy -auto-approve in %s\n", args[len(args)-1])
-			fmt.Fprintf(os.Stdout, "Destroy complete for %s\n", filepath.Base(args[len(args)-1]))
-		default:
-			fmt.Fprintf(os.Stderr, "Unknown terraform command: %s\n", args[0])
-			os.Exit(2)
-		}
-	default:
-		fmt.Fprintf(os.Stderr, "Unknown command: %s\n", cmd)
-		os.Exit(2)
-	}
+		t.CheckErrorContains("deployment api depends on unknown deployment network", err)
+	})
 }
@@ -0,0 +1,62 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// imageVars returns the values of the deployment's `imageVars`, which are the tags of the built images,
+// or their IDs for machine images, like the AMIs built by Packer.
+// Variables that are also set in the deployment's `vars` are left out, since explicit values win.
+// When strict is set, every image must have been built. Otherwise, the variables of the images that
+// weren't built, for instance when destroying the deployment from `skaffold delete`, are set to the image name.
+func imageVars(ctx context.Context, deployment *latest.TerrformDeployments, builds []graph.Artifact, strict bool) (map[string]string, error) {
+	tags := map[string]string{}
+	for _, build := range builds {
+		if build.MachineImage != "" {
+			tags[build.ImageName] = build.MachineImage
+		} else {
+			tags[build.ImageName] = build.Tag
+		}
+	}
+
+	vars := make(map[string]string, len(deployment.ImageVars))
+	for name, image := range deployment.ImageVars {
+		if _, explicit := deployment.Vars[name]; explicit {
+			continue
+		}
+		tag, found := tags[image]
+		switch {
+		case found:
+			vars[name] = tag
+		case strict:
+			return nil, fmt.Errorf("variable %q of %s references image %q, which wasn't built", name, deployment.Name, image)
+		default:
+			log.Entry(ctx).Warnf("Image %q wasn't built, setting variable %q of %s to the image name", image, name, deployment.Name)
+			vars[name] = image
+		}
+	}
+	return vars, nil
+}
+
+// deploymentVars returns the values of the deployment's variables, with the images mapped by `imageVars`.
+// The variables can reference the given outputs of the deployments it depends on.
+func deploymentVars(ctx context.Context, deployment *latest.TerrformDeployments, builds []graph.Artifact, outputs map[string]string, strict bool) (map[string]string, error) {
+	vars, err := expandVars(deployment, outputs, strict)
+	if err != nil {
+		return nil, err
+	}
+
+	images, err := imageVars(ctx, deployment, builds, strict)
+	if err != nil {
+		return nil, err
+	}
+	for name, tag := range images {
+		vars[name] = tag
+	}
+	return vars, nil
+}
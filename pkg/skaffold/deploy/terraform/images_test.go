@@ -0,0 +1,72 @@
+package terraform
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestImageVars(t *testing.T) {
+	deployment := &latest.TerrformDeployments{
+		Name:      "app",
+		ImageVars: map[string]string{"api_image": "gcr.io/x/api", "web_image": "gcr.io/x/web", "worker_image": "gcr.io/x/worker"},
+		Vars:      map[string]string{"worker_image": "gcr.io/x/worker:pinned"},
+	}
+
+	tests := []struct {
+		description string
+		builds      []graph.Artifact
+		strict      bool
+		expected    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "all images built",
+			builds: []graph.Artifact{
+				{ImageName: "gcr.io/x/api", Tag: "gcr.io/x/api:v1@sha256:abc"},
+				{ImageName: "gcr.io/x/web", Tag: "gcr.io/x/web:v1@sha256:def"},
+			},
+			strict: true,
+			expected: map[string]string{
+				"api_image": "gcr.io/x/api:v1@sha256:abc",
+				"web_image": "gcr.io/x/web:v1@sha256:def",
+			},
+		},
+		{
+			description: "machine image",
+			builds: []graph.Artifact{
+				{ImageName: "gcr.io/x/api", MachineImage: "us-east-1:ami-0123456789abcdef0"},
+				{ImageName: "gcr.io/x/web", Tag: "gcr.io/x/web:v1@sha256:def"},
+			},
+			strict: true,
+			expected: map[string]string{
+				"api_image": "us-east-1:ami-0123456789abcdef0",
+				"web_image": "gcr.io/x/web:v1@sha256:def",
+			},
+		},
+		{
+			description: "missing image falls back to the image name",
+			builds:      []graph.Artifact{{ImageName: "gcr.io/x/api", Tag: "gcr.io/x/api:v1@sha256:abc"}},
+			expected: map[string]string{
+				"api_image": "gcr.io/x/api:v1@sha256:abc",
+				"web_image": "gcr.io/x/web",
+			},
+		},
+		{
+			description: "missing image is an error when strict",
+			builds:      []graph.Artifact{{ImageName: "gcr.io/x/api", Tag: "gcr.io/x/api:v1@sha256:abc"}},
+			strict:      true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			vars, err := imageVars(context.Background(), deployment, test.builds, test.strict)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, vars)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"time"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+)
+
+// defaultLockRetryDelay is the time to wait between attempts when `lockRetry.delay` isn't set.
+const defaultLockRetryDelay = 10 * time.Second
+
+var (
+	stateLockedRegex = regexp.MustCompile(`Error acquiring the state lock`)
+	lockIDRegex      = regexp.MustCompile(`ID:\s+(\S+)`)
+	lockWhoRegex     = regexp.MustCompile(`Who:\s+(\S+)`)
+)
+
+// lockInfo describes the lock held on the state of a deployment by another run.
+type lockInfo struct {
+	ID  string
+	Who string
+}
+
+// parseLockInfo returns the lock reported in the output of a Terraform command,
+// if the command failed because the state is locked.
+func parseLockInfo(out []byte) (lockInfo, bool) {
+	loc := stateLockedRegex.FindIndex(out)
+	if loc == nil {
+		return lockInfo{}, false
+	}
+
+	var lock lockInfo
+	if match := lockIDRegex.FindSubmatch(out[loc[1]:]); match != nil {
+		lock.ID = string(match[1])
+	}
+	if match := lockWhoRegex.FindSubmatch(out[loc[1]:]); match != nil {
+		lock.Who = string(match[1])
+	}
+	return lock, true
+}
+
+// runLockingCommand runs a Terraform command that acquires the state lock, such as `plan`, `apply` or `destroy`.
+// While the state is locked by another run, the command is retried following the deployment's `lockRetry` policy.
+func (t *Deployer) runLockingCommand(ctx context.Context, out io.Writer, deployment *latest.TerrformDeployments, command string, args ...string) error {
+	attempts, delay, err := lockRetryPolicy(deployment)
+	if err != nil {
+		return err
+	}
+
+	cmdArgs := []string{command}
+	if deployment.LockTimeout != "" {
+		cmdArgs = append(cmdArgs, "-lock-timeout="+deployment.LockTimeout)
+	}
+	cmdArgs = append(cmdArgs, args...)
+
+	for attempt := 1; ; attempt++ {
+		var cmdOut bytes.Buffer
+		err := t.runTerraformCommand(ctx, io.MultiWriter(out, &cmdOut), deployment.Dir, cmdArgs...)
+		if err == nil {
+			return nil
+		}
+
+		lock, locked := parseLockInfo(cmdOut.Bytes())
+		if !locked {
+			return err
+		}
+		if attempt > attempts {
+			return stateLockedErr(deployment, lock, err)
+		}
+
+		output.Yellow.Fprintf(out, "Terraform state of %s is locked, retrying in %v (%d/%d)\n", deployment.Name, delay, attempt, attempts)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// lockRetryPolicy returns how many times a command is retried while the state is locked, and how long to wait between attempts.
+func lockRetryPolicy(deployment *latest.TerrformDeployments) (int, time.Duration, error) {
+	if deployment.LockRetry == nil {
+		return 0, 0, nil
+	}
+	if deployment.LockRetry.Delay == "" {
+		return deployment.LockRetry.Attempts, defaultLockRetryDelay, nil
+	}
+
+	delay, err := time.ParseDuration(deployment.LockRetry.Delay)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid lockRetry delay %q for %s: %w", deployment.LockRetry.Delay, deployment.Name, err)
+	}
+	return deployment.LockRetry.Attempts, delay, nil
+}
+
+// stateLockedErr reports that a command failed because another run holds the state lock of a deployment.
+func stateLockedErr(deployment *latest.TerrformDeployments, lock lockInfo, err error) error {
+	msg := fmt.Sprintf("the Terraform state of %s is locked", deployment.Name)
+	if lock.Who != "" {
+		msg += " by " + lock.Who
+	}
+	if lock.ID != "" {
+		msg += fmt.Sprintf(" (lock ID %s)", lock.ID)
+	}
+
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message:     msg,
+			ErrCode:     proto.StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED,
+			Suggestions: stateLockedSuggestions(deployment, lock),
+		})
+}
+
+func stateLockedSuggestions(deployment *latest.TerrformDeployments, lock lockInfo) []*proto.Suggestion {
+	wait := "Wait for the other run to finish and try again"
+	if deployment.LockTimeout == "" && deployment.LockRetry == nil {
+		wait = fmt.Sprintf("Wait for the other run to finish, or set `lockTimeout` or `lockRetry` on the %s deployment to wait for the lock", deployment.Name)
+	}
+	suggestions := []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK,
+		Action:         wait,
+	}}
+
+	if lock.ID != "" {
+		dir := deployment.Dir
+		if dir == "" {
+			dir = "."
+		}
+		suggestions = append(suggestions, &proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE,
+			Action:         fmt.Sprintf("If no other run is in progress, release the lock with `terraform force-unlock %s` in %s", lock.ID, dir),
+		})
+	}
+	return suggestions
+}
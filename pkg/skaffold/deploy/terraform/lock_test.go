@@ -0,0 +1,172 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+// testLockedOutput is what Terraform prints when another run holds the state lock.
+const testLockedOutput = `╷
+│ Error: Error acquiring the state lock
+│
+│ Error message: ConditionalCheckFailedException: The conditional request failed
+│ Lock Info:
+│   ID:        9f3c2b1a-5d4e-4f6a-8b7c-1d2e3f4a5b6c
+│   Path:      tf-state/network.tfstate
+│   Operation: OperationTypeApply
+│   Who:       alice@laptop
+│   Version:   1.6.0
+│   Created:   2024-05-01 10:00:00.000000000 +0000 UTC
+│
+│ Terraform acquires a state lock to protect the state from being written
+│ by multiple users at the same time.
+╵
+`
+
+func TestParseLockInfo(t *testing.T) {
+	tests := []struct {
+		description    string
+		output         string
+		expectedLocked bool
+		expected       lockInfo
+	}{
+		{
+			description:    "state locked",
+			output:         testLockedOutput,
+			expectedLocked: true,
+			expected:       lockInfo{ID: "9f3c2b1a-5d4e-4f6a-8b7c-1d2e3f4a5b6c", Who: "alice@laptop"},
+		},
+		{
+			description:    "state locked without lock info",
+			output:         "Error: Error acquiring the state lock\n",
+			expectedLocked: true,
+		},
+		{
+			description: "other error",
+			output:      "Error: Invalid resource type\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			lock, locked := parseLockInfo([]byte(test.output))
+
+			t.CheckDeepEqual(test.expectedLocked, locked)
+			t.CheckDeepEqual(test.expected, lock)
+		})
+	}
+}
+
+func TestLockRetryPolicy(t *testing.T) {
+	tests := []struct {
+		description      string
+		retry            *latest.TerraformLockRetry
+		expectedAttempts int
+		expectedDelay    time.Duration
+		shouldErr        bool
+	}{
+		{
+			description: "no retry",
+		},
+		{
+			description:      "default delay",
+			retry:            &latest.TerraformLockRetry{Attempts: 3},
+			expectedAttempts: 3,
+			expectedDelay:    defaultLockRetryDelay,
+		},
+		{
+			description:      "custom delay",
+			retry:            &latest.TerraformLockRetry{Attempts: 2, Delay: "1m"},
+			expectedAttempts: 2,
+			expectedDelay:    time.Minute,
+		},
+		{
+			description: "invalid delay",
+			retry:       &latest.TerraformLockRetry{Attempts: 2, Delay: "soon"},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			attempts, delay, err := lockRetryPolicy(&latest.TerrformDeployments{Name: "network", LockRetry: test.retry})
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedAttempts, attempts)
+			t.CheckDeepEqual(test.expectedDelay, delay)
+		})
+	}
+}
+
+func TestDeployStateLocked(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRunWithOutputErr("terraform plan -lock-timeout=30s -input=false -out=skaffold.tfplan", testLockedOutput, errors.New("exit status 1")))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{Name: "network", Dir: "infra/network", LockTimeout: "30s"}},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		err = deployer.Deploy(context.Background(), &bytes.Buffer{}, nil, createMockManifestListByConfig())
+
+		var sErr sErrors.Error
+		if !errors.As(err, &sErr) {
+			t.Fatalf("expected an actionable error, got %v", err)
+		}
+		t.CheckDeepEqual(proto.StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED, sErr.StatusCode())
+		t.CheckErrorContains("the Terraform state of network is locked by alice@laptop (lock ID 9f3c2b1a-5d4e-4f6a-8b7c-1d2e3f4a5b6c)", err)
+		t.CheckErrorContains("Wait for the other run to finish and try again", err)
+		t.CheckErrorContains("`terraform force-unlock 9f3c2b1a-5d4e-4f6a-8b7c-1d2e3f4a5b6c` in infra/network", err)
+	})
+}
+
+func TestDeployRetriesWhileStateLocked(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRunWithOutputErr("terraform plan -input=false -out=skaffold.tfplan", testLockedOutput, errors.New("exit status 1")).
+			AndRun("terraform plan -input=false -out=skaffold.tfplan").
+			AndRunOut("terraform show -json skaffold.tfplan", testPlan).
+			AndRunWithOutputErr("terraform apply skaffold.tfplan", testLockedOutput, errors.New("exit status 1")).
+			AndRunWithOutput("terraform apply skaffold.tfplan", "Creation complete\n").
+			AndRunOut("terraform output -json", `{}`))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{Name: "network", LockRetry: &latest.TerraformLockRetry{Attempts: 1, Delay: "1ms"}}},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		out := &bytes.Buffer{}
+		err = deployer.Deploy(context.Background(), out, nil, createMockManifestListByConfig())
+
+		t.CheckNoError(err)
+		t.CheckContains("Terraform state of network is locked, retrying in 1ms (1/1)", out.String())
+	})
+}
+
+func TestDeployStateLockedNoPolicy(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRun("terraform init").
+			AndRunWithOutputErr("terraform plan -input=false -out=skaffold.tfplan", "Error: Error acquiring the state lock\n", errors.New("exit status 1")))
+
+		deployer, err := NewDeployer(&mockConfig{}, &latest.TerraformDeploy{
+			Deployments: []latest.TerrformDeployments{{Name: "network"}},
+		}, "test-config")
+		t.CheckNoError(err)
+
+		err = deployer.Deploy(context.Background(), &bytes.Buffer{}, nil, createMockManifestListByConfig())
+
+		t.CheckErrorContains("set `lockTimeout` or `lockRetry` on the network deployment", err)
+		t.CheckFalse(strings.Contains(err.Error(), "force-unlock"))
+	})
+}
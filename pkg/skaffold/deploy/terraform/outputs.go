@@ -0,0 +1,116 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+// outputVarPrefix prefixes the template variables set from Terraform outputs.
+const outputVarPrefix = "TF_"
+
+var invalidVarChars = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// jsonOutput is an output in the `terraform output -json` and `terraform show -json` representations.
+// Value is absent when the output is only known after apply.
+type jsonOutput struct {
+	Sensitive bool            `json:"sensitive"`
+	Value     json.RawMessage `json:"value"`
+}
+
+// outputsSource identifies the template variables set from the outputs of a deployment.
+func outputsSource(deployment string) string {
+	return "terraform/" + deployment
+}
+
+// OutputVar returns the name of the template variable that holds an output of a deployment,
+// for instance `TF_network_subnet_id` for the `subnet_id` output of the `network` deployment.
+func OutputVar(deployment, output string) string {
+	return outputVarPrefix + invalidVarChars.ReplaceAllString(deployment, "_") + "_" + invalidVarChars.ReplaceAllString(output, "_")
+}
+
+// parseOutputs converts the outputs of a deployment to template variables.
+// Strings are used as-is and other values are JSON encoded.
+// Sensitive outputs are left out, so that they don't end up in rendered manifests, hook environments or logs.
+func parseOutputs(deployment string, outputs map[string]jsonOutput) (map[string]string, error) {
+	vars := map[string]string{}
+	for name, o := range outputs {
+		if len(o.Value) == 0 || o.Sensitive {
+			continue
+		}
+
+		var s string
+		if err := json.Unmarshal(o.Value, &s); err == nil {
+			vars[OutputVar(deployment, name)] = s
+			continue
+		}
+
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, o.Value); err != nil {
+			return nil, fmt.Errorf("parsing terraform output %q: %w", name, err)
+		}
+		vars[OutputVar(deployment, name)] = compacted.String()
+	}
+	return vars, nil
+}
+
+// readOutputs registers the outputs of an applied deployment as template variables.
+func (t *Deployer) readOutputs(ctx context.Context, deployment *latest.TerrformDeployments) error {
+	cmd := exec.CommandContext(ctx, "terraform", "output", "-json")
+	cmd.Dir = deployment.Dir
+	b, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return fmt.Errorf("failed to run terraform output: %w", err)
+	}
+
+	var outputs map[string]jsonOutput
+	if err := json.Unmarshal(b, &outputs); err != nil {
+		return fmt.Errorf("parsing terraform outputs: %w", err)
+	}
+
+	vars, err := parseOutputs(deployment.Name, outputs)
+	if err != nil {
+		return err
+	}
+	t.cfg.TemplateVars().Set(outputsSource(deployment.Name), vars)
+	return nil
+}
+
+// plannedOutputs returns the template variables for the outputs of a saved plan that are known before apply.
+func plannedOutputs(deployment string, b []byte) (map[string]string, error) {
+	var plan struct {
+		PlannedValues struct {
+			Outputs map[string]jsonOutput `json:"outputs"`
+		} `json:"planned_values"`
+	}
+	if err := json.Unmarshal(b, &plan); err != nil {
+		return nil, fmt.Errorf("parsing terraform plan: %w", err)
+	}
+	return parseOutputs(deployment, plan.PlannedValues.Outputs)
+}
+
+// expandVars evaluates the templates in the values of the deployment's variables,
+// which can reference the given outputs of the deployments it depends on.
+// When strict is set, referencing an output that isn't known is an error.
+func expandVars(deployment *latest.TerrformDeployments, outputs map[string]string, strict bool) (map[string]string, error) {
+	expand := util.ExpandEnvTemplate
+	if strict {
+		expand = util.ExpandEnvTemplateOrFail
+	}
+
+	vars := make(map[string]string, len(deployment.Vars))
+	for key, value := range deployment.Vars {
+		expanded, err := expand(value, outputs)
+		if err != nil {
+			return nil, fmt.Errorf("expanding variable %q of %s: %w", key, deployment.Name, err)
+		}
+		vars[key] = expanded
+	}
+	return vars, nil
+}
@@ -0,0 +1,53 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestOutputVar(t *testing.T) {
+	testutil.CheckDeepEqual(t, "TF_my_network_subnet_id", OutputVar("my-network", "subnet_id"))
+}
+
+func TestPlannedOutputs(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        string
+		expected    map[string]string
+		shouldErr   bool
+	}{
+		{
+			description: "no outputs",
+			plan:        `{}`,
+			expected:    map[string]string{},
+		},
+		{
+			description: "strings, other values, unknown and sensitive values",
+			plan: `{"planned_values": {"outputs": {
+				"host": {"sensitive": false, "value": "10.0.0.2"},
+				"port": {"sensitive": false, "value": 5432},
+				"zones": {"sensitive": false, "value": ["a", "b"]},
+				"id": {"sensitive": false},
+				"password": {"sensitive": true, "value": "s3cr3t"}
+			}}}`,
+			expected: map[string]string{
+				"TF_db_host":  "10.0.0.2",
+				"TF_db_port":  "5432",
+				"TF_db_zones": `["a","b"]`,
+			},
+		},
+		{
+			description: "invalid json",
+			plan:        `{`,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			outputs, err := plannedOutputs("db", []byte(test.plan))
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, outputs)
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+)
+
+// defaultPlanFile is where the plan is saved, relative to the deployment directory, when `planFile` is not set.
+const defaultPlanFile = "skaffold.tfplan"
+
+// PlanSummary lists the resources that a saved Terraform plan would add, change or destroy,
+// along with the resources that drifted from the recorded state.
+type PlanSummary struct {
+	Deployment string
+	Add        []string
+	Change     []string
+	Destroy    []string
+	Drifted    []string
+}
+
+// HasChanges returns true if applying the plan would modify any resource.
+func (s PlanSummary) HasChanges() bool {
+	return len(s.Add)+len(s.Change)+len(s.Destroy) > 0
+}
+
+// String returns the summary in the same format as `terraform plan`.
+func (s PlanSummary) String() string {
+	return fmt.Sprintf("%d to add, %d to change, %d to destroy", len(s.Add), len(s.Change), len(s.Destroy))
+}
+
+// Print writes the summary and the resources it affects.
+func (s PlanSummary) Print(out io.Writer) {
+	output.Default.Fprintf(out, "Terraform plan for %s: %s\n", s.Deployment, s.String())
+	for _, address := range s.Add {
+		output.Green.Fprintf(out, " + %s\n", address)
+	}
+	for _, address := range s.Change {
+		output.Yellow.Fprintf(out, " ~ %s\n", address)
+	}
+	for _, address := range s.Destroy {
+		output.Red.Fprintf(out, " - %s\n", address)
+	}
+	for _, address := range s.Drifted {
+		output.Yellow.Fprintf(out, " ! %s changed outside of Terraform\n", address)
+	}
+}
+
+// jsonPlan is the subset of the `terraform show -json` plan representation used by Skaffold.
+type jsonPlan struct {
+	ResourceChanges []jsonResourceChange `json:"resource_changes"`
+	ResourceDrift   []jsonResourceChange `json:"resource_drift"`
+}
+
+type jsonResourceChange struct {
+	Address string `json:"address"`
+	Change  struct {
+		Actions []string `json:"actions"`
+	} `json:"change"`
+}
+
+// parsePlan summarises the JSON representation of a saved plan.
+func parsePlan(deployment string, b []byte) (PlanSummary, error) {
+	var plan jsonPlan
+	if err := json.Unmarshal(b, &plan); err != nil {
+		return PlanSummary{}, fmt.Errorf("parsing terraform plan: %w", err)
+	}
+
+	summary := PlanSummary{Deployment: deployment}
+	for _, rc := range plan.ResourceChanges {
+		for _, action := range rc.Change.Actions {
+			switch action {
+			case "create":
+				summary.Add = append(summary.Add, rc.Address)
+			case "update":
+				summary.Change = append(summary.Change, rc.Address)
+			case "delete":
+				summary.Destroy = append(summary.Destroy, rc.Address)
+			}
+		}
+	}
+	for _, rc := range plan.ResourceDrift {
+		summary.Drifted = append(summary.Drifted, rc.Address)
+	}
+
+	sort.Strings(summary.Add)
+	sort.Strings(summary.Change)
+	sort.Strings(summary.Destroy)
+	sort.Strings(summary.Drifted)
+	return summary, nil
+}
@@ -0,0 +1,69 @@
+package terraform
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestParsePlan(t *testing.T) {
+	tests := []struct {
+		description string
+		plan        string
+		expected    PlanSummary
+		shouldErr   bool
+	}{
+		{
+			description: "no changes",
+			plan:        `{"resource_changes": [{"address": "null_resource.a", "change": {"actions": ["no-op"]}}]}`,
+			expected:    PlanSummary{Deployment: "dep"},
+		},
+		{
+			description: "create, update, delete and replace",
+			plan: `{"resource_changes": [
+				{"address": "null_resource.c", "change": {"actions": ["create"]}},
+				{"address": "null_resource.u", "change": {"actions": ["update"]}},
+				{"address": "null_resource.d", "change": {"actions": ["delete"]}},
+				{"address": "null_resource.r", "change": {"actions": ["delete", "create"]}},
+				{"address": "data.null_data_source.x", "change": {"actions": ["read"]}}
+			]}`,
+			expected: PlanSummary{
+				Deployment: "dep",
+				Add:        []string{"null_resource.c", "null_resource.r"},
+				Change:     []string{"null_resource.u"},
+				Destroy:    []string{"null_resource.d", "null_resource.r"},
+			},
+		},
+		{
+			description: "drift",
+			plan: `{"resource_drift": [
+				{"address": "google_storage_bucket.b", "change": {"actions": ["update"]}},
+				{"address": "google_sql_database.a", "change": {"actions": ["delete"]}}
+			]}`,
+			expected: PlanSummary{
+				Deployment: "dep",
+				Drifted:    []string{"google_sql_database.a", "google_storage_bucket.b"},
+			},
+		},
+		{
+			description: "invalid json",
+			plan:        `{`,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			summary, err := parsePlan("dep", []byte(test.plan))
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, summary)
+		})
+	}
+}
+
+func TestPlanSummaryString(t *testing.T) {
+	summary := PlanSummary{Add: []string{"a", "b"}, Destroy: []string{"c"}}
+
+	testutil.CheckDeepEqual(t, "2 to add, 0 to change, 1 to destroy", summary.String())
+	testutil.CheckDeepEqual(t, true, summary.HasChanges())
+	testutil.CheckDeepEqual(t, false, PlanSummary{Drifted: []string{"d"}}.HasChanges())
+}
@@ -0,0 +1,141 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sync"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// deploymentFunc runs a Terraform operation for the i-th deployment.
+type deploymentFunc func(ctx context.Context, out io.Writer, i int, deployment *latest.TerrformDeployments) error
+
+// node tracks the completion of a deployment, so that the deployments depending on it can start.
+// The wait channel is closed once the deployment completes.
+type node struct {
+	wait         chan interface{}
+	dependencies []*node
+}
+
+// waitForDependencies waits for all the dependencies to complete, or returns an error if the context is cancelled first.
+func (n *node) waitForDependencies(ctx context.Context) error {
+	for _, dep := range n.dependencies {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-dep.wait:
+		}
+	}
+	return nil
+}
+
+// runInOrder runs fn for every deployment once the deployments it depends on have completed.
+// Up to `concurrency` deployments run at the same time, 0 or less meaning no limit, and the first failure cancels the others.
+func (t *Deployer) runInOrder(ctx context.Context, out io.Writer, fn deploymentFunc) error {
+	// Reject dependency cycles before anything runs
+	if _, err := t.deploymentOrder(); err != nil {
+		return err
+	}
+
+	// Negative values are rejected by the schema validation, but treat them as "no limit" rather than panicking
+	concurrency := t.Concurrency
+	if concurrency <= 0 || concurrency > len(t.Deployments) {
+		concurrency = len(t.Deployments)
+	}
+	sem := make(chan bool, concurrency)
+
+	nodes := map[string]*node{}
+	for _, d := range t.Deployments {
+		nodes[d.Name] = &node{wait: make(chan interface{})}
+	}
+	for _, d := range t.Deployments {
+		for _, dep := range d.DependsOn {
+			if n, found := nodes[dep]; found {
+				nodes[d.Name].dependencies = append(nodes[d.Name].dependencies, n)
+			}
+		}
+	}
+
+	var lock sync.Mutex
+	g, gCtx := errgroup.WithContext(ctx)
+	for i := range t.Deployments {
+		i := i
+		deployment := &t.Deployments[i]
+		n := nodes[deployment.Name]
+
+		g.Go(func() error {
+			if err := n.waitForDependencies(gCtx); err != nil {
+				return err
+			}
+			sem <- true
+			defer func() { <-sem }()
+
+			// Prefix the output of each deployment when they can run concurrently
+			w := out
+			if concurrency > 1 {
+				pw := newPrefixedWriter(out, &lock, fmt.Sprintf("[%s] ", deployment.Name))
+				defer pw.Flush()
+				w = pw
+				if output.IsColorable(out) {
+					w = output.NewColorWriter(pw)
+				}
+			}
+
+			if err := fn(gCtx, w, i, deployment); err != nil {
+				return err
+			}
+			close(n.wait)
+			return nil
+		})
+	}
+	return g.Wait()
+}
+
+// prefixedWriter prefixes every line with the name of a deployment.
+// Lines are written whole, so that concurrent deployments sharing the same lock don't interleave their output.
+type prefixedWriter struct {
+	out    io.Writer
+	lock   *sync.Mutex
+	prefix string
+	buf    bytes.Buffer
+}
+
+func newPrefixedWriter(out io.Writer, lock *sync.Mutex, prefix string) *prefixedWriter {
+	return &prefixedWriter{out: out, lock: lock, prefix: prefix}
+}
+
+func (w *prefixedWriter) Write(p []byte) (int, error) {
+	w.buf.Write(p)
+	for {
+		i := bytes.IndexByte(w.buf.Bytes(), '\n')
+		if i < 0 {
+			return len(p), nil
+		}
+		if err := w.writeLine(w.buf.Next(i + 1)); err != nil {
+			return 0, err
+		}
+	}
+}
+
+// Flush writes the last line, if it isn't terminated by a newline.
+func (w *prefixedWriter) Flush() error {
+	if w.buf.Len() == 0 {
+		return nil
+	}
+	line := append(w.buf.Next(w.buf.Len()), '\n')
+	return w.writeLine(line)
+}
+
+func (w *prefixedWriter) writeLine(line []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	_, err := w.out.Write(append([]byte(w.prefix), line...))
+	return err
+}
@@ -0,0 +1,74 @@
+package terraform
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"sync"
+
+	eventV2 "github.com/ryanharper/skaffold/v2/pkg/skaffold/event/v2"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+)
+
+// applyCompleteRegex matches the summary printed by `terraform apply`, for instance
+// `Apply complete! Resources: 1 added, 0 changed, 0 destroyed.`
+var applyCompleteRegex = regexp.MustCompile(`Apply complete! Resources: (?:\d+ imported, )?(\d+) added, (\d+) changed, (\d+) destroyed`)
+
+// applyResult counts the resources that the apply of a deployment changed.
+type applyResult struct {
+	deployment string
+	added      int
+	changed    int
+	destroyed  int
+}
+
+func (r applyResult) String() string {
+	return fmt.Sprintf("%d added, %d changed, %d destroyed", r.added, r.changed, r.destroyed)
+}
+
+// parseApply reads the resource counts from the output of `terraform apply`.
+// The counts of the applied plan are used if the output has no summary.
+func parseApply(deployment string, out []byte, plan PlanSummary) applyResult {
+	m := applyCompleteRegex.FindSubmatch(out)
+	if m == nil {
+		return applyResult{deployment: deployment, added: len(plan.Add), changed: len(plan.Change), destroyed: len(plan.Destroy)}
+	}
+
+	added, _ := strconv.Atoi(string(m[1]))
+	changed, _ := strconv.Atoi(string(m[2]))
+	destroyed, _ := strconv.Atoi(string(m[3]))
+	return applyResult{deployment: deployment, added: added, changed: changed, destroyed: destroyed}
+}
+
+// Monitor reports the deployments that were applied since the last check as status check resources.
+type Monitor struct {
+	lock    sync.Mutex
+	applied []applyResult
+}
+
+func (m *Monitor) record(r applyResult) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.applied = append(m.applied, r)
+}
+
+func (m *Monitor) Check(_ context.Context, out io.Writer) error {
+	m.lock.Lock()
+	applied := m.applied
+	m.applied = nil
+	m.lock.Unlock()
+
+	for _, r := range applied {
+		eventV2.TerraformApplied(r.deployment, r.added, r.changed, r.destroyed)
+		output.Default.Fprintf(out, "Terraform deployment %s finished: %s\n", r.deployment, r)
+	}
+	return nil
+}
+
+func (m *Monitor) Reset() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.applied = nil
+}
@@ -0,0 +1,61 @@
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+	testEvent "github.com/ryanharper/skaffold/v2/testutil/event"
+)
+
+func TestParseApply(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		expected    applyResult
+	}{
+		{
+			description: "summary",
+			output:      "null_resource.example: Creation complete\n\nApply complete! Resources: 2 added, 1 changed, 3 destroyed.\n",
+			expected:    applyResult{deployment: "dep", added: 2, changed: 1, destroyed: 3},
+		},
+		{
+			description: "summary with imports",
+			output:      "Apply complete! Resources: 1 imported, 0 added, 4 changed, 0 destroyed.\n",
+			expected:    applyResult{deployment: "dep", changed: 4},
+		},
+		{
+			description: "no summary uses the plan",
+			output:      "",
+			expected:    applyResult{deployment: "dep", added: 1},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			result := parseApply("dep", []byte(test.output), PlanSummary{Add: []string{"null_resource.example"}})
+
+			t.CheckDeepEqual(test.expected, result, cmp.AllowUnexported(applyResult{}))
+		})
+	}
+}
+
+func TestMonitorCheck(t *testing.T) {
+	testEvent.InitializeState([]latest.Pipeline{{}})
+
+	m := &Monitor{}
+	m.record(applyResult{deployment: "network", added: 1})
+	m.record(applyResult{deployment: "app", changed: 2})
+
+	out := &bytes.Buffer{}
+	testutil.CheckError(t, false, m.Check(context.Background(), out))
+	testutil.CheckDeepEqual(t, "Terraform deployment network finished: 1 added, 0 changed, 0 destroyed\nTerraform deployment app finished: 0 added, 2 changed, 0 destroyed\n", out.String())
+
+	// Deployments are only reported once
+	out.Reset()
+	testutil.CheckError(t, false, m.Check(context.Background(), out))
+	testutil.CheckDeepEqual(t, "", out.String())
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diagnose
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/deploy/terraform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// CheckTerraformDrift plans every Terraform deployment and reports the resources
+// that changed outside of Terraform, as well as the changes a deploy of the given builds would apply.
+func CheckTerraformDrift(ctx context.Context, cfg terraform.Config, tfDeploy *latest.TerraformDeploy, builds []graph.Artifact, out io.Writer) error {
+	if tfDeploy == nil {
+		return nil
+	}
+	d, err := terraform.NewDeployer(cfg, tfDeploy, "")
+	if err != nil {
+		return err
+	}
+	summaries, err := d.DriftSummaries(ctx, io.Discard, builds)
+	if err != nil {
+		return fmt.Errorf("planning terraform deployments: %w", err)
+	}
+
+	for _, summary := range summaries {
+		output.Default.Fprintf(out, "\nTerraform deployment: %s\n", summary.Deployment)
+		fmt.Fprintln(out, " - Drifted resources:", len(summary.Drifted))
+		for _, address := range summary.Drifted {
+			fmt.Fprintln(out, "   -", address)
+		}
+		fmt.Fprintln(out, " - Pending changes:", summary.String())
+	}
+	return nil
+}
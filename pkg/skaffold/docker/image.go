@@ -33,6 +33,7 @@ import (
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/filters"
 	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/api/types/volume"
 	"github.com/docker/docker/client"
 	"github.com/docker/docker/errdefs"
@@ -67,6 +68,7 @@ type ContainerRun struct {
 type ContainerCreateOpts struct {
 	Name            string
 	Network         string
+	NetworkAliases  []string
 	VolumesFrom     []string
 	Wait            bool
 	Bindings        nat.PortMap
@@ -107,6 +109,7 @@ type LocalDaemon interface {
 	RawClient() client.CommonAPIClient
 	VolumeCreate(ctx context.Context, opts volume.CreateOptions) (volume.Volume, error)
 	VolumeRemove(ctx context.Context, id string) error
+	VolumeList(ctx context.Context, opts volume.ListOptions) ([]*volume.Volume, error)
 	Stop(ctx context.Context, id string, stopTimeout *time.Duration) error
 	Remove(ctx context.Context, id string) error
 }
@@ -171,6 +174,14 @@ func (l *localDaemon) VolumeRemove(ctx context.Context, id string) error {
 	return l.apiClient.VolumeRemove(ctx, id, false)
 }
 
+func (l *localDaemon) VolumeList(ctx context.Context, opts volume.ListOptions) ([]*volume.Volume, error) {
+	resp, err := l.apiClient.VolumeList(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Volumes, nil
+}
+
 func (l *localDaemon) ContainerInspect(ctx context.Context, id string) (types.ContainerJSON, error) {
 	return l.apiClient.ContainerInspect(ctx, id)
 }
@@ -230,7 +241,16 @@ func (l *localDaemon) Run(ctx context.Context, out io.Writer, opts ContainerCrea
 		Mounts:       opts.Mounts,
 	}
 
-	c, err := l.apiClient.ContainerCreate(ctx, opts.ContainerConfig, hCfg, nil, nil, opts.Name)
+	var nCfg *network.NetworkingConfig
+	if len(opts.NetworkAliases) > 0 {
+		nCfg = &network.NetworkingConfig{
+			EndpointsConfig: map[string]*network.EndpointSettings{
+				opts.Network: {Aliases: opts.NetworkAliases},
+			},
+		}
+	}
+
+	c, err := l.apiClient.ContainerCreate(ctx, opts.ContainerConfig, hCfg, nCfg, nil, opts.Name)
 	if err != nil {
 		return nil, nil, "", err
 	}
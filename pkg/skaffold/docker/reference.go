@@ -80,6 +80,15 @@ func ParseReference(image string) (*ImageReference, error) {
 	return parsed, nil
 }
 
+// TagWithDigest references an image by tag and digest, unless the tag already includes the digest.
+func TagWithDigest(tag, digest string) string {
+	digestSuffix := "@" + digest
+	if strings.HasSuffix(tag, digestSuffix) {
+		return tag
+	}
+	return tag + digestSuffix
+}
+
 // SanitizeImageName removes prefixes and lowercases the name portion of images.
 // This is primarily used to handle `ko` import paths in image fields, e.g.
 // `ko://github.com/GoogleContainerTools/skaffold`.
@@ -18,12 +18,18 @@ package docker
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/google/go-containerregistry/pkg/name"
 	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
 	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/google/go-containerregistry/pkg/v1/tarball"
+	"github.com/google/go-containerregistry/pkg/v1/types"
 	specs "github.com/opencontainers/image-spec/specs-go/v1"
 
 	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
@@ -96,6 +102,40 @@ func RetrieveRemoteConfig(identifier string, cfg Config, platform v1.Platform) (
 	return img.ConfigFile()
 }
 
+// RemoteAnnotations retrieves the manifest annotations of a remote image. It returns nil when the image doesn't exist.
+func RemoteAnnotations(identifier string, cfg Config) (map[string]string, error) {
+	img, err := getRemoteImage(identifier, cfg, v1.Platform{})
+	if err != nil {
+		var tErr *transport.Error
+		if errors.As(err, &tErr) && tErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("getting image: %w", err)
+	}
+	m, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf("getting manifest: %w", err)
+	}
+	if m.Annotations == nil {
+		return map[string]string{}, nil
+	}
+	return m.Annotations, nil
+}
+
+// PushAnnotations pushes an empty image whose manifest holds annotations, to record metadata in a registry.
+func PushAnnotations(identifier string, annotations map[string]string, cfg Config) error {
+	ref, err := parseReference(identifier, cfg, name.WeakValidation)
+	if err != nil {
+		return err
+	}
+	img := mutate.ConfigMediaType(mutate.MediaType(empty.Image, types.OCIManifestSchema1), types.OCIConfigJSON)
+	img = mutate.Annotations(img, annotations).(v1.Image)
+	if err := remote.Write(ref, img, remote.WithAuthFromKeychain(primaryKeychain)); err != nil {
+		return fmt.Errorf("%s %q: %w", sErrors.PushImageErr, ref, err)
+	}
+	return nil
+}
+
 // Push pushes the tarball image
 func Push(tarPath, tag string, cfg Config, platforms []specs.Platform) (string, error) {
 	t, err := name.NewTag(tag, name.WeakValidation)
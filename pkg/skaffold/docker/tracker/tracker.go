@@ -89,3 +89,13 @@ func (t *ContainerTracker) Add(artifact graph.Artifact, c Container) {
 		t.notifier <- c.ID
 	}()
 }
+
+// Remove stops tracking the container deployed for an artifact.
+func (t *ContainerTracker) Remove(imageName string) {
+	t.Lock()
+	defer t.Unlock()
+	if c, found := t.deployedContainers[imageName]; found {
+		delete(t.containerToArtifact, c.ID)
+		delete(t.deployedContainers, imageName)
+	}
+}
@@ -151,3 +151,19 @@ func TestDeployedContainerForImage(t *testing.T) {
 		})
 	}
 }
+
+func TestRemove(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tracker := NewContainerTracker()
+		tracker.Add(graph.Artifact{ImageName: "image1"}, Container{ID: "deadbeef"})
+		tracker.Add(graph.Artifact{ImageName: "image2"}, Container{ID: "foobar"})
+
+		tracker.Remove("image1")
+		tracker.Remove("bogus")
+
+		_, found := tracker.ContainerForImage("image1")
+		t.CheckFalse(found)
+		t.CheckDeepEqual(graph.Artifact{}, tracker.ArtifactForContainer("deadbeef"))
+		t.CheckDeepEqual(map[string]Container{"image2": {ID: "foobar"}}, tracker.DeployedContainers())
+	})
+}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	proto "github.com/ryanharper/skaffold/v2/proto/v2"
+)
+
+func TerraformPlanned(deployment string, add, change, destroy int, drifted []string) {
+	handler.handleTerraformPlan(&proto.TerraformPlanEvent{
+		Id:         deployment,
+		TaskId:     fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
+		Deployment: deployment,
+		Add:        int32(add),
+		Change:     int32(change),
+		Destroy:    int32(destroy),
+		Drifted:    drifted,
+	})
+}
+
+// TerraformApplied reports a Terraform deployment as a status check resource, with the number of resources its apply changed.
+func TerraformApplied(deployment string, added, changed, destroyed int) {
+	resource := "terraform/" + deployment
+	handler.handleStatusCheckSubtaskEvent(&proto.StatusCheckSubtaskEvent{
+		Id:         resource,
+		TaskId:     fmt.Sprintf("%s-%d", constants.Deploy, handler.iteration),
+		Resource:   resource,
+		Status:     Succeeded,
+		Message:    fmt.Sprintf("%d added, %d changed, %d destroyed", added, changed, destroyed),
+		StatusCode: proto.StatusCode_STATUSCHECK_SUCCESS,
+	})
+}
+
+func (ev *eventHandler) handleTerraformPlan(e *proto.TerraformPlanEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_TerraformPlanEvent{
+			TerraformPlanEvent: e,
+		},
+	})
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	proto "github.com/ryanharper/skaffold/v2/proto/v2"
+)
+
+// VulnerabilityFound reports a vulnerability that a scanner found in an image, and whether it fails the test.
+func VulnerabilityFound(scanner, image, id, pkg, installedVersion, fixedVersion, severity, status string) {
+	handler.handleVulnerability(&proto.VulnerabilityEvent{
+		Id:               id,
+		TaskId:           fmt.Sprintf("%s-%d", constants.Test, handler.iteration),
+		Image:            image,
+		Scanner:          scanner,
+		Package:          pkg,
+		InstalledVersion: installedVersion,
+		FixedVersion:     fixedVersion,
+		Severity:         severity,
+		Status:           status,
+	})
+}
+
+func (ev *eventHandler) handleVulnerability(e *proto.VulnerabilityEvent) {
+	ev.handle(&proto.Event{
+		EventType: &proto.Event_VulnerabilityEvent{
+			VulnerabilityEvent: e,
+		},
+	})
+}
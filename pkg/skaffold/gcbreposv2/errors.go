@@ -0,0 +1,54 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcbreposv2
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+)
+
+// apiErr tells when the Cloud Build API rejects the credentials of the user, usually because they expired.
+func apiErr(repo string, err error) error {
+	var msg, action string
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		msg = fmt.Sprintf("credentials to resolve repository %s are invalid or expired: %v", repo, err)
+		action = "Refresh your credentials with `gcloud auth application-default login`, or set flag `--sync-remote-cache` to `missing` to use the cached repository"
+	case codes.PermissionDenied:
+		msg = fmt.Sprintf("permission denied to resolve repository %s: %v", repo, err)
+		action = "Check that your account has the `roles/cloudbuild.readTokenAccessor` role on the repository, or set flag `--sync-remote-cache` to `missing` to use the cached repository"
+	default:
+		return err
+	}
+
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: msg,
+			ErrCode: proto.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS,
+					Action:         action,
+				},
+			},
+		})
+}
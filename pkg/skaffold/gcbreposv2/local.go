@@ -0,0 +1,67 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcbreposv2
+
+import (
+	"context"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"cloud.google.com/go/cloudbuild/apiv2/cloudbuildpb"
+	"github.com/googleapis/gax-go/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LocalClient stands in for the Cloud Build API by resolving repositories to local git repositories,
+// so that remote configs can be tested without a Cloud Build connection.
+type LocalClient struct {
+	// Repos maps the names of the repositories to the paths of local git repositories, usually bare ones.
+	Repos map[string]string
+
+	// TokenExpired makes the client reject read token requests as if the credentials of the user expired.
+	TokenExpired bool
+}
+
+func (c LocalClient) GetRepository(ctx context.Context, req *cloudbuildpb.GetRepositoryRequest, opts ...gax.CallOption) (*cloudbuildpb.Repository, error) {
+	dir, found := c.Repos[path.Base(req.GetName())]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "repository %s not found", req.GetName())
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	uri := filepath.ToSlash(abs)
+	if !strings.HasPrefix(uri, "/") {
+		uri = "/" + uri
+	}
+	return &cloudbuildpb.Repository{
+		Name:      req.GetName(),
+		RemoteUri: "file://" + uri,
+	}, nil
+}
+
+func (c LocalClient) FetchReadToken(ctx context.Context, req *cloudbuildpb.FetchReadTokenRequest, opts ...gax.CallOption) (*cloudbuildpb.FetchReadTokenResponse, error) {
+	if c.TokenExpired {
+		return nil, status.Error(codes.Unauthenticated, "request had invalid authentication credentials")
+	}
+	return &cloudbuildpb.FetchReadTokenResponse{Token: "local"}, nil
+}
+
+func (c LocalClient) Close() error { return nil }
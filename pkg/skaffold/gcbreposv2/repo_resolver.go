@@ -26,7 +26,9 @@ import (
 	"github.com/googleapis/gax-go/v2"
 )
 
-type cloudBuildRepoClient interface {
+// Client is the part of the Cloud Build repository manager API that resolves repositories and their read tokens.
+// It can be replaced, for example by a LocalClient that resolves repositories to local git repositories in tests.
+type Client interface {
 	GetRepository(ctx context.Context, req *cloudbuildpb.GetRepositoryRequest, opts ...gax.CallOption) (*cloudbuildpb.Repository, error)
 	FetchReadToken(ctx context.Context, req *cloudbuildpb.FetchReadTokenRequest, opts ...gax.CallOption) (*cloudbuildpb.FetchReadTokenResponse, error)
 	Close() error
@@ -40,10 +42,16 @@ type Repo struct {
 	CloneURI string
 }
 
+// RepositoryManagerClient creates the client that resolves repositories.
 var RepositoryManagerClient = repositoryManagerClient
 
+// Name returns the resource name of a repository.
+func Name(gcpProject, gcpRegion, gcpConnectionName, gcpRepoName string) string {
+	return fmt.Sprintf("projects/%v/locations/%v/connections/%v/repositories/%v", gcpProject, gcpRegion, gcpConnectionName, gcpRepoName)
+}
+
 func GetRepoInfo(ctx context.Context, gcpProject, gcpRegion, gcpConnectionName, gcpRepoName string) (Repo, error) {
-	cbRepoRef := fmt.Sprintf("projects/%v/locations/%v/connections/%v/repositories/%v", gcpProject, gcpRegion, gcpConnectionName, gcpRepoName)
+	cbRepoRef := Name(gcpProject, gcpRegion, gcpConnectionName, gcpRepoName)
 	cbClient, err := RepositoryManagerClient(ctx)
 	if err != nil {
 		return Repo{}, fmt.Errorf("failed to create repository manager client: %w", err)
@@ -52,12 +60,12 @@ func GetRepoInfo(ctx context.Context, gcpProject, gcpRegion, gcpConnectionName,
 
 	repoURI, err := getRepoURI(ctx, cbClient, cbRepoRef)
 	if err != nil {
-		return Repo{}, fmt.Errorf("failed to get remote URI for repository %v: %w", gcpRepoName, err)
+		return Repo{}, apiErr(cbRepoRef, fmt.Errorf("failed to get remote URI for repository %v: %w", gcpRepoName, err))
 	}
 
 	readAccessToken, err := getRepoReadAccessToken(ctx, cbClient, cbRepoRef)
 	if err != nil {
-		return Repo{}, fmt.Errorf("failed to get repository read access token for repo %v: %w", gcpRepoName, err)
+		return Repo{}, apiErr(cbRepoRef, fmt.Errorf("failed to get repository read access token for repo %v: %w", gcpRepoName, err))
 	}
 
 	repoCloneURI, err := buildRepoURIWithToken(repoURI, readAccessToken)
@@ -71,11 +79,11 @@ func GetRepoInfo(ctx context.Context, gcpProject, gcpRegion, gcpConnectionName,
 	}, nil
 }
 
-func repositoryManagerClient(ctx context.Context) (cloudBuildRepoClient, error) {
+func repositoryManagerClient(ctx context.Context) (Client, error) {
 	return cloudbuild.NewRepositoryManagerClient(ctx)
 }
 
-func getRepoURI(ctx context.Context, cbClient cloudBuildRepoClient, cbRepoRef string) (string, error) {
+func getRepoURI(ctx context.Context, cbClient Client, cbRepoRef string) (string, error) {
 	req := &cloudbuildpb.GetRepositoryRequest{
 		Name: cbRepoRef,
 	}
@@ -86,7 +94,7 @@ func getRepoURI(ctx context.Context, cbClient cloudBuildRepoClient, cbRepoRef st
 	return repoInfo.GetRemoteUri(), nil
 }
 
-func getRepoReadAccessToken(ctx context.Context, cbClient cloudBuildRepoClient, cbRepoRef string) (string, error) {
+func getRepoReadAccessToken(ctx context.Context, cbClient Client, cbRepoRef string) (string, error) {
 	req := &cloudbuildpb.FetchReadTokenRequest{
 		Repository: cbRepoRef,
 	}
@@ -102,6 +110,10 @@ func buildRepoURIWithToken(repoURI, readAccessToken string) (string, error) {
 	if err != nil {
 		return "", err
 	}
+	// only HTTP remotes take the token, local repositories don't need one.
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return repoURI, nil
+	}
 
 	parsed.Host = fmt.Sprintf("oauth2:%v@%v", readAccessToken, parsed.Host)
 	return url.PathUnescape(parsed.String())
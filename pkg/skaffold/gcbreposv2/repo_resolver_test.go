@@ -18,12 +18,17 @@ package gcbreposv2
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"cloud.google.com/go/cloudbuild/apiv2/cloudbuildpb"
 	"github.com/googleapis/gax-go/v2"
 
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
 	"github.com/ryanharper/skaffold/v2/testutil"
 )
 
@@ -125,7 +130,7 @@ func TestGetRepoInfo(t *testing.T) {
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			ctx := context.Background()
-			t.Override(&RepositoryManagerClient, func(ctx context.Context) (cloudBuildRepoClient, error) {
+			t.Override(&RepositoryManagerClient, func(ctx context.Context) (Client, error) {
 				return test.gcbMockClient, nil
 			})
 
@@ -139,3 +144,53 @@ func TestGetRepoInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetRepoInfoWithLocalClient(t *testing.T) {
+	tests := []struct {
+		description  string
+		client       LocalClient
+		expectedCode proto.StatusCode
+		shouldErr    bool
+	}{
+		{
+			description: "local repositories don't take the read token",
+			client:      LocalClient{Repos: map[string]string{gcbRepo: "repo.git"}},
+		},
+		{
+			description:  "expired credentials",
+			client:       LocalClient{Repos: map[string]string{gcbRepo: "repo.git"}, TokenExpired: true},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR,
+		},
+		{
+			description: "unknown repository",
+			client:      LocalClient{},
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Chdir()
+			t.Override(&RepositoryManagerClient, func(ctx context.Context) (Client, error) {
+				return test.client, nil
+			})
+
+			repoInfo, err := GetRepoInfo(context.Background(), gcpProject, gcpRegion, gcbConnection, gcbRepo)
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				var actionable sErrors.Error
+				t.CheckDeepEqual(test.expectedCode != proto.StatusCode_OK, errors.As(err, &actionable))
+				if test.expectedCode != proto.StatusCode_OK {
+					t.CheckDeepEqual(test.expectedCode, actionable.StatusCode())
+				}
+				return
+			}
+			uri := "file://" + filepath.ToSlash(tmpDir.Path("repo.git"))
+			if !strings.HasPrefix(filepath.ToSlash(tmpDir.Path("repo.git")), "/") {
+				uri = "file:///" + filepath.ToSlash(tmpDir.Path("repo.git"))
+			}
+			t.CheckDeepEqual(Repo{URI: uri, CloneURI: uri}, repoInfo)
+		})
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"cloud.google.com/go/storage"
@@ -33,12 +34,16 @@ import (
 
 var GetBucketManager = getBucketManager
 
+// ErrObjectsChanged is returned when the objects matched by a source aren't the ones pinned to generations anymore.
+var ErrObjectsChanged = errors.New("objects changed since they were pinned")
+
 // bucketHandler defines the available interactions with a GCS bucket.
 type bucketHandler interface {
 	// ListObjects lists the objects that match the given query.
 	ListObjects(ctx context.Context, q *storage.Query) ([]string, error)
-	// DownloadObject downloads the object with the given uri in the localPath.
-	DownloadObject(ctx context.Context, localPath, uri string) error
+	// DownloadObject downloads the object with the given uri in the localPath, at the given generation when it's not 0,
+	// and returns the generation of the downloaded object.
+	DownloadObject(ctx context.Context, localPath, uri string, generation int64) (int64, error)
 	// UploadObject creates a files with the given content with the objName.
 	UploadObject(ctx context.Context, objName string, content *os.File) error
 	// Close closes the bucket handler connection.
@@ -62,37 +67,73 @@ type Native struct{}
 
 // Downloads the content that match the given src uri and subfolders.
 func (n *Native) DownloadRecursive(ctx context.Context, src, dst string) error {
+	_, err := n.DownloadRecursiveGenerations(ctx, src, dst, nil)
+	return err
+}
+
+// DownloadRecursiveGenerations downloads the content that match the given src uri and subfolders, and returns the generations
+// of the downloaded objects. When generations are given, the matched objects must be the same, and are downloaded at these generations.
+func (n *Native) DownloadRecursiveGenerations(ctx context.Context, src, dst string, generations map[string]int64) (map[string]int64, error) {
 	uriInfo, err := n.parseGCSURI(src)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	bucket, err := GetBucketManager(ctx, uriInfo.Bucket)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer bucket.Close()
 
 	files, err := n.filesToDownload(ctx, bucket, uriInfo)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	if generations != nil {
+		if err := checkPinnedObjects(files, generations); err != nil {
+			return nil, fmt.Errorf("%s: %w", src, err)
+		}
 	}
 
+	downloaded := map[string]int64{}
 	for uri, localPath := range files {
 		fullPath := filepath.Join(dst, localPath)
 		dir := filepath.Dir(fullPath)
 		if _, err := os.Stat(dir); os.IsNotExist(err) {
 			if err := os.MkdirAll(dir, os.ModePerm); err != nil {
-				return fmt.Errorf("failed to create directory: %v", err)
+				return nil, fmt.Errorf("failed to create directory: %v", err)
 			}
 		}
 
-		if err := bucket.DownloadObject(ctx, fullPath, uri); err != nil {
-			return err
+		generation, err := bucket.DownloadObject(ctx, fullPath, uri, generations[uri])
+		if err != nil {
+			return nil, err
 		}
+		downloaded[uri] = generation
 	}
 
-	return nil
+	return downloaded, nil
+}
+
+// checkPinnedObjects checks that the objects to download are the ones pinned to generations.
+func checkPinnedObjects(files map[string]string, generations map[string]int64) error {
+	var added, removed []string
+	for uri := range files {
+		if _, found := generations[uri]; !found {
+			added = append(added, uri)
+		}
+	}
+	for uri := range generations {
+		if _, found := files[uri]; !found {
+			removed = append(removed, uri)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return nil
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return fmt.Errorf("%w: added %v, removed %v", ErrObjectsChanged, added, removed)
 }
 
 // Uploads a single file to the given dst.
@@ -320,24 +361,28 @@ func (nb nativeBucketHandler) ListObjects(ctx context.Context, q *storage.Query)
 	return matches, nil
 }
 
-func (nb nativeBucketHandler) DownloadObject(ctx context.Context, localPath, uri string) error {
-	reader, err := nb.bucket.Object(uri).NewReader(ctx)
+func (nb nativeBucketHandler) DownloadObject(ctx context.Context, localPath, uri string, generation int64) (int64, error) {
+	obj := nb.bucket.Object(uri)
+	if generation != 0 {
+		obj = obj.Generation(generation)
+	}
+	reader, err := obj.NewReader(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to read object: %v", err)
+		return 0, fmt.Errorf("failed to read object: %v", err)
 	}
 	defer reader.Close()
 
 	file, err := os.Create(localPath)
 	if err != nil {
-		return fmt.Errorf("failed to create file: %v", err)
+		return 0, fmt.Errorf("failed to create file: %v", err)
 	}
 	defer file.Close()
 
 	if _, err := io.Copy(file, reader); err != nil {
-		return fmt.Errorf("failed to copy object to file: %v", err)
+		return 0, fmt.Errorf("failed to copy object to file: %v", err)
 	}
 
-	return nil
+	return reader.Attrs.Generation, nil
 }
 
 func (nb nativeBucketHandler) UploadObject(ctx context.Context, objName string, content *os.File) error {
@@ -18,6 +18,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -88,9 +89,12 @@ func (r *repoHandlerMock) ListObjects(ctx context.Context, q *storage.Query) ([]
 	return nil, nil
 }
 
-func (r *repoHandlerMock) DownloadObject(ctx context.Context, localPath, uri string) error {
+func (r *repoHandlerMock) DownloadObject(ctx context.Context, localPath, uri string, generation int64) (int64, error) {
 	r.downloadedFiles[uri] = localPath
-	return nil
+	if generation == 0 {
+		generation = int64(len(uri))
+	}
+	return generation, nil
 }
 
 func (r *repoHandlerMock) UploadObject(ctx context.Context, objName string, content *os.File) error {
@@ -286,6 +290,56 @@ func TestDownloadRecursive(t *testing.T) {
 	}
 }
 
+func TestDownloadRecursiveGenerations(t *testing.T) {
+	tests := []struct {
+		name                string
+		generations         map[string]int64
+		expectedGenerations map[string]int64
+		shouldErr           bool
+	}{
+		{
+			name:                "latest generations",
+			expectedGenerations: map[string]int64{"dir/a.yaml": 10, "dir/b.yaml": 10},
+		},
+		{
+			name:                "pinned generations",
+			generations:         map[string]int64{"dir/a.yaml": 1, "dir/b.yaml": 2},
+			expectedGenerations: map[string]int64{"dir/a.yaml": 1, "dir/b.yaml": 2},
+		},
+		{
+			name:        "object added since it was pinned",
+			generations: map[string]int64{"dir/a.yaml": 1},
+			shouldErr:   true,
+		},
+		{
+			name:        "object removed since it was pinned",
+			generations: map[string]int64{"dir/a.yaml": 1, "dir/b.yaml": 2, "dir/c.yaml": 3},
+			shouldErr:   true,
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.name, func(t *testutil.T) {
+			td := t.NewTempDir()
+			td.Touch("dir/a.yaml", "dir/b.yaml")
+			rh := &repoHandlerMock{
+				root:            td.Root(),
+				downloadedFiles: make(map[string]string),
+			}
+			t.Override(&GetBucketManager, func(ctx context.Context, bucketName string) (bucketHandler, error) {
+				return rh, nil
+			})
+
+			n := Native{}
+			generations, err := n.DownloadRecursiveGenerations(context.TODO(), "gs://bucket/dir/*", t.NewTempDir().Root(), test.generations)
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedGenerations, generations)
+			if test.shouldErr {
+				t.CheckTrue(errors.Is(err, ErrObjectsChanged))
+			}
+		})
+	}
+}
+
 func TestUploadFile(t *testing.T) {
 	tests := []struct {
 		name                string
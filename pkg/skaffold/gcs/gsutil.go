@@ -21,14 +21,17 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"reflect"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
 	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/gcs/client"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
@@ -61,50 +64,93 @@ var GetGCSClient = func() gscClient {
 }
 
 type gscClient interface {
-	// Downloads the content that match the given src uri and subfolders.
-	DownloadRecursive(ctx context.Context, src, dst string) error
+	// Downloads the content that match the given src uri and subfolders, at the given generations when set,
+	// and returns the generations of the downloaded objects.
+	DownloadRecursiveGenerations(ctx context.Context, src, dst string, generations map[string]int64) (map[string]int64, error)
 }
 
-// SyncObjects syncs the target Google Cloud Storage objects with skaffold's local cache and returns the local path to the objects.
-func SyncObjects(ctx context.Context, g latest.GoogleCloudStorageInfo, opts config.SkaffoldOptions) (string, error) {
+// SyncObjects syncs the target Google Cloud Storage objects with skaffold's local cache and returns the local path to the objects,
+// and the generations of the objects. When generations are given, the objects are synced at these generations.
+func SyncObjects(ctx context.Context, g latest.GoogleCloudStorageInfo, opts config.SkaffoldOptions, generations map[string]int64) (string, map[string]int64, error) {
 	remoteCacheDir, err := config.GetRemoteCacheDir(opts)
 	if err != nil {
-		return "", fmt.Errorf("failed determining remote cache directory: %w", err)
+		return "", nil, fmt.Errorf("failed determining remote cache directory: %w", err)
 	}
 	if err := os.MkdirAll(remoteCacheDir, 0700); err != nil {
-		return "", fmt.Errorf("failed creating remote cache directory: %w", err)
+		return "", nil, fmt.Errorf("failed creating remote cache directory: %w", err)
 	}
 
 	sourceDir, err := getPerSourceDir(g)
 	if err != nil {
-		return "", fmt.Errorf("failed determining Google Cloud Storage remote cache directory for %q: %w", g.Source, err)
+		return "", nil, fmt.Errorf("failed determining Google Cloud Storage remote cache directory for %q: %w", g.Source, err)
 	}
 	cacheDir := filepath.Join(remoteCacheDir, sourceDir)
+	generationsFile := cacheDir + ".generations"
 	if _, err := os.Stat(cacheDir); os.IsNotExist(err) {
 		// If cache doesn't exist and cloning is disabled then we can't move forward.
 		if opts.SyncRemoteCache.CloneDisabled() {
-			return "", syncDisabledErr(g, cacheDir)
+			return "", nil, syncDisabledErr(g, cacheDir)
 		}
 		// The subdirectory needs to exist to work with gsutil.
 		if err := os.MkdirAll(cacheDir, 0700); err != nil {
-			return "", fmt.Errorf("failed creating Google Cloud Storage cache directory for %q: %w", g.Source, err)
+			return "", nil, fmt.Errorf("failed creating Google Cloud Storage cache directory for %q: %w", g.Source, err)
 		}
 	} else {
+		cached := readGenerations(generationsFile)
+		// Pinned generations never change, so they're only downloaded once.
+		if generations != nil && reflect.DeepEqual(cached, generations) {
+			return cacheDir, cached, nil
+		}
 		// If sync property is false then skip fetching latest object from remote storage.
-		if g.Sync != nil && !*g.Sync {
-			return cacheDir, nil
+		if generations == nil && g.Sync != nil && !*g.Sync {
+			return cacheDir, cached, nil
 		}
 		// If sync is turned off via flag `--sync-remote-cache` then skip fetching latest object from remote storage.
 		if opts.SyncRemoteCache.FetchDisabled() {
-			return cacheDir, nil
+			if generations != nil {
+				return "", nil, lock.OutOfDateErr("cached Google Cloud Storage objects from %q don't match their pinned generations, and remote cache sync is disabled via flag `--sync-remote-cache`", g.Source)
+			}
+			return cacheDir, cached, nil
 		}
 	}
 
 	gcs := GetGCSClient()
-	if err := gcs.DownloadRecursive(ctx, g.Source, cacheDir); err != nil {
-		return "", fmt.Errorf("failed to cache Google Cloud Storage objects from %q: %w", g.Source, err)
+	downloaded, err := gcs.DownloadRecursiveGenerations(ctx, g.Source, cacheDir, generations)
+	if err != nil {
+		if errors.Is(err, client.ErrObjectsChanged) {
+			return "", nil, lock.OutOfDateErr("%v", err)
+		}
+		return "", nil, fmt.Errorf("failed to cache Google Cloud Storage objects from %q: %w", g.Source, err)
+	}
+	if err := writeGenerations(generationsFile, downloaded); err != nil {
+		return "", nil, err
+	}
+	return cacheDir, downloaded, nil
+}
+
+// readGenerations reads the generations of the cached objects of a source, or returns nil when they're unknown.
+func readGenerations(file string) map[string]int64 {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		return nil
 	}
-	return cacheDir, nil
+	var generations map[string]int64
+	if err := json.Unmarshal(buf, &generations); err != nil {
+		return nil
+	}
+	return generations
+}
+
+// writeGenerations writes the generations of the cached objects of a source next to its cache directory.
+func writeGenerations(file string, generations map[string]int64) error {
+	buf, err := json.Marshal(generations)
+	if err != nil {
+		return fmt.Errorf("failed to save generations of Google Cloud Storage objects: %w", err)
+	}
+	if err := os.WriteFile(file, buf, 0600); err != nil {
+		return fmt.Errorf("failed to save generations of Google Cloud Storage objects: %w", err)
+	}
+	return nil
 }
 
 // getPerSourceDir returns the directory used per Google Cloud Storage source. Directory is a hash of the source provided.
@@ -18,13 +18,17 @@ package gcs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/gcs/client"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
 	"github.com/ryanharper/skaffold/v2/testutil"
 )
 
@@ -81,8 +85,14 @@ type gcsClientMock struct {
 	err error
 }
 
-func (g gcsClientMock) DownloadRecursive(ctx context.Context, src, dst string) error {
-	return g.err
+func (g gcsClientMock) DownloadRecursiveGenerations(ctx context.Context, src, dst string, generations map[string]int64) (map[string]int64, error) {
+	if g.err != nil {
+		return nil, g.err
+	}
+	if generations != nil {
+		return generations, nil
+	}
+	return map[string]int64{"dir1/configs/skaffold.yaml": 2}, nil
 }
 
 func TestSyncObject(t *testing.T) {
@@ -91,19 +101,24 @@ func TestSyncObject(t *testing.T) {
 	sourceHash := "B7fSU6BUuHFJuLenty9ErOwyxPVqO5cB"
 
 	tests := []struct {
-		description string
-		g           latest.GoogleCloudStorageInfo
-		gsutilErr   error
-		syncFlag    string
-		existing    bool
-		shouldErr   bool
-		expected    string
+		description         string
+		g                   latest.GoogleCloudStorageInfo
+		gsutilErr           error
+		syncFlag            string
+		existing            bool
+		cached              string
+		pinned              map[string]int64
+		shouldErr           bool
+		expectedCode        proto.StatusCode
+		expected            string
+		expectedGenerations map[string]int64
 	}{
 		{
-			description: "first time copy succeeds",
-			g:           latest.GoogleCloudStorageInfo{Source: source, Path: path},
-			syncFlag:    "always",
-			expected:    sourceHash,
+			description:         "first time copy succeeds",
+			g:                   latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			syncFlag:            "always",
+			expected:            sourceHash,
+			expectedGenerations: map[string]int64{"dir1/configs/skaffold.yaml": 2},
 		},
 		{
 			description: "first time copy fails",
@@ -119,11 +134,12 @@ func TestSyncObject(t *testing.T) {
 			shouldErr:   true,
 		},
 		{
-			description: "existing copy update succeeds",
-			g:           latest.GoogleCloudStorageInfo{Source: source, Path: path},
-			syncFlag:    "always",
-			existing:    true,
-			expected:    sourceHash,
+			description:         "existing copy update succeeds",
+			g:                   latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			syncFlag:            "always",
+			existing:            true,
+			expected:            sourceHash,
+			expectedGenerations: map[string]int64{"dir1/configs/skaffold.yaml": 2},
 		},
 		{
 			description: "existing copy with sync off via flag succeeds",
@@ -139,6 +155,53 @@ func TestSyncObject(t *testing.T) {
 			existing:    true,
 			expected:    sourceHash,
 		},
+		{
+			description:         "existing copy with sync off returns cached generations",
+			g:                   latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			syncFlag:            "never",
+			existing:            true,
+			cached:              `{"dir1/configs/skaffold.yaml":1}`,
+			expected:            sourceHash,
+			expectedGenerations: map[string]int64{"dir1/configs/skaffold.yaml": 1},
+		},
+		{
+			description:         "pinned generations are downloaded",
+			g:                   latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			syncFlag:            "always",
+			pinned:              map[string]int64{"dir1/configs/skaffold.yaml": 1},
+			expected:            sourceHash,
+			expectedGenerations: map[string]int64{"dir1/configs/skaffold.yaml": 1},
+		},
+		{
+			description:         "cached pinned generations aren't downloaded again",
+			g:                   latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			gsutilErr:           fmt.Errorf("not found"),
+			syncFlag:            "always",
+			existing:            true,
+			cached:              `{"dir1/configs/skaffold.yaml":1}`,
+			pinned:              map[string]int64{"dir1/configs/skaffold.yaml": 1},
+			expected:            sourceHash,
+			expectedGenerations: map[string]int64{"dir1/configs/skaffold.yaml": 1},
+		},
+		{
+			description:  "pinned generations that aren't cached fail with sync off via flag",
+			g:            latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			syncFlag:     "never",
+			existing:     true,
+			cached:       `{"dir1/configs/skaffold.yaml":2}`,
+			pinned:       map[string]int64{"dir1/configs/skaffold.yaml": 1},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR,
+		},
+		{
+			description:  "pinned objects changed",
+			g:            latest.GoogleCloudStorageInfo{Source: source, Path: path},
+			gsutilErr:    fmt.Errorf("%s: %w: added [dir1/new.yaml], removed []", source, client.ErrObjectsChanged),
+			syncFlag:     "always",
+			pinned:       map[string]int64{"dir1/configs/skaffold.yaml": 1},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR,
+		},
 	}
 
 	for _, test := range tests {
@@ -147,6 +210,9 @@ func TestSyncObject(t *testing.T) {
 			if test.existing {
 				td.Touch(sourceHash)
 			}
+			if test.cached != "" {
+				td.Write(sourceHash+".generations", test.cached)
+			}
 
 			syncRemote := &config.SyncRemoteCacheOption{}
 			_ = syncRemote.Set(test.syncFlag)
@@ -160,12 +226,18 @@ func TestSyncObject(t *testing.T) {
 				return gcsClient
 			})
 
-			path, err := SyncObjects(context.Background(), test.g, opts)
+			path, generations, err := SyncObjects(context.Background(), test.g, opts, test.pinned)
 			var expected string
 			if !test.shouldErr {
 				expected = filepath.Join(td.Root(), test.expected)
 			}
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, expected, path)
+			t.CheckDeepEqual(test.expectedGenerations, generations)
+			if test.expectedCode != proto.StatusCode_OK {
+				var actionable sErrors.Error
+				t.CheckTrue(errors.As(err, &actionable))
+				t.CheckDeepEqual(test.expectedCode, actionable.StatusCode())
+			}
 		})
 	}
 }
@@ -0,0 +1,303 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package git
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+// The sources of the credentials of a remote repository, in the order they're looked up.
+const (
+	CredentialsFromURL         = "url"
+	CredentialsFromEnvironment = "environment"
+	CredentialsFromNetrc       = "netrc"
+	CredentialsFromHelper      = "credential helper"
+	CredentialsFromSSHAgent    = "ssh agent"
+	CredentialsFromSSHKeys     = "ssh keys"
+)
+
+var (
+	// scpLikeURI matches the `user@host:path` syntax of ssh remotes.
+	scpLikeURI = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):[^/]`)
+	// nonAlphanumeric matches the characters of a host name that aren't allowed in environment variable names.
+	nonAlphanumeric = regexp.MustCompile(`[^A-Z0-9]+`)
+
+	netrcFile = func() string {
+		if f := os.Getenv("NETRC"); f != "" {
+			return f
+		}
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		if runtime.GOOS == "windows" {
+			return filepath.Join(home, "_netrc")
+		}
+		return filepath.Join(home, ".netrc")
+	}
+)
+
+// credentials authenticate git commands to a remote repository.
+type credentials struct {
+	source   string
+	username string
+	password string
+}
+
+// remote is the protocol and host of a repository URI.
+type remote struct {
+	scheme string
+	host   string
+}
+
+func (r remote) isSSH() bool {
+	return r.scheme == "ssh"
+}
+
+// parseRemote returns the protocol and host of a repository URI, for the `scheme://host/path` and `user@host:path` syntaxes.
+func parseRemote(uri string) (remote, bool) {
+	if u, err := url.Parse(uri); err == nil && u.Scheme != "" && u.Host != "" {
+		scheme := u.Scheme
+		if strings.HasPrefix(scheme, "git+ssh") || strings.HasPrefix(scheme, "ssh+git") {
+			scheme = "ssh"
+		}
+		return remote{scheme: scheme, host: u.Hostname()}, true
+	}
+	if m := scpLikeURI.FindStringSubmatch(uri); m != nil {
+		return remote{scheme: "ssh", host: m[1]}, true
+	}
+	return remote{}, false
+}
+
+// tokenCredentials looks up a token for a host in the environment.
+// Host specific variables like `SKAFFOLD_GIT_TOKEN_GITEA_EXAMPLE_COM` take precedence over `SKAFFOLD_GIT_TOKEN`.
+func tokenCredentials(host string) (credentials, bool) {
+	suffix := "_" + strings.Trim(nonAlphanumeric.ReplaceAllString(strings.ToUpper(host), "_"), "_")
+	token := firstEnv("SKAFFOLD_GIT_TOKEN"+suffix, "SKAFFOLD_GIT_TOKEN")
+	if token == "" {
+		return credentials{}, false
+	}
+	username := firstEnv("SKAFFOLD_GIT_USERNAME"+suffix, "SKAFFOLD_GIT_USERNAME")
+	if username == "" {
+		username = tokenUsername(host)
+	}
+	return credentials{source: CredentialsFromEnvironment, username: username, password: token}, true
+}
+
+// tokenUsername returns the user name that git forges expect with access tokens.
+func tokenUsername(host string) string {
+	switch host {
+	case "github.com":
+		return "x-access-token"
+	case "bitbucket.org":
+		return "x-token-auth"
+	default:
+		// GitLab requires `oauth2`, while Gitea and Bitbucket Data Center accept any user name with a token.
+		return "oauth2"
+	}
+}
+
+func firstEnv(names ...string) string {
+	for _, n := range names {
+		if v := os.Getenv(n); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// netrcCredentials looks up the login of a host in the netrc file.
+func netrcCredentials(host string) (credentials, bool, error) {
+	file := netrcFile()
+	if file == "" {
+		return credentials{}, false, nil
+	}
+	f, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return credentials{}, false, nil
+		}
+		return credentials{}, false, fmt.Errorf("reading netrc file %s: %w", file, err)
+	}
+	defer f.Close()
+
+	var found, fallback *credentials
+	var current *credentials
+	scanner := bufio.NewScanner(f)
+	scanner.Split(bufio.ScanLines)
+	inMacro := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		// macro definitions end with an empty line.
+		if inMacro {
+			inMacro = strings.TrimSpace(line) != ""
+			continue
+		}
+		fields := strings.Fields(line)
+		for i := 0; i < len(fields); i++ {
+			next := func() string {
+				if i+1 < len(fields) {
+					i++
+					return fields[i]
+				}
+				return ""
+			}
+			switch fields[i] {
+			case "machine":
+				current = nil
+				if m := next(); m == host && found == nil {
+					found = &credentials{source: CredentialsFromNetrc}
+					current = found
+				}
+			case "default":
+				current = nil
+				if fallback == nil {
+					fallback = &credentials{source: CredentialsFromNetrc}
+					current = fallback
+				}
+			case "login":
+				if l := next(); current != nil {
+					current.username = l
+				}
+			case "password":
+				if p := next(); current != nil {
+					current.password = p
+				}
+			case "account":
+				next()
+			case "macdef":
+				inMacro = true
+				i = len(fields)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return credentials{}, false, fmt.Errorf("reading netrc file %s: %w", file, err)
+	}
+
+	for _, c := range []*credentials{found, fallback} {
+		if c != nil && c.password != "" {
+			return *c, true, nil
+		}
+	}
+	return credentials{}, false, nil
+}
+
+// lookupCredentials finds the credentials that skaffold passes to git for a repository URI.
+// Tokens from the environment and logins from the netrc file are only sent to https remotes; git credential helpers
+// and ssh agents are left to git, so that git never prompts for credentials.
+func lookupCredentials(uri string) (credentials, bool, error) {
+	r, ok := parseRemote(uri)
+	if !ok || r.isSSH() || (r.scheme != "https" && r.scheme != "http") {
+		return credentials{}, false, nil
+	}
+	if u, err := url.Parse(uri); err == nil && u.User != nil {
+		return credentials{source: CredentialsFromURL}, false, nil
+	}
+	c, ok := tokenCredentials(r.host)
+	if !ok {
+		var err error
+		if c, ok, err = netrcCredentials(r.host); err != nil || !ok {
+			return credentials{}, false, err
+		}
+	}
+	if r.scheme != "https" {
+		// the credentials would be sent in clear text.
+		log.Entry(context.TODO()).Warnf("Not sending the %s credentials of %s to the plain http remote %s, use an https remote instead", c.source, r.host, uri)
+		return credentials{}, false, nil
+	}
+	return c, true, nil
+}
+
+// authEnv returns the environment of the git commands that reach a remote repository.
+// Credentials are sent as an `http.extraHeader` scoped to the host of the repository, instead of being written to
+// the URI of the cached clone.
+func authEnv(uri string) ([]string, error) {
+	env := []string{"GIT_TERMINAL_PROMPT=0"}
+	r, ok := parseRemote(uri)
+	if !ok {
+		return env, nil
+	}
+	if r.isSSH() {
+		if os.Getenv("GIT_SSH_COMMAND") == "" && os.Getenv("GIT_SSH") == "" {
+			env = append(env, "GIT_SSH_COMMAND=ssh -o BatchMode=yes")
+		}
+		return env, nil
+	}
+
+	c, ok, err := lookupCredentials(uri)
+	if err != nil || !ok {
+		return env, err
+	}
+	count := 0
+	if n, err := strconv.Atoi(os.Getenv("GIT_CONFIG_COUNT")); err == nil {
+		count = n
+	}
+	header := base64.StdEncoding.EncodeToString([]byte(c.username + ":" + c.password))
+	return append(env,
+		fmt.Sprintf("GIT_CONFIG_COUNT=%d", count+1),
+		fmt.Sprintf("GIT_CONFIG_KEY_%d=http.%s://%s/.extraHeader", count, r.scheme, r.host),
+		fmt.Sprintf("GIT_CONFIG_VALUE_%d=Authorization: Basic %s", count, header),
+	), nil
+}
+
+// CredentialSource returns the source of the credentials used to clone and fetch a repository, or an empty string for anonymous access.
+func CredentialSource(ctx context.Context, uri string) (string, error) {
+	r, ok := parseRemote(uri)
+	if !ok {
+		return "", nil
+	}
+	if r.isSSH() {
+		if os.Getenv("SSH_AUTH_SOCK") != "" {
+			return CredentialsFromSSHAgent, nil
+		}
+		return CredentialsFromSSHKeys, nil
+	}
+
+	c, ok, err := lookupCredentials(uri)
+	if err != nil {
+		return "", err
+	}
+	if ok || c.source != "" {
+		return c.source, nil
+	}
+
+	gitProgram, err := findGit()
+	if err != nil {
+		return "", err
+	}
+	// `git config` exits with 1 when no credential helper is configured for the repository.
+	out, err := util.RunCmdOut(ctx, exec.Command(gitProgram, "config", "--get-urlmatch", "credential.helper", uri))
+	if err == nil && strings.TrimSpace(string(out)) != "" {
+		return CredentialsFromHelper, nil
+	}
+	return "", nil
+}
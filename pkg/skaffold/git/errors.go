@@ -38,3 +38,19 @@ func SyncDisabledErr(g Config, repoCacheDir string) error {
 			},
 		})
 }
+
+// AuthErr returns error when the credentials of a git repository are rejected, for example because they expired.
+func AuthErr(g Config, err error) error {
+	msg := fmt.Sprintf("authentication to repository %q failed, its credentials are invalid or expired: %v", g.Repo, err)
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: msg,
+			ErrCode: proto.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS,
+					Action:         "Refresh the credentials of the repository, or set flag `--sync-remote-cache` to `missing` to use the cached repository",
+				},
+			},
+		})
+}
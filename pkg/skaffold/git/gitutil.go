@@ -25,6 +25,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
@@ -36,11 +37,33 @@ import (
 var SyncRepo = syncRepo
 var findGit = func() (string, error) { return exec.LookPath("git") }
 
+// commitSHA matches refs that pin a repository to a commit, with SHA-1 or SHA-256 object names.
+var commitSHA = regexp.MustCompile(`^[0-9a-f]{40}([0-9a-f]{24})?$`)
+
+// authFailures are the messages of git when the credentials of a repository are rejected.
+var authFailures = []string{"Authentication failed", "could not read Username", "Access denied", "returned error: 401", "returned error: 403", "Permission denied (publickey"}
+
 type Config struct {
 	Repo         string
 	RepoCloneURI string
 	Ref          string
 	Sync         *bool
+
+	// ResolveCloneURI resolves RepoCloneURI when it's empty, only when the repository is cloned or fetched.
+	// It is used for repositories whose credentials are short-lived, so that cached repositories can be used offline.
+	ResolveCloneURI func(context.Context) (string, error)
+}
+
+// cloneURI returns the URI to clone or fetch the repository from.
+func (g *Config) cloneURI(ctx context.Context) (string, error) {
+	if g.RepoCloneURI == "" && g.ResolveCloneURI != nil {
+		uri, err := g.ResolveCloneURI(ctx)
+		if err != nil {
+			return "", err
+		}
+		g.RepoCloneURI = uri
+	}
+	return g.RepoCloneURI, nil
 }
 
 // defaultRef returns the default ref as "master" if master branch exists in
@@ -70,7 +93,13 @@ func branchExists(ctx context.Context, repoCloneURI, repo, branch string) (bool,
 	if err != nil {
 		return false, err
 	}
-	out, err := util.RunCmdOut(ctx, exec.Command(gitProgram, "ls-remote", "--heads", repoCloneURI, branch))
+	env, err := authEnv(repoCloneURI)
+	if err != nil {
+		return false, err
+	}
+	cmd := exec.Command(gitProgram, "ls-remote", "--heads", repoCloneURI, branch)
+	cmd.Env = append(util.OSEnviron(), env...)
+	out, err := util.RunCmdOut(ctx, cmd)
 	if err != nil {
 		// stdErr contains the error message for os related errors, git permission errors
 		// and if repo doesn't exist
@@ -107,14 +136,6 @@ func syncRepo(ctx context.Context, g Config, opts config.SkaffoldOptions) (strin
 			"failed to clone repo %s: trouble creating cache directory: %w", g.Repo, err)
 	}
 
-	ref := g.Ref
-	if ref == "" {
-		ref, err = defaultRef(ctx, g.RepoCloneURI, g.Repo)
-		if err != nil {
-			return "", fmt.Errorf("failed to clone repo %s: trouble getting default branch: %w", g.Repo, err)
-		}
-	}
-
 	hash, err := getRepoDir(g)
 	if err != nil {
 		return "", fmt.Errorf("failed to clone git repo: unable to create directory name: %w", err)
@@ -124,10 +145,20 @@ func syncRepo(ctx context.Context, g Config, opts config.SkaffoldOptions) (strin
 		if opts.SyncRemoteCache.CloneDisabled() {
 			return "", SyncDisabledErr(g, repoCacheDir)
 		}
-		if _, err := r.Run(ctx, "clone", g.RepoCloneURI, fmt.Sprintf("./%s", hash), "--branch", ref, "--depth", "1"); err != nil {
+		uri, ref, err := remoteRef(ctx, &g)
+		if err != nil {
+			return "", err
+		}
+		if r.Env, err = authEnv(uri); err != nil {
+			return "", err
+		}
+		if commitSHA.MatchString(ref) {
+			return repoCacheDir, cloneCommit(ctx, g, r, uri, ref, hash)
+		}
+		if _, err := r.Run(ctx, "clone", uri, fmt.Sprintf("./%s", hash), "--branch", ref, "--depth", "1"); err != nil {
 			if strings.Contains(err.Error(), "Could not find remote branch") {
-				if _, err := r.Run(ctx, "clone", g.RepoCloneURI, fmt.Sprintf("./%s", hash), "--depth", "1"); err != nil {
-					return "", fmt.Errorf("failed to clone repo: %w", err)
+				if _, err := r.Run(ctx, "clone", uri, fmt.Sprintf("./%s", hash), "--depth", "1"); err != nil {
+					return "", cloneErr(g, "failed to clone repo: %w", err)
 				}
 
 				r.Dir = repoCacheDir
@@ -139,7 +170,7 @@ func syncRepo(ctx context.Context, g Config, opts config.SkaffoldOptions) (strin
 					return "", fmt.Errorf("failed to checkout commit: %w", err)
 				}
 			} else {
-				return "", fmt.Errorf("failed to clone repo: %w", err)
+				return "", cloneErr(g, "failed to clone repo: %w", err)
 			}
 		}
 	} else {
@@ -161,10 +192,25 @@ func syncRepo(ctx context.Context, g Config, opts config.SkaffoldOptions) (strin
 			return repoCacheDir, nil
 		}
 
-		tryUpdateRemoteOriginFetchURL(ctx, r, g.RepoCloneURI)
+		// a commit never changes, so the clone is only reset to it, without fetching from remote.
+		if commitSHA.MatchString(g.Ref) {
+			if _, err := r.Run(ctx, "reset", "--hard", g.Ref); err != nil {
+				return "", fmt.Errorf("failed to clone repo %s: trouble resetting to commit %s: %w", g.Repo, g.Ref, err)
+			}
+			return repoCacheDir, nil
+		}
+
+		uri, ref, err := remoteRef(ctx, &g)
+		if err != nil {
+			return "", err
+		}
+		if r.Env, err = authEnv(uri); err != nil {
+			return "", err
+		}
+		tryUpdateRemoteOriginFetchURL(ctx, r, uri)
 
 		if _, err = r.Run(ctx, "fetch", "origin", ref); err != nil {
-			return "", fmt.Errorf("failed to clone repo %s: unable to find any matching refs %s; run 'git clone <REPO>; stat <DIR/SUBDIR>' to verify credentials: %w", g.Repo, ref, err)
+			return "", cloneErr(g, "failed to clone repo %s: unable to find any matching refs %s; run 'git clone <REPO>; stat <DIR/SUBDIR>' to verify credentials: %w", g.Repo, ref, err)
 		}
 
 		// Sync option is either nil or true, so we are resetting the repo
@@ -175,10 +221,68 @@ func syncRepo(ctx context.Context, g Config, opts config.SkaffoldOptions) (strin
 	return repoCacheDir, nil
 }
 
+// remoteRef resolves the URI of the repository, and its default branch when no ref is set.
+func remoteRef(ctx context.Context, g *Config) (string, string, error) {
+	uri, err := g.cloneURI(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to clone repo %s: %w", g.Repo, err)
+	}
+
+	ref := g.Ref
+	if ref == "" {
+		ref, err = defaultRef(ctx, uri, g.Repo)
+		if err != nil {
+			return "", "", cloneErr(*g, "failed to clone repo %s: trouble getting default branch: %w", g.Repo, err)
+		}
+	}
+	return uri, ref, nil
+}
+
+// cloneCommit clones a single commit of a repository, since `git clone` only clones branches and tags.
+func cloneCommit(ctx context.Context, g Config, r gitCmd, uri, sha, hash string) error {
+	if _, err := r.Run(ctx, "init", "--quiet", fmt.Sprintf("./%s", hash)); err != nil {
+		return fmt.Errorf("failed to clone repo: %w", err)
+	}
+
+	r.Dir = filepath.Join(r.Dir, hash)
+	err := func() error {
+		if _, err := r.Run(ctx, "remote", "add", "origin", uri); err != nil {
+			return fmt.Errorf("failed to clone repo: %w", err)
+		}
+		if _, err := r.Run(ctx, "fetch", "--depth", "1", "origin", sha); err != nil {
+			return cloneErr(g, "failed to clone repo %s: unable to fetch commit %s: %w", g.Repo, sha, err)
+		}
+		if _, err := r.Run(ctx, "checkout", "--quiet", sha); err != nil {
+			return fmt.Errorf("failed to checkout commit: %w", err)
+		}
+		return nil
+	}()
+	if err != nil {
+		if rmErr := os.RemoveAll(r.Dir); rmErr != nil {
+			return fmt.Errorf("failed to remove repo cache dir: %w", rmErr)
+		}
+	}
+	return err
+}
+
+// cloneErr reports the errors of git commands that reach the remote repository,
+// and tells when they're caused by invalid or expired credentials.
+func cloneErr(g Config, format string, a ...interface{}) error {
+	err := fmt.Errorf(format, a...)
+	for _, msg := range authFailures {
+		if strings.Contains(err.Error(), msg) {
+			return AuthErr(g, err)
+		}
+	}
+	return err
+}
+
 // gitCmd runs git commands in a git repo.
 type gitCmd struct {
 	// Dir is the directory the commands are run in.
 	Dir string
+	// Env is added to the environment of the commands, to authenticate them to the remote repository.
+	Env []string
 }
 
 // Run runs a git command.
@@ -191,9 +295,22 @@ func (g *gitCmd) Run(ctx context.Context, args ...string) ([]byte, error) {
 
 	cmd := exec.Command(p, args...)
 	cmd.Dir = g.Dir
+	if len(g.Env) > 0 {
+		cmd.Env = append(util.OSEnviron(), g.Env...)
+	}
 	return util.RunCmdOut(ctx, cmd)
 }
 
+// Commit returns the commit checked out in a cached repository.
+func Commit(ctx context.Context, repoCacheDir string) (string, error) {
+	r := gitCmd{Dir: repoCacheDir}
+	out, err := r.Run(ctx, "rev-parse", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to get commit of repo cache dir %s: %w", repoCacheDir, err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
 func tryUpdateRemoteOriginFetchURL(ctx context.Context, r gitCmd, newFetchURI string) {
 	output, err := r.Run(ctx, "remote", "get-url", "origin")
 	if err != nil {
@@ -17,14 +17,38 @@ limitations under the License.
 package graph
 
 import (
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 )
 
 // Artifact is the result corresponding to each successful build.
 type Artifact struct {
-	ImageName   string `json:"imageName"`
-	Tag         string `json:"tag"`
-	RuntimeType string `json:"runtimeType,omitempty"`
+	ImageName string `json:"imageName"`
+	Tag       string `json:"tag"`
+	// MachineImage is the ID of what the artifact was built into when it isn't a container image, for instance an AMI.
+	// Tag is empty then, since the ID isn't an image reference.
+	MachineImage string `json:"machineImage,omitempty"`
+	RuntimeType  string `json:"runtimeType,omitempty"`
+}
+
+// NewArtifact returns the artifact that a builder built into the given result,
+// either the reference to a container image or a packer.MachineImageResult.
+func NewArtifact(a *latest.Artifact, result string) Artifact {
+	if id, found := packer.MachineImageID(result); found {
+		return Artifact{ImageName: a.ImageName, MachineImage: id, RuntimeType: a.RuntimeType}
+	}
+	return Artifact{ImageName: a.ImageName, Tag: result, RuntimeType: a.RuntimeType}
+}
+
+// ContainerImages returns the artifacts that were built into container images, leaving out the machine images.
+func ContainerImages(artifacts []Artifact) []Artifact {
+	var images []Artifact
+	for _, a := range artifacts {
+		if a.MachineImage == "" {
+			images = append(images, a)
+		}
+	}
+	return images
 }
 
 // ArtifactGraph is a map of [artifact image : artifact definition]
@@ -19,6 +19,7 @@ package graph
 import (
 	"testing"
 
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/ryanharper/skaffold/v2/testutil"
 )
@@ -64,3 +65,16 @@ func TestToArtifactGraph_shouldReturnDependencies(t *testing.T) {
 		t.CheckDeepEqual(graph.Dependencies(artifacts[0])[0], artifacts[1])
 	})
 }
+
+func TestNewArtifact(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		a := &latest.Artifact{ImageName: "app", RuntimeType: "go"}
+
+		image := NewArtifact(a, "app:tag@sha256:abc")
+		ami := NewArtifact(a, packer.MachineImageResult("us-east-1:ami-0123456789abcdef0"))
+
+		t.CheckDeepEqual(Artifact{ImageName: "app", Tag: "app:tag@sha256:abc", RuntimeType: "go"}, image)
+		t.CheckDeepEqual(Artifact{ImageName: "app", MachineImage: "us-east-1:ami-0123456789abcdef0", RuntimeType: "go"}, ami)
+		t.CheckDeepEqual([]Artifact{image}, ContainerImages([]Artifact{ami, image}))
+	})
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/kaniko"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/ko"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/misc"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/instrumentation"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
@@ -147,6 +148,9 @@ func sourceDependenciesForArtifact(ctx context.Context, a *latest.Artifact, cfg
 	case a.KoArtifact != nil:
 		paths, err = ko.GetDependencies(ctx, a.Workspace, a.KoArtifact)
 
+	case a.PackerArtifact != nil:
+		paths, err = packer.GetDependencies(ctx, a.Workspace, a.PackerArtifact)
+
 	default:
 		return nil, fmt.Errorf("unexpected artifact type %q:\n%s", misc.ArtifactType(a), misc.FormatArtifact(a))
 	}
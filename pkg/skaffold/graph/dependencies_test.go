@@ -65,6 +65,8 @@ func TestSourceDependenciesForArtifact(t *testing.T) {
 		"bar.go",
 		"dir1/baz.java",
 		"dir2/frob.go",
+		"image.pkr.hcl",
+		"image.pkrvars.hcl",
 	)
 	tests := []struct {
 		description            string
@@ -86,6 +88,19 @@ func TestSourceDependenciesForArtifact(t *testing.T) {
 				filepath.Join(tmpDir.Root(), "bar.go"),
 			},
 		},
+		{
+			description: "packer default dependencies",
+			artifact: &latest.Artifact{
+				ArtifactType: latest.ArtifactType{
+					PackerArtifact: &latest.PackerArtifact{TemplatePath: "image.pkr.hcl"},
+				},
+				Workspace: tmpDir.Root(),
+			},
+			expectedPaths: []string{
+				filepath.Join(tmpDir.Root(), "image.pkr.hcl"),
+				filepath.Join(tmpDir.Root(), "image.pkrvars.hcl"),
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
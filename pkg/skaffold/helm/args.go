@@ -34,8 +34,9 @@ import (
 	maps "github.com/ryanharper/skaffold/v2/pkg/skaffold/util/map"
 )
 
-// ConstructOverrideArgs creates the command line arguments for overrides
-func ConstructOverrideArgs(r *latest.HelmRelease, builds []graph.Artifact, args []string, manifestOverrides map[string]string) ([]string, error) {
+// ConstructOverrideArgs creates the command line arguments for overrides.
+// The templates can reference the given template variables, like Terraform outputs, as well as the built images.
+func ConstructOverrideArgs(r *latest.HelmRelease, builds []graph.Artifact, templateVars map[string]string, args []string, manifestOverrides map[string]string) ([]string, error) {
 	for _, k := range maps.SortKeys(r.SetValues) {
 		args = append(args, "--set", fmt.Sprintf("%s=%s", k, r.SetValues[k]))
 	}
@@ -51,6 +52,9 @@ func ConstructOverrideArgs(r *latest.HelmRelease, builds []graph.Artifact, args
 	}
 
 	envMap := map[string]string{}
+	for k, v := range templateVars {
+		envMap[k] = v
+	}
 	for idx, b := range builds {
 		idxSuffix := ""
 		// replace commonly used image name chars that are illegal helm template chars "/" & "-" with "_"
@@ -0,0 +1,114 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+// PulledChart is a remote Helm chart pulled to a local archive.
+type PulledChart struct {
+	// Path is the path to the chart archive.
+	Path string
+	// Version is the version of the chart.
+	Version string
+	// Digest is the sha256 digest of the chart archive.
+	Digest string
+}
+
+// PullChart pulls a remote chart, at a version or version range when it's set, to an empty directory.
+func PullChart(ctx context.Context, chart, repo, version, dir string) (PulledChart, error) {
+	args := []string{"pull", chart}
+	if repo != "" {
+		args = append(args, "--repo", repo)
+	}
+	if version != "" {
+		args = append(args, "--version", version)
+	}
+	args = append(args, "--destination", dir)
+	if out, err := util.RunCmdOut(ctx, exec.CommandContext(ctx, "helm", args...)); err != nil {
+		return PulledChart{}, fmt.Errorf("pulling chart %q: %s: %w", chart, strings.TrimSpace(string(out)), err)
+	}
+	return ReadChart(dir)
+}
+
+// ReadChart reads the version and digest of the chart archive in a directory.
+func ReadChart(dir string) (PulledChart, error) {
+	archives, err := filepath.Glob(filepath.Join(dir, "*.tgz"))
+	if err != nil {
+		return PulledChart{}, err
+	}
+	if len(archives) != 1 {
+		return PulledChart{}, fmt.Errorf("expected one chart archive in %s, found %d", dir, len(archives))
+	}
+
+	buf, err := os.ReadFile(archives[0])
+	if err != nil {
+		return PulledChart{}, fmt.Errorf("reading chart archive: %w", err)
+	}
+	version, err := chartVersion(bytes.NewReader(buf))
+	if err != nil {
+		return PulledChart{}, fmt.Errorf("reading chart archive %s: %w", archives[0], err)
+	}
+	return PulledChart{Path: archives[0], Version: version, Digest: fmt.Sprintf("sha256:%x", sha256.Sum256(buf))}, nil
+}
+
+// chartVersion reads the version in the `Chart.yaml` file at the root of a chart archive.
+func chartVersion(r io.Reader) (string, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return "", err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return "", errors.New("no Chart.yaml found")
+		}
+		if err != nil {
+			return "", err
+		}
+		if parts := strings.Split(h.Name, "/"); len(parts) != 2 || parts[1] != "Chart.yaml" {
+			continue
+		}
+		buf, err := io.ReadAll(tr)
+		if err != nil {
+			return "", err
+		}
+		var metadata struct {
+			Version string `yaml:"version"`
+		}
+		if err := yaml.Unmarshal(buf, &metadata); err != nil {
+			return "", fmt.Errorf("parsing Chart.yaml: %w", err)
+		}
+		return metadata.Version, nil
+	}
+}
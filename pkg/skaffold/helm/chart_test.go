@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func chartArchive(t *testutil.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		t.CheckNoError(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		t.CheckNoError(err)
+	}
+	t.CheckNoError(tw.Close())
+	t.CheckNoError(gz.Close())
+	return buf.Bytes()
+}
+
+func TestReadChart(t *testing.T) {
+	tests := []struct {
+		description string
+		archives    map[string]map[string]string
+		expected    string
+		shouldErr   bool
+	}{
+		{
+			description: "chart version",
+			archives: map[string]map[string]string{"nginx-15.1.2.tgz": {
+				"nginx/templates/deployment.yaml": "kind: Deployment",
+				"nginx/charts/common/Chart.yaml":  "name: common\nversion: 2.0.0",
+				"nginx/Chart.yaml":                "apiVersion: v2\nname: nginx\nversion: 15.1.2",
+			}},
+			expected: "15.1.2",
+		},
+		{
+			description: "no Chart.yaml",
+			archives:    map[string]map[string]string{"nginx-15.1.2.tgz": {"nginx/values.yaml": "replicas: 1"}},
+			shouldErr:   true,
+		},
+		{
+			description: "no archive",
+			shouldErr:   true,
+		},
+		{
+			description: "several archives",
+			archives: map[string]map[string]string{
+				"nginx-15.1.2.tgz": {"nginx/Chart.yaml": "version: 15.1.2"},
+				"nginx-15.1.3.tgz": {"nginx/Chart.yaml": "version: 15.1.3"},
+			},
+			shouldErr: true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir()
+			var digest string
+			for name, files := range test.archives {
+				buf := chartArchive(t, files)
+				tmpDir.Write(name, string(buf))
+				digest = fmt.Sprintf("sha256:%x", sha256.Sum256(buf))
+			}
+
+			c, err := ReadChart(tmpDir.Root())
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, c.Version)
+				t.CheckDeepEqual(digest, c.Digest)
+			}
+		})
+	}
+}
+
+func TestPullChart(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		tmpDir.Write("nginx-15.1.2.tgz", string(chartArchive(t, map[string]string{"nginx/Chart.yaml": "version: 15.1.2"})))
+		t.Override(&util.DefaultExecCommand, testutil.CmdRunOut(
+			fmt.Sprintf("helm pull nginx --repo https://charts.example.com --version ^15.0.0 --destination %s", tmpDir.Root()), ""))
+
+		c, err := PullChart(context.Background(), "nginx", "https://charts.example.com", "^15.0.0", tmpDir.Root())
+		t.CheckNoError(err)
+		t.CheckDeepEqual("15.1.2", c.Version)
+		t.CheckDeepEqual(tmpDir.Path("nginx-15.1.2.tgz"), c.Path)
+	})
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// for testing
+var NewTerraformDeployRunner = newTerraformDeployRunner
+
+// TerraformEnvOpts contains the environment variables to be set in the lifecycle hook executor of a single Terraform deployment.
+type TerraformEnvOpts struct {
+	TerraformDeployment string
+	TerraformWorkspace  string
+	TerraformDir        string
+}
+
+// newTerraformDeployRunner returns a runner for the host hooks of the Terraform deployer, or of one of its deployments when tfOpts is set.
+// The post-hooks environment also contains the values returned by outputs, like the Terraform outputs of the applied deployments.
+func newTerraformDeployRunner(d latest.TerraformDeployHooks, opts DeployEnvOpts, tfOpts *TerraformEnvOpts, outputs func() map[string]string) Runner {
+	return terraformDeployRunner{
+		TerraformDeployHooks: d,
+		opts:                 opts,
+		tfOpts:               tfOpts,
+		outputs:              outputs,
+	}
+}
+
+type terraformDeployRunner struct {
+	latest.TerraformDeployHooks
+	opts    DeployEnvOpts
+	tfOpts  *TerraformEnvOpts
+	outputs func() map[string]string
+}
+
+func (r terraformDeployRunner) RunPreHooks(ctx context.Context, out io.Writer) error {
+	return r.run(ctx, out, r.PreHooks, phases.PreDeploy, nil)
+}
+
+func (r terraformDeployRunner) RunPostHooks(ctx context.Context, out io.Writer) error {
+	var outputs map[string]string
+	if r.outputs != nil {
+		outputs = r.outputs()
+	}
+	return r.run(ctx, out, r.PostHooks, phases.PostDeploy, outputs)
+}
+
+func (r terraformDeployRunner) getEnv(outputs map[string]string) []string {
+	env := append(getEnv(staticEnvOpts), getEnv(r.opts)...)
+	if r.tfOpts != nil {
+		env = append(env, getEnv(*r.tfOpts)...)
+	}
+
+	var keys []string
+	for k := range outputs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		env = append(env, fmt.Sprintf("%s=%s", k, outputs[k]))
+	}
+	return env
+}
+
+func (r terraformDeployRunner) run(ctx context.Context, out io.Writer, hooks []latest.HostHook, phase phase, outputs map[string]string) error {
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	output.Default.Fprintln(out, fmt.Sprintf("Starting %s hooks...", phase))
+	env := r.getEnv(outputs)
+	for _, h := range hooks {
+		hook := hostHook{h, env}
+		if err := hook.run(ctx, nil, out); err != nil && !errors.Is(err, &Skip{}) {
+			return err
+		}
+	}
+	output.Default.Fprintln(out, fmt.Sprintf("Completed %s hooks", phase))
+	return nil
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hooks
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestTerraformDeployHooks(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		hooks := latest.TerraformDeployHooks{
+			PreHooks: []latest.HostHook{
+				{
+					OS:      []string{"linux", "darwin"},
+					Command: []string{"sh", "-c", "echo pre-hook running with SKAFFOLD_RUN_ID=$SKAFFOLD_RUN_ID,SKAFFOLD_TERRAFORM_DEPLOYMENT=$SKAFFOLD_TERRAFORM_DEPLOYMENT,SKAFFOLD_TERRAFORM_WORKSPACE=$SKAFFOLD_TERRAFORM_WORKSPACE,SKAFFOLD_TERRAFORM_DIR=$SKAFFOLD_TERRAFORM_DIR,TF_network_subnet_id=$TF_network_subnet_id"},
+				},
+				{
+					OS:      []string{"windows"},
+					Command: []string{"cmd.exe", "/C", "echo pre-hook running with SKAFFOLD_RUN_ID=%SKAFFOLD_RUN_ID%,SKAFFOLD_TERRAFORM_DEPLOYMENT=%SKAFFOLD_TERRAFORM_DEPLOYMENT%,SKAFFOLD_TERRAFORM_WORKSPACE=%SKAFFOLD_TERRAFORM_WORKSPACE%,SKAFFOLD_TERRAFORM_DIR=%SKAFFOLD_TERRAFORM_DIR%,TF_network_subnet_id="},
+				},
+			},
+			PostHooks: []latest.HostHook{
+				{
+					OS:      []string{"linux", "darwin"},
+					Command: []string{"sh", "-c", "echo post-hook running with TF_network_subnet_id=$TF_network_subnet_id"},
+				},
+				{
+					OS:      []string{"windows"},
+					Command: []string{"cmd.exe", "/C", "echo post-hook running with TF_network_subnet_id=%TF_network_subnet_id%"},
+				},
+			},
+		}
+		opts := NewDeployEnvOpts("run_id", "", nil)
+		tfOpts := &TerraformEnvOpts{TerraformDeployment: "network", TerraformWorkspace: "staging", TerraformDir: "infra/network"}
+		runner := NewTerraformDeployRunner(hooks, opts, tfOpts, func() map[string]string {
+			return map[string]string{"TF_network_subnet_id": "subnet-1"}
+		})
+
+		var preOut, postOut bytes.Buffer
+		err := runner.RunPreHooks(context.Background(), &preOut)
+		t.CheckNoError(err)
+		t.CheckContains("pre-hook running with SKAFFOLD_RUN_ID=run_id,SKAFFOLD_TERRAFORM_DEPLOYMENT=network,SKAFFOLD_TERRAFORM_WORKSPACE=staging,SKAFFOLD_TERRAFORM_DIR=infra/network,TF_network_subnet_id=", preOut.String())
+		t.CheckFalse(bytes.Contains(preOut.Bytes(), []byte("subnet-1")))
+
+		err = runner.RunPostHooks(context.Background(), &postOut)
+		t.CheckNoError(err)
+		t.CheckContains("post-hook running with TF_network_subnet_id=subnet-1", postOut.String())
+	})
+}
@@ -46,6 +46,7 @@ type ProjectAnalysis struct {
 	kubeAnalyzer        *kubeAnalyzer
 	kustomizeAnalyzer   *kustomizeAnalyzer
 	helmAnalyzer        *helmAnalyzer
+	terraformAnalyzer   *terraformAnalyzer
 	builderAnalyzer     *builderAnalyzer
 	maxFileSize         int64
 	skipUnreachableDirs bool
@@ -87,11 +88,17 @@ func (a *ProjectAnalysis) ChartPaths() map[string][]string {
 	return a.helmAnalyzer.chartDirs
 }
 
+// TerraformStacks returns the directories of the Terraform root modules.
+func (a *ProjectAnalysis) TerraformStacks() []string {
+	return a.terraformAnalyzer.rootModules()
+}
+
 func (a *ProjectAnalysis) analyzers() []analyzer {
 	return []analyzer{
 		a.kubeAnalyzer,
 		a.kustomizeAnalyzer,
 		a.helmAnalyzer,
+		a.terraformAnalyzer,
 		a.configAnalyzer,
 		a.builderAnalyzer,
 	}
@@ -103,6 +110,7 @@ func NewAnalyzer(c config.Config) *ProjectAnalysis {
 		kubeAnalyzer:      &kubeAnalyzer{},
 		kustomizeAnalyzer: &kustomizeAnalyzer{},
 		helmAnalyzer:      &helmAnalyzer{chartDirs: map[string][]string{}},
+		terraformAnalyzer: &terraformAnalyzer{configDirs: map[string]bool{}, moduleDirs: map[string]bool{}},
 		builderAnalyzer: &builderAnalyzer{
 			findBuilders:         !c.SkipBuild,
 			enableJibInit:        c.EnableJibInit,
@@ -47,6 +47,7 @@ func TestAnalyze(t *testing.T) {
 		filesWithContents map[string]string
 		expectedConfigs   []string
 		expectedBuilders  []builder
+		expectedStacks    []string
 		config            initconfig.Config
 		shouldErr         bool
 	}{
@@ -379,6 +380,26 @@ deploy:
 			expectedBuilders: nil,
 			shouldErr:        true,
 		},
+		{
+			description: "packer templates and terraform root modules",
+			filesWithContents: map[string]string{
+				"image/image.pkr.hcl":           "source \"docker\" \"ubuntu\" {}\nbuild {\n  sources = [\"source.docker.ubuntu\"]\n}\n",
+				"image/variables.pkr.hcl":       "variable \"image_tag\" {}\n",
+				"legacy/template.pkr.json":      `{"builders": [{"type": "docker"}]}`,
+				"vars/prod.pkrvars.hcl":         "image_tag = \"prod\"\n",
+				"infra/network/main.tf":         "module \"vpc\" {\n  source = \"../modules/vpc\"\n}\n",
+				"infra/modules/vpc/main.tf":     emptyFile,
+				"infra/app/main.tf":             emptyFile,
+				"infra/app/terraform.tfvars":    emptyFile,
+				"infra/.terraform/cache/int.tf": emptyFile,
+			},
+			config: initconfig.Config{},
+			expectedBuilders: []builder{
+				{name: "Packer", path: "image/image.pkr.hcl"},
+				{name: "Packer", path: "legacy/template.pkr.json"},
+			},
+			expectedStacks: []string{"infra/app", "infra/network"},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -396,6 +417,7 @@ deploy:
 			}
 
 			t.CheckDeepEqual(test.expectedConfigs, a.Manifests())
+			t.CheckDeepEqual(test.expectedStacks, a.TerraformStacks())
 
 			if len(test.expectedBuilders) != len(a.Builders()) {
 				t.Fatalf("expected %d builders, got %d: %v",
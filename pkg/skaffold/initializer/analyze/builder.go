@@ -24,6 +24,7 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/jib"
 	koinit "github.com/ryanharper/skaffold/v2/pkg/skaffold/build/ko/init"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/build"
 )
@@ -88,6 +89,11 @@ func (a *builderAnalyzer) detectBuilders(ctx context.Context, path string, detec
 		}
 	}
 
+	// Check for Packer templates
+	if packer.ValidateTemplate(path) {
+		results = append(results, packer.NewArtifactConfig(path))
+	}
+
 	if a.enableKoInit {
 		if koinit.Validate(path) {
 			results = append(results, koinit.ArtifactConfig{
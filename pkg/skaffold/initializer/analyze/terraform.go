@@ -0,0 +1,69 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package analyze
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/deploy/terraform"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+)
+
+// terraformAnalyzer is a Visitor during the directory analysis that finds Terraform root modules
+type terraformAnalyzer struct {
+	directoryAnalyzer
+	configDirs map[string]bool
+	moduleDirs map[string]bool
+}
+
+func (t *terraformAnalyzer) analyzeFile(ctx context.Context, fp string) error {
+	if !isTerraformConfig(fp) {
+		return nil
+	}
+	dir := filepath.Dir(fp)
+	t.configDirs[dir] = true
+
+	b, err := os.ReadFile(fp)
+	if err != nil {
+		log.Entry(ctx).Debugf("not looking for the modules used by %s: %s", fp, err)
+		return nil
+	}
+	for _, source := range terraform.LocalModuleSources(b) {
+		t.moduleDirs[filepath.Join(dir, source)] = true
+	}
+	return nil
+}
+
+// rootModules lists the directories with Terraform configuration files that aren't used as modules by other configurations.
+func (t *terraformAnalyzer) rootModules() []string {
+	var dirs []string
+	for dir := range t.configDirs {
+		if !t.moduleDirs[dir] {
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+func isTerraformConfig(fp string) bool {
+	return strings.HasSuffix(fp, ".tf") || strings.HasSuffix(fp, ".tf.json")
+}
@@ -23,6 +23,7 @@ import (
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/jib"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/prompt"
 	tag "github.com/ryanharper/skaffold/v2/pkg/skaffold/tag/util"
@@ -136,6 +137,33 @@ func TestResolveBuilderImages(t *testing.T) {
 			force:            false,
 			shouldErr:        false,
 		},
+		{
+			description:  "packer template without image",
+			buildConfigs: []InitBuilder{packer.ArtifactConfig{File: "image/image.pkr.hcl"}},
+			images:       []string{},
+			expectedInfos: []ArtifactInfo{
+				{
+					Builder:   packer.ArtifactConfig{File: "image/image.pkr.hcl"},
+					ImageName: "image",
+				},
+			},
+		},
+		{
+			description:      "packer template not chosen for an image",
+			buildConfigs:     []InitBuilder{docker.ArtifactConfig{File: "Dockerfile1"}, packer.ArtifactConfig{File: "image/image.pkr.hcl"}},
+			images:           []string{"image1"},
+			shouldMakeChoice: true,
+			expectedInfos: []ArtifactInfo{
+				{
+					Builder:   docker.ArtifactConfig{File: "Dockerfile1"},
+					ImageName: "image1",
+				},
+				{
+					Builder:   packer.ArtifactConfig{File: "image/image.pkr.hcl"},
+					ImageName: "image",
+				},
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -156,6 +184,9 @@ func TestResolveBuilderImages(t *testing.T) {
 				unresolvedImages: test.images,
 			}
 			err := initializer.resolveBuilderImages()
+			if err == nil {
+				initializer.resolvePackerBuilders()
+			}
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedInfos, initializer.artifactInfos, cmp.AllowUnexported())
 			t.CheckDeepEqual(test.expectedGeneratedInfos, initializer.generatedArtifactInfos, cmp.AllowUnexported())
 		})
@@ -25,6 +25,7 @@ import (
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/buildpacks"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/jib"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/errors"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/kubernetes/generator"
@@ -180,6 +181,16 @@ func processCliArtifacts(cliArtifacts []string) ([]ArtifactInfo, error) {
 			info := ArtifactInfo{Builder: parsed.Payload, ImageName: a.Image, Workspace: a.Workspace, Manifest: manifestInfo}
 			artifactInfos = append(artifactInfos, info)
 
+		case packer.Name:
+			parsed := struct {
+				Payload packer.ArtifactConfig `json:"payload"`
+			}{}
+			if err := json.Unmarshal([]byte(artifact), &parsed); err != nil {
+				return nil, err
+			}
+			info := ArtifactInfo{Builder: parsed.Payload, ImageName: a.Image, Workspace: a.Workspace, Manifest: manifestInfo}
+			artifactInfos = append(artifactInfos, info)
+
 		case "None":
 			info := ArtifactInfo{Builder: NoneBuilder{}, ImageName: a.Image, Manifest: manifestInfo}
 			artifactInfos = append(artifactInfos, info)
@@ -50,7 +50,10 @@ func (d *defaultBuildInitializer) ProcessImages(images []string) error {
 	// if we're in `analyze` mode, we want to match if we can, but not resolve
 	d.matchBuildersToImages(images)
 	if d.resolveImages {
-		return d.resolveBuilderImages()
+		if err := d.resolveBuilderImages(); err != nil {
+			return err
+		}
+		d.resolvePackerBuilders()
 	}
 	return nil
 }
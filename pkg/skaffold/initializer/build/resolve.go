@@ -23,6 +23,7 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/errors"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/prompt"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringslice"
@@ -38,6 +39,9 @@ func (d *defaultBuildInitializer) resolveBuilderImages() error {
 	// if there's only one builder config, no need to prompt
 	if len(d.builders) == 1 {
 		if len(d.unresolvedImages) == 0 {
+			if d.builders[0].Name() == packer.Name {
+				return nil
+			}
 			// no image was parsed from k8s manifests, so we create an image name
 			d.generatedArtifactInfos = append(d.generatedArtifactInfos, getGeneratedArtifactInfo(d.builders[0]))
 			return nil
@@ -125,8 +129,15 @@ func (d *defaultBuildInitializer) resolveBuilderImagesInteractively() error {
 		}
 		d.unresolvedImages = stringslice.Remove(d.unresolvedImages, image)
 	}
-	if len(choices) > 0 {
-		chosen, err := prompt.ChooseBuildersFunc(choices)
+	// Packer templates don't get generated kubernetes resources
+	var manifestChoices []string
+	for _, choice := range choices {
+		if choiceMap[choice].Name() != packer.Name {
+			manifestChoices = append(manifestChoices, choice)
+		}
+	}
+	if len(manifestChoices) > 0 {
+		chosen, err := prompt.ChooseBuildersFunc(manifestChoices)
 		if err != nil {
 			return err
 		}
@@ -138,6 +149,24 @@ func (d *defaultBuildInitializer) resolveBuilderImagesInteractively() error {
 	return nil
 }
 
+// resolvePackerBuilders keeps the Packer templates that don't build any of the images of the manifests as artifacts of their own,
+// since they usually build machine images or images for the infrastructure rather than for kubernetes resources.
+func (d *defaultBuildInitializer) resolvePackerBuilders() {
+	resolved := map[string]bool{}
+	for _, info := range d.artifactInfos {
+		resolved[info.Builder.Describe()] = true
+	}
+	for _, info := range d.generatedArtifactInfos {
+		resolved[info.Builder.Describe()] = true
+	}
+
+	for _, b := range d.builders {
+		if b.Name() == packer.Name && !resolved[b.Describe()] {
+			d.artifactInfos = append(d.artifactInfos, getGeneratedArtifactInfo(b).ArtifactInfo)
+		}
+	}
+}
+
 func getGeneratedArtifactInfo(b InitBuilder) GeneratedArtifactInfo {
 	path := b.Path()
 	var imageName string
@@ -19,6 +19,7 @@ package deploy
 import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/analyze"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/initializer/prompt"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 )
 
@@ -35,14 +36,41 @@ func (e *emptyDeployInit) DeployConfig() latest.DeployConfig {
 	return latest.DeployConfig{}
 }
 
-// NewInitializer if any helm charts are provided we use HelmInitializer, otherwise we use empty initializer.
-func NewInitializer(h analyze.HelmChartInfo, c config.Config) Initializer {
+// combinedDeployInit generates the Deploy Config of both the helm charts and the Terraform root modules.
+type combinedDeployInit struct {
+	helm      helm
+	terraform terraform
+}
+
+func (c *combinedDeployInit) DeployConfig() latest.DeployConfig {
+	deployConfig := c.helm.DeployConfig()
+	deployConfig.TerraformDeploy = c.terraform.DeployConfig().TerraformDeploy
+	return deployConfig
+}
+
+// NewInitializer if any helm charts or Terraform root modules are provided we use HelmInitializer and TerraformInitializer,
+// otherwise we use empty initializer. Users choose the root modules to deploy unless `--force` is used.
+func NewInitializer(h analyze.HelmChartInfo, stacks []string, c config.Config) (Initializer, error) {
+	if c.SkipDeploy {
+		return &emptyDeployInit{}, nil
+	}
+
+	if len(stacks) > 1 && !c.Force && !c.Analyze {
+		chosen, err := prompt.ChooseStacksFunc(stacks)
+		if err != nil {
+			return nil, err
+		}
+		stacks = chosen
+	}
+
 	switch {
-	case c.SkipDeploy:
-		return &emptyDeployInit{}
+	case len(h.Charts()) > 0 && len(stacks) > 0:
+		return &combinedDeployInit{helm: newHelmInitializer(h.Charts()), terraform: newTerraformInitializer(stacks)}, nil
 	case len(h.Charts()) > 0:
-		return newHelmInitializer(h.Charts())
+		return newHelmInitializer(h.Charts()), nil
+	case len(stacks) > 0:
+		return newTerraformInitializer(stacks), nil
 	default:
-		return &emptyDeployInit{}
+		return &emptyDeployInit{}, nil
 	}
 }
@@ -0,0 +1,243 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/hcl"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringslice"
+)
+
+// defaultStateFile is where the local backend stores the state of a root module.
+const defaultStateFile = "terraform.tfstate"
+
+// stateAttributes are the backend settings that locate a state.
+var stateAttributes = []string{"path", "bucket", "prefix", "key", "container_name", "storage_account_name", "organization", "name"}
+
+// terraform implements deploymentInitializer for the terraform deployer.
+type terraform struct {
+	stacks []stack
+}
+
+// stack is a Terraform root module.
+type stack struct {
+	name      string
+	dir       string
+	backend   state
+	remotes   []remoteState
+	dependsOn []string
+}
+
+// state locates the state of a root module.
+type state struct {
+	backend  string
+	settings map[string]string
+}
+
+// remoteState is a `terraform_remote_state` data source.
+type remoteState struct {
+	name string
+	state
+}
+
+// newTerraformInitializer returns a terraform config generator, that orders the root modules after the ones
+// whose state they read.
+func newTerraformInitializer(dirs []string) terraform {
+	stacks := make([]stack, len(dirs))
+	names := stackNames(dirs)
+	for i, dir := range dirs {
+		stacks[i] = parseStack(names[i], dir)
+	}
+
+	for i := range stacks {
+		for _, remote := range stacks[i].remotes {
+			dep := findStack(stacks, stacks[i], remote)
+			if dep == nil {
+				log.Entry(context.TODO()).Debugf("Couldn't find the root module of the remote state %q read by %s", remote.name, stacks[i].dir)
+				continue
+			}
+			if !stringslice.Contains(stacks[i].dependsOn, dep.name) {
+				stacks[i].dependsOn = append(stacks[i].dependsOn, dep.name)
+			}
+		}
+		sort.Strings(stacks[i].dependsOn)
+	}
+
+	return terraform{
+		stacks: stacks,
+	}
+}
+
+// DeployConfig implements the Initializer interface and generates
+// a terraform configuration
+func (t terraform) DeployConfig() latest.DeployConfig {
+	var deployments []latest.TerrformDeployments
+	for _, s := range t.stacks {
+		deployments = append(deployments, latest.TerrformDeployments{
+			Name: s.name,
+			// to make skaffold.yaml more portable across OS-es we should always generate /-delimited filePaths
+			Dir:       filepath.ToSlash(s.dir),
+			DependsOn: s.dependsOn,
+		})
+	}
+	return latest.DeployConfig{
+		DeployType: latest.DeployType{
+			TerraformDeploy: &latest.TerraformDeploy{
+				Deployments: deployments,
+			},
+		},
+	}
+}
+
+// stackNames names the root modules after their directory, or after their path when directories have the same name.
+func stackNames(dirs []string) []string {
+	count := map[string]int{}
+	for _, dir := range dirs {
+		count[stackName(dir)]++
+	}
+
+	names := make([]string, len(dirs))
+	for i, dir := range dirs {
+		names[i] = stackName(dir)
+		if count[names[i]] > 1 {
+			names[i] = strings.ReplaceAll(filepath.ToSlash(filepath.Clean(dir)), "/", "-")
+		}
+	}
+	return names
+}
+
+func stackName(dir string) string {
+	if base := filepath.Base(dir); base != "." && base != string(filepath.Separator) {
+		return base
+	}
+	return "default"
+}
+
+// parseStack reads the backend of a root module and the remote states it reads from its configuration files.
+func parseStack(name, dir string) stack {
+	s := stack{
+		name:    name,
+		dir:     dir,
+		backend: state{backend: "local", settings: map[string]string{}},
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return s
+	}
+	sort.Strings(files)
+	for _, file := range files {
+		b, err := readFile(file)
+		if err != nil {
+			log.Entry(context.TODO()).Debugf("Skipping %s, as it could not be read: %s", file, err)
+			continue
+		}
+
+		blocks, err := hcl.Parse(b, file)
+		if err != nil {
+			log.Entry(context.TODO()).Debugf("Skipping %s, as it could not be parsed: %s", file, err)
+			continue
+		}
+
+		for _, settings := range hcl.Find(blocks, "terraform") {
+			for _, backend := range settings.Blocks {
+				if backend.Type == "backend" && len(backend.Labels) == 1 {
+					s.backend = state{
+						backend:  backend.Labels[0],
+						settings: stateSettings(backend, ""),
+					}
+				}
+			}
+		}
+		for _, data := range blocks {
+			if data.Type != "data" || len(data.Labels) != 2 || data.Labels[0] != "terraform_remote_state" {
+				continue
+			}
+			remote := remoteState{
+				name:  data.Labels[1],
+				state: state{settings: stateSettings(data, "config.")},
+			}
+			remote.backend, _ = data.Attribute("backend")
+			s.remotes = append(s.remotes, remote)
+		}
+	}
+	return s
+}
+
+// findStack returns the root module whose state is read by a remote state: the one that stores it in the same
+// backend location, or else the one named after the data source.
+func findStack(stacks []stack, from stack, remote remoteState) *stack {
+	for i := range stacks {
+		if stacks[i].dir != from.dir && sameState(from, remote, stacks[i]) {
+			return &stacks[i]
+		}
+	}
+	for i := range stacks {
+		if stacks[i].dir != from.dir && stacks[i].name == remote.name {
+			return &stacks[i]
+		}
+	}
+	return nil
+}
+
+func sameState(from stack, remote remoteState, to stack) bool {
+	if remote.backend != to.backend.backend {
+		return false
+	}
+
+	if remote.backend == "local" {
+		path, found := remote.settings["path"]
+		if !found {
+			return false
+		}
+		return filepath.Join(from.dir, path) == localStatePath(to)
+	}
+
+	if len(to.backend.settings) == 0 {
+		return false
+	}
+	for k, v := range to.backend.settings {
+		if remote.settings[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func localStatePath(s stack) string {
+	if path, found := s.backend.settings["path"]; found {
+		return filepath.Join(s.dir, path)
+	}
+	return filepath.Join(s.dir, defaultStateFile)
+}
+
+// stateSettings returns the literal backend settings of a block, whose attributes are named with the given prefix.
+func stateSettings(b hcl.Block, prefix string) map[string]string {
+	settings := map[string]string{}
+	for _, attr := range stateAttributes {
+		if v, found := b.Attribute(prefix + attr); found && !strings.Contains(v, "$") {
+			settings[attr] = v
+		}
+	}
+	return settings
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestTerraformDeployConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		stacks      []string
+		expected    []latest.TerrformDeployments
+	}{
+		{
+			description: "independent root modules",
+			files: map[string]string{
+				"network/main.tf": `resource "google_compute_network" "vpc" {}`,
+				"app/main.tf":     `resource "google_cloud_run_v2_service" "app" {}`,
+			},
+			stacks: []string{"app", "network"},
+			expected: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app"},
+				{Name: "network", Dir: "network"},
+			},
+		},
+		{
+			description: "remote state in the same gcs backend",
+			files: map[string]string{
+				"network/backend.tf": `terraform {
+  backend "gcs" {
+    bucket = "tf-state"
+    prefix = "network"
+  }
+}`,
+				"app/main.tf": `data "terraform_remote_state" "vpc" {
+  backend  = "gcs"
+  defaults = { banner = "}" }
+  config = {
+    bucket = "tf-state"
+    prefix = "network"
+  }
+}`,
+			},
+			stacks: []string{"app", "network"},
+			expected: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app", DependsOn: []string{"network"}},
+				{Name: "network", Dir: "network"},
+			},
+		},
+		{
+			description: "remote state with the default local backend",
+			files: map[string]string{
+				"infra/network/main.tf": `resource "google_compute_network" "vpc" {}`,
+				"infra/app/main.tf": `data "terraform_remote_state" "vpc" {
+  backend = "local"
+  config = {
+    path = "../network/terraform.tfstate"
+  }
+}`,
+			},
+			stacks: []string{"infra/app", "infra/network"},
+			expected: []latest.TerrformDeployments{
+				{Name: "app", Dir: "infra/app", DependsOn: []string{"network"}},
+				{Name: "network", Dir: "infra/network"},
+			},
+		},
+		{
+			description: "remote state named after the root module",
+			files: map[string]string{
+				"network/main.tf":  `resource "google_compute_network" "vpc" {}`,
+				"database/main.tf": `resource "google_sql_database_instance" "db" {}`,
+				"app/main.tf": `data "terraform_remote_state" "network" {
+  backend = "s3"
+  config = {
+    bucket = "tf-state"
+    key    = "${var.env}/network.tfstate"
+  }
+}
+
+data "terraform_remote_state" "database" {
+  backend = "s3"
+  config  = { bucket = "tf-state" }
+}
+
+data "terraform_remote_state" "shared" {
+  backend = "s3"
+  config  = { bucket = "other" }
+}`,
+			},
+			stacks: []string{"app", "database", "network"},
+			expected: []latest.TerrformDeployments{
+				{Name: "app", Dir: "app", DependsOn: []string{"database", "network"}},
+				{Name: "database", Dir: "database"},
+				{Name: "network", Dir: "network"},
+			},
+		},
+		{
+			description: "root modules with the same directory name",
+			files: map[string]string{
+				"main.tf":          `resource "null_resource" "root" {}`,
+				"dev/app/main.tf":  `resource "null_resource" "dev" {}`,
+				"prod/app/main.tf": `resource "null_resource" "prod" {}`,
+			},
+			stacks: []string{".", "dev/app", "prod/app"},
+			expected: []latest.TerrformDeployments{
+				{Name: "default", Dir: "."},
+				{Name: "dev-app", Dir: "dev/app"},
+				{Name: "prod-app", Dir: "prod/app"},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().WriteFiles(test.files).Chdir()
+
+			d := newTerraformInitializer(test.stacks).DeployConfig()
+
+			t.CheckDeepEqual(test.expected, d.TerraformDeploy.Deployments)
+		})
+	}
+}
@@ -109,7 +109,8 @@ spec:
 			a := analyze.NewAnalyzer(config)
 			err := a.Analyze(".")
 			t.CheckError(test.shouldErr, err)
-			d := deploy.NewInitializer(a.HelmChartInfo(), config)
+			d, err := deploy.NewInitializer(a.HelmChartInfo(), a.TerraformStacks(), config)
+			t.CheckNoError(err)
 			dc := d.DeployConfig()
 			deploy.CheckHelmInitStruct(t, test.expected, dc.LegacyHelmDeploy.Releases)
 		})
@@ -68,8 +68,11 @@ func AnalyzeProject(c config.Config) (*analyze.ProjectAnalysis, error) {
 // Initialize uses the information gathered by the analyzer to create a skaffold config and generate kubernetes manifests.
 // The returned map[string][]byte represents a mapping from generated config name to its respective manifest data held in a []byte
 func Initialize(out io.Writer, c config.Config, a *analyze.ProjectAnalysis) (*latest.SkaffoldConfig, map[string][]byte, error) {
-	renderInitializer := render.NewInitializer(a.Manifests(), a.KustomizeBases(), a.KustomizePaths(), a.HelmChartInfo(), c)
-	deployInitializer := deploy.NewInitializer(a.HelmChartInfo(), c)
+	renderInitializer := render.NewInitializer(a.Manifests(), a.KustomizeBases(), a.KustomizePaths(), a.HelmChartInfo(), a.TerraformStacks(), c)
+	deployInitializer, err := deploy.NewInitializer(a.HelmChartInfo(), a.TerraformStacks(), c)
+	if err != nil {
+		return nil, nil, err
+	}
 	images := renderInitializer.GetImages()
 
 	buildInitializer := build.NewInitializer(a.Builders(), c)
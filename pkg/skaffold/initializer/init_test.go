@@ -212,6 +212,16 @@ func TestDoInit(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "packer and terraform",
+			dir:  "testdata/init/packer-terraform",
+			config: initconfig.Config{
+				Force: true,
+				Opts: config.SkaffoldOptions{
+					ConfigurationFile: "skaffold.yaml.out",
+				},
+			},
+		},
 		{
 			name:       "helm init",
 			dir:        "testdata/init/helm-deployment",
@@ -34,6 +34,7 @@ import (
 var (
 	BuildConfigFunc         = buildConfig
 	ChooseBuildersFunc      = chooseBuilders
+	ChooseStacksFunc        = chooseStacks
 	PortForwardResourceFunc = portForwardResource
 	askOne                  = survey.AskOne
 	ask                     = survey.Ask
@@ -93,6 +94,22 @@ func chooseBuilders(builders []string) ([]string, error) {
 	return chosen, err
 }
 
+// chooseStacks prompts the user to select which Terraform root modules they'd like to deploy
+func chooseStacks(stacks []string) ([]string, error) {
+	chosen := []string{}
+	prompt := &survey.MultiSelect{
+		Message: "Which Terraform root modules would you like to deploy?",
+		Options: stacks,
+		Default: stacks,
+	}
+	err := askOne(prompt, &chosen)
+	if err != nil {
+		return []string{}, fmt.Errorf("getting user choices")
+	}
+
+	return chosen, err
+}
+
 // PortForwardResource prompts the user to give a port to forward the current resource on
 func portForwardResource(out io.Writer, imageName string) (int, error) {
 	var response string
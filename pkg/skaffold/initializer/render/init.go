@@ -77,8 +77,9 @@ func (e *emptyRenderInit) AddManifestForImage(string, string) {}
 
 // if any CLI manifests are provided, we always use those as part of a kubectl render first
 // if not, then if a kustomization yaml is found, we use that next
+// projects that only deploy Terraform root modules don't render anything
 // otherwise, default to a kubectl render.
-func NewInitializer(manifests, bases, kustomizations []string, h analyze.HelmChartInfo, c config.Config) Initializer {
+func NewInitializer(manifests, bases, kustomizations []string, h analyze.HelmChartInfo, stacks []string, c config.Config) Initializer {
 	switch {
 	case c.SkipDeploy:
 		return &emptyRenderInit{}
@@ -88,6 +89,8 @@ func NewInitializer(manifests, bases, kustomizations []string, h analyze.HelmCha
 		return newKustomizeInitializer(c.DefaultKustomization, bases, kustomizations, manifests)
 	case len(h.Charts()) > 0:
 		return newHelmInitializer(h.Charts())
+	case len(manifests) == 0 && len(stacks) > 0:
+		return &emptyRenderInit{}
 	default:
 		return newKubectlInitializer(manifests)
 	}
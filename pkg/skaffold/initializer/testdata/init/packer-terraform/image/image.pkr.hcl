@@ -0,0 +1,30 @@
+packer {
+  required_plugins {
+    docker = {
+      version = ">= 1.0.8"
+      source  = "github.com/hashicorp/docker"
+    }
+  }
+}
+
+variable "image_name" {
+  type = string
+}
+
+variable "image_tag" {
+  type = string
+}
+
+source "docker" "ubuntu" {
+  image  = "ubuntu:jammy"
+  commit = true
+}
+
+build {
+  sources = ["source.docker.ubuntu"]
+
+  post-processor "docker-tag" {
+    repository = var.image_name
+    tags       = [var.image_tag]
+  }
+}
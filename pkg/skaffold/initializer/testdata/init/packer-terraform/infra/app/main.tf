@@ -0,0 +1,23 @@
+terraform {
+  backend "gcs" {
+    bucket = "skaffold-tf-state"
+    prefix = "app"
+  }
+}
+
+data "terraform_remote_state" "vpc" {
+  backend = "gcs"
+  config = {
+    bucket = "skaffold-tf-state"
+    prefix = "network"
+  }
+}
+
+resource "google_compute_instance" "app" {
+  name         = "skaffold-app"
+  machine_type = "e2-small"
+
+  network_interface {
+    network = data.terraform_remote_state.vpc.outputs.network_id
+  }
+}
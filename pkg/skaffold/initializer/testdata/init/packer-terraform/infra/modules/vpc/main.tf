@@ -0,0 +1,7 @@
+resource "google_compute_network" "vpc" {
+  name = "skaffold"
+}
+
+output "network_id" {
+  value = google_compute_network.vpc.id
+}
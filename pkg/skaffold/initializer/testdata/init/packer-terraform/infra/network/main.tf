@@ -0,0 +1,14 @@
+terraform {
+  backend "gcs" {
+    bucket = "skaffold-tf-state"
+    prefix = "network"
+  }
+}
+
+module "vpc" {
+  source = "../modules/vpc"
+}
+
+output "network_id" {
+  value = module.vpc.network_id
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"context"
+	"io"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/inspect"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser"
+)
+
+type remoteDependencyList struct {
+	Dependencies []remoteDependencyEntry `json:"dependencies"`
+}
+
+type remoteDependencyEntry struct {
+	Kind        string           `json:"kind"`
+	Repo        string           `json:"repo,omitempty"`
+	Ref         string           `json:"ref,omitempty"`
+	Source      string           `json:"source,omitempty"`
+	Chart       string           `json:"chart,omitempty"`
+	Version     string           `json:"version,omitempty"`
+	Commit      string           `json:"commit,omitempty"`
+	Generations map[string]int64 `json:"generations,omitempty"`
+	Resolved    string           `json:"resolved,omitempty"`
+	Digest      string           `json:"digest,omitempty"`
+	Pinned      bool             `json:"pinned,omitempty"`
+	Credentials string           `json:"credentials,omitempty"`
+}
+
+// PrintConfigDependenciesList prints the remote config dependencies and remote Helm charts, with the commit, generations or chart version each of them is resolved to.
+func PrintConfigDependenciesList(ctx context.Context, out io.Writer, opts inspect.Options) error {
+	formatter := inspect.OutputFormatter(out, opts.OutFormat)
+	deps, err := inspect.GetRemoteDependencies(ctx, config.SkaffoldOptions{
+		ConfigurationFile: opts.Filename,
+		RemoteCacheDir:    opts.RemoteCacheDir,
+		Profiles:          opts.Profiles,
+		PropagateProfiles: opts.PropagateProfiles,
+	})
+	if err != nil {
+		formatter.WriteErr(err)
+		return err
+	}
+	return formatter.Write(newRemoteDependencyList(deps))
+}
+
+func newRemoteDependencyList(deps []parser.RemoteDependency) *remoteDependencyList {
+	l := &remoteDependencyList{Dependencies: []remoteDependencyEntry{}}
+	for _, d := range deps {
+		l.Dependencies = append(l.Dependencies, remoteDependencyEntry{
+			Kind:        d.Kind,
+			Repo:        d.Repo,
+			Ref:         d.Ref,
+			Source:      d.Source,
+			Chart:       d.Chart,
+			Version:     d.Version,
+			Commit:      d.Commit,
+			Generations: d.Generations,
+			Resolved:    d.Resolved,
+			Digest:      d.Digest,
+			Pinned:      d.Pinned,
+			Credentials: d.Credentials,
+		})
+	}
+	return l
+}
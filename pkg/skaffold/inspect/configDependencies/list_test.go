@@ -0,0 +1,76 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package inspect
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/inspect"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestPrintConfigDependenciesList(t *testing.T) {
+	tests := []struct {
+		description string
+		deps        []parser.RemoteDependency
+		err         error
+		expected    string
+	}{
+		{
+			description: "print remote dependencies",
+			deps: []parser.RemoteDependency{
+				{Kind: "git", Repo: "https://gitea.example.com/org/configs.git", Ref: "main", CacheDir: "/cache/a", Commit: "1111111111111111111111111111111111111111", Pinned: true, Credentials: "environment"},
+				{Kind: "googleCloudBuildRepoV2", Repo: "projects/p/locations/r/connections/c/repositories/repo", CacheDir: "/cache/b", Commit: "2222222222222222222222222222222222222222"},
+				{Kind: "googleCloudStorage", Source: "gs://bucket/configs/*", CacheDir: "/cache/c", Generations: map[string]int64{"configs/skaffold.yaml": 1700000000000000}, Pinned: true},
+				{Kind: "helmChart", Chart: "nginx", Repo: "https://charts.example.com", Version: "^15.0.0", Resolved: "15.1.2", Digest: "sha256:abcd"},
+			},
+			expected: `{"dependencies":[` +
+				`{"kind":"git","repo":"https://gitea.example.com/org/configs.git","ref":"main","commit":"1111111111111111111111111111111111111111","pinned":true,"credentials":"environment"},` +
+				`{"kind":"googleCloudBuildRepoV2","repo":"projects/p/locations/r/connections/c/repositories/repo","commit":"2222222222222222222222222222222222222222"},` +
+				`{"kind":"googleCloudStorage","source":"gs://bucket/configs/*","generations":{"configs/skaffold.yaml":1700000000000000},"pinned":true},` +
+				`{"kind":"helmChart","repo":"https://charts.example.com","chart":"nginx","version":"^15.0.0","resolved":"15.1.2","digest":"sha256:abcd"}]}` + "\n",
+		},
+		{
+			description: "no remote dependencies",
+			expected:    `{"dependencies":[]}` + "\n",
+		},
+		{
+			description: "generic error",
+			err:         errors.New("some error occurred"),
+			expected:    `{"errorCode":"INSPECT_UNKNOWN_ERR","errorMessage":"some error occurred"}` + "\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&inspect.GetRemoteDependencies, func(_ context.Context, opts config.SkaffoldOptions) ([]parser.RemoteDependency, error) {
+				t.CheckFalse(opts.UpdateLock)
+				t.CheckDeepEqual([]string{"p1"}, opts.Profiles)
+				t.CheckTrue(opts.PropagateProfiles)
+				return test.deps, test.err
+			})
+			var buf bytes.Buffer
+			err := PrintConfigDependenciesList(context.Background(), &buf, inspect.Options{OutFormat: "json", Profiles: []string{"p1"}, PropagateProfiles: true})
+			t.CheckError(test.err != nil, err)
+			t.CheckDeepEqual(test.expected, buf.String())
+		})
+	}
+}
@@ -109,8 +109,9 @@ type BuildEnvOptions struct {
 type BuildEnv string
 
 var (
-	GetConfigSet = parser.GetConfigSet
-	BuildEnvs    = struct {
+	GetConfigSet          = parser.GetConfigSet
+	GetRemoteDependencies = parser.GetRemoteDependencies
+	BuildEnvs             = struct {
 		Unspecified      BuildEnv
 		Local            BuildEnv
 		GoogleCloudBuild BuildEnv
@@ -19,11 +19,17 @@ package manifest
 import (
 	"bufio"
 	"bytes"
+	"encoding/json"
+	"fmt"
 	"io"
 	"regexp"
+	"strconv"
 	"strings"
 
+	yamlv3 "gopkg.in/yaml.v3"
 	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
 )
 
 // ManifestList is a list of yaml manifests.
@@ -63,6 +69,19 @@ func (ml ManifestListByConfig) ConfigNames() []string {
 	return ml.configNames
 }
 
+// SubstituteTemplateVars replaces the `{{.NAME}}` references to the given variables in the manifests of every config.
+func (ml ManifestListByConfig) SubstituteTemplateVars(vars map[string]string) (ManifestListByConfig, error) {
+	updated := NewManifestListByConfig()
+	for _, configName := range ml.configNames {
+		manifests, err := ml.manifests[configName].SubstituteTemplateVars(vars)
+		if err != nil {
+			return ManifestListByConfig{}, err
+		}
+		updated.Add(configName, manifests)
+	}
+	return updated, nil
+}
+
 func (ml ManifestListByConfig) String() string {
 	var manifests []string
 
@@ -173,6 +192,106 @@ func (l *ManifestList) Diff(latest ManifestList) ManifestList {
 	return updated
 }
 
+// templateVarRegex matches references to a single variable, like `{{.TF_network_subnet_id}}`.
+var templateVarRegex = regexp.MustCompile(`{{\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*}}`)
+
+// templateVarPlaceholder stands in for the i-th reference to a variable while a manifest is parsed.
+// It is a valid plain scalar, so that references can be anywhere, including in unquoted values.
+const templateVarPlaceholder = "skaffold-template-var-%d-placeholder"
+
+var templateVarPlaceholderRegex = regexp.MustCompile(`skaffold-template-var-(\d+)-placeholder`)
+
+// SubstituteTemplateVars replaces the `{{.NAME}}` references to the given variables.
+// Manifests aren't templates, so any other `{{ }}` expression is left untouched.
+// The values are substituted in the parsed manifests, so that they can't change their structure.
+func (l ManifestList) SubstituteTemplateVars(vars map[string]string) (ManifestList, error) {
+	if len(vars) == 0 {
+		return l, nil
+	}
+
+	var updated ManifestList
+	for _, manifest := range l {
+		substituted, err := substituteTemplateVars(manifest, vars)
+		if err != nil {
+			return nil, err
+		}
+		updated = append(updated, substituted)
+	}
+	return updated, nil
+}
+
+func substituteTemplateVars(manifest []byte, vars map[string]string) ([]byte, error) {
+	var refs, values []string
+	replaced := templateVarRegex.ReplaceAllFunc(manifest, func(ref []byte) []byte {
+		value, found := vars[string(templateVarRegex.FindSubmatch(ref)[1])]
+		if !found {
+			return ref
+		}
+		refs = append(refs, string(ref))
+		values = append(values, value)
+		return []byte(fmt.Sprintf(templateVarPlaceholder, len(refs)-1))
+	})
+	if len(refs) == 0 {
+		return manifest, nil
+	}
+
+	var doc yamlv3.Node
+	if err := yaml.Unmarshal(replaced, &doc); err != nil {
+		return nil, fmt.Errorf("parsing manifest to substitute template variables: %w", err)
+	}
+	if err := substituteTemplateVarNodes(&doc, values); err != nil {
+		return nil, err
+	}
+	substituted, err := yaml.Marshal(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// References in comments aren't substituted
+	return templateVarPlaceholderRegex.ReplaceAllFunc(substituted, func(placeholder []byte) []byte {
+		return []byte(refs[placeholderIndex(placeholder)])
+	}), nil
+}
+
+// substituteTemplateVarNodes replaces the placeholders in the scalars of a parsed manifest with the values they stand for.
+// A plain scalar made of a single placeholder takes JSON values, like numbers or lists, as YAML values. Every other value is a string.
+func substituteTemplateVarNodes(node *yamlv3.Node, values []string) error {
+	for _, child := range node.Content {
+		if err := substituteTemplateVarNodes(child, values); err != nil {
+			return err
+		}
+	}
+	if node.Kind != yamlv3.ScalarNode || !templateVarPlaceholderRegex.MatchString(node.Value) {
+		return nil
+	}
+
+	if match := templateVarPlaceholderRegex.FindString(node.Value); match == node.Value && node.Style == 0 {
+		value := values[placeholderIndex([]byte(match))]
+		if json.Valid([]byte(value)) && !strings.HasPrefix(value, `"`) {
+			var parsed yamlv3.Node
+			if err := yaml.Unmarshal([]byte(value), &parsed); err != nil {
+				return fmt.Errorf("parsing template variable value %q: %w", value, err)
+			}
+			*node = *parsed.Content[0]
+			if node.Kind != yamlv3.ScalarNode {
+				node.Style = yamlv3.FlowStyle
+			}
+			return nil
+		}
+	}
+
+	node.Value = templateVarPlaceholderRegex.ReplaceAllStringFunc(node.Value, func(placeholder string) string {
+		return values[placeholderIndex([]byte(placeholder))]
+	})
+	node.Tag = "!!str"
+	return nil
+}
+
+func placeholderIndex(placeholder []byte) int {
+	i, _ := strconv.Atoi(string(templateVarPlaceholderRegex.FindSubmatch(placeholder)[1]))
+	return i
+}
+
 // Reader returns a reader on the raw yaml descriptors.
 func (l *ManifestList) Reader() io.Reader {
 	return strings.NewReader(l.String())
@@ -163,6 +163,56 @@ func TestAppendServiceAndRoleBinding(t *testing.T) {
 	testutil.CheckDeepEqual(t, manifests.String(), roleBinding+"\n---\n"+service)
 }
 
+func TestSubstituteTemplateVars(t *testing.T) {
+	vars := map[string]string{
+		"TF_db_host":  "10.0.0.2",
+		"TF_db_port":  "5432",
+		"TF_db_ports": `[5432,5433]`,
+		"TF_db_url":   "postgres://db:5432/app # primary",
+		"TF_db_json":  `{"user": "app"}`,
+		"TF_db_cert":  "line1\nline2",
+	}
+	tests := []struct {
+		description string
+		manifest    string
+		expected    string
+	}{
+		{
+			description: "quoted value",
+			manifest:    `value: "{{.TF_db_host}}:{{ .TF_db_port }}"`,
+			expected:    "value: \"10.0.0.2:5432\"\n",
+		},
+		{
+			description: "unknown variables and expressions are left untouched",
+			manifest:    `value: "{{.UNKNOWN}} {{ printf "%s" .TF_db_host }}"`,
+			expected:    `value: "{{.UNKNOWN}} {{ printf "%s" .TF_db_host }}"`,
+		},
+		{
+			description: "JSON values",
+			manifest:    "port: {{.TF_db_port}}\nports: {{.TF_db_ports}}\n",
+			expected:    "port: 5432\nports: [5432, 5433]\n",
+		},
+		{
+			description: "strings with YAML syntax stay strings",
+			manifest:    "url: {{.TF_db_url}}\nconfig: {{.TF_db_json}}-suffix\ncert: {{.TF_db_cert}}\n",
+			expected:    "url: 'postgres://db:5432/app # primary'\nconfig: '{\"user\": \"app\"}-suffix'\ncert: |-\n  line1\n  line2\n",
+		},
+		{
+			description: "references in comments are kept",
+			manifest:    "# uses {{.TF_db_host}}\nhost: {{.TF_db_host}}\n",
+			expected:    "# uses {{.TF_db_host}}\nhost: 10.0.0.2\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			substituted, err := ManifestList{[]byte(test.manifest)}.SubstituteTemplateVars(vars)
+
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, string(substituted[0]))
+		})
+	}
+}
+
 func TestManifestListByConfigAdd(t *testing.T) {
 	tests := []struct {
 		description string
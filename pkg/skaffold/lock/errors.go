@@ -0,0 +1,40 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"fmt"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+)
+
+// OutOfDateErr returns error when a remote dependency isn't pinned by the lock file, or doesn't match its pin anymore.
+func OutOfDateErr(format string, a ...interface{}) error {
+	msg := fmt.Sprintf("%s is out of date: %s", FileName, fmt.Sprintf(format, a...))
+	return sErrors.NewError(fmt.Errorf(msg),
+		&proto.ActionableErr{
+			Message: msg,
+			ErrCode: proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR,
+			Suggestions: []*proto.Suggestion{
+				{
+					SuggestionCode: proto.SuggestionCode_CONFIG_UPDATE_LOCK_FILE,
+					Action:         "Run `skaffold lock` to pin new remote dependencies, or `skaffold lock --update-lock` to update all of them",
+				},
+			},
+		})
+}
@@ -0,0 +1,207 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+const (
+	// FileName is the name of the lock file, next to the root `skaffold.yaml`.
+	FileName = "skaffold.lock"
+
+	version = 1
+	header  = "# This file is generated by skaffold to pin remote dependencies. Do not edit.\n"
+)
+
+// File pins the remote dependencies of a skaffold project, so that they resolve to the same content on every run.
+type File struct {
+	Version            int         `yaml:"version"`
+	Git                []GitLock   `yaml:"git,omitempty"`
+	GoogleCloudStorage []GCSLock   `yaml:"googleCloudStorage,omitempty"`
+	HelmCharts         []ChartLock `yaml:"helmCharts,omitempty"`
+}
+
+// GitLock pins a ref of a git repository to a commit.
+type GitLock struct {
+	Repo   string `yaml:"repo"`
+	Ref    string `yaml:"ref,omitempty"`
+	Commit string `yaml:"commit"`
+}
+
+// GCSLock pins the Google Cloud Storage objects matched by a source to their generations.
+type GCSLock struct {
+	Source      string           `yaml:"source"`
+	Generations map[string]int64 `yaml:"generations"`
+}
+
+// ChartLock pins a remote Helm chart to a version and the digest of its archive.
+type ChartLock struct {
+	Chart string `yaml:"chart"`
+	Repo  string `yaml:"repo,omitempty"`
+	// Version is the version or version range set in the Helm release, empty for the latest version.
+	Version  string `yaml:"version,omitempty"`
+	Resolved string `yaml:"resolved"`
+	Digest   string `yaml:"digest"`
+}
+
+// Path returns the path of the lock file of a skaffold configuration file.
+func Path(configFile string) string {
+	return filepath.Join(filepath.Dir(configFile), FileName)
+}
+
+// Read reads a lock file. It returns nil when the file doesn't exist.
+func Read(file string) (*File, error) {
+	buf, err := os.ReadFile(file)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("reading lock file %s: %w", file, err)
+	}
+	f := &File{}
+	if err := yaml.UnmarshalStrict(buf, f); err != nil {
+		return nil, fmt.Errorf("parsing lock file %s: %w", file, err)
+	}
+	if f.Version != version {
+		return nil, fmt.Errorf("parsing lock file %s: unsupported version %d, expected %d", file, f.Version, version)
+	}
+	for _, g := range f.Git {
+		if g.Repo == "" || g.Commit == "" {
+			return nil, fmt.Errorf("parsing lock file %s: git entries require a repo and a commit", file)
+		}
+	}
+	for _, g := range f.GoogleCloudStorage {
+		if g.Source == "" || len(g.Generations) == 0 {
+			return nil, fmt.Errorf("parsing lock file %s: googleCloudStorage entries require a source and generations", file)
+		}
+	}
+	for _, c := range f.HelmCharts {
+		if c.Chart == "" || c.Resolved == "" || c.Digest == "" {
+			return nil, fmt.Errorf("parsing lock file %s: helmCharts entries require a chart, a resolved version and a digest", file)
+		}
+	}
+	return f, nil
+}
+
+// Write writes the lock file, with its entries sorted so that it's stable across runs.
+func (f *File) Write(file string) error {
+	f.Version = version
+	sort.Slice(f.Git, func(i, j int) bool {
+		if f.Git[i].Repo != f.Git[j].Repo {
+			return f.Git[i].Repo < f.Git[j].Repo
+		}
+		return f.Git[i].Ref < f.Git[j].Ref
+	})
+	sort.Slice(f.GoogleCloudStorage, func(i, j int) bool {
+		return f.GoogleCloudStorage[i].Source < f.GoogleCloudStorage[j].Source
+	})
+	sort.Slice(f.HelmCharts, func(i, j int) bool {
+		a, b := f.HelmCharts[i], f.HelmCharts[j]
+		if a.Chart != b.Chart {
+			return a.Chart < b.Chart
+		}
+		if a.Repo != b.Repo {
+			return a.Repo < b.Repo
+		}
+		return a.Version < b.Version
+	})
+	buf, err := yaml.Marshal(f)
+	if err != nil {
+		return fmt.Errorf("writing lock file %s: %w", file, err)
+	}
+	if err := os.WriteFile(file, append([]byte(header), buf...), 0644); err != nil {
+		return fmt.Errorf("writing lock file %s: %w", file, err)
+	}
+	return nil
+}
+
+// GitCommit returns the commit that a ref of a git repository is pinned to, or an empty string.
+func (f *File) GitCommit(repo, ref string) string {
+	if f == nil {
+		return ""
+	}
+	for _, g := range f.Git {
+		if g.Repo == repo && g.Ref == ref {
+			return g.Commit
+		}
+	}
+	return ""
+}
+
+// SetGitCommit pins a ref of a git repository to a commit.
+func (f *File) SetGitCommit(repo, ref, commit string) {
+	for i, g := range f.Git {
+		if g.Repo == repo && g.Ref == ref {
+			f.Git[i].Commit = commit
+			return
+		}
+	}
+	f.Git = append(f.Git, GitLock{Repo: repo, Ref: ref, Commit: commit})
+}
+
+// GCSGenerations returns the generations that the objects of a Google Cloud Storage source are pinned to, or nil.
+func (f *File) GCSGenerations(source string) map[string]int64 {
+	if f == nil {
+		return nil
+	}
+	for _, g := range f.GoogleCloudStorage {
+		if g.Source == source {
+			return g.Generations
+		}
+	}
+	return nil
+}
+
+// SetGCSGenerations pins the objects of a Google Cloud Storage source to their generations.
+func (f *File) SetGCSGenerations(source string, generations map[string]int64) {
+	for i, g := range f.GoogleCloudStorage {
+		if g.Source == source {
+			f.GoogleCloudStorage[i].Generations = generations
+			return
+		}
+	}
+	f.GoogleCloudStorage = append(f.GoogleCloudStorage, GCSLock{Source: source, Generations: generations})
+}
+
+// Chart returns the pin of a remote Helm chart at a version or version range, or nil.
+func (f *File) Chart(chart, repo, version string) *ChartLock {
+	if f == nil {
+		return nil
+	}
+	for i, c := range f.HelmCharts {
+		if c.Chart == chart && c.Repo == repo && c.Version == version {
+			return &f.HelmCharts[i]
+		}
+	}
+	return nil
+}
+
+// SetChart pins a remote Helm chart.
+func (f *File) SetChart(chart ChartLock) {
+	if c := f.Chart(chart.Chart, chart.Repo, chart.Version); c != nil {
+		*c = chart
+		return
+	}
+	f.HelmCharts = append(f.HelmCharts, chart)
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lock
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestReadWrite(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		file := Path(tmpDir.Path("skaffold.yaml"))
+		t.CheckDeepEqual(tmpDir.Path(FileName), file)
+
+		f, err := Read(file)
+		t.CheckNoError(err)
+		t.CheckNil(f)
+		t.CheckDeepEqual("", f.GitCommit("https://gitea.example.com/org/b.git", "main"))
+
+		f = &File{}
+		f.SetGitCommit("https://gitea.example.com/org/b.git", "main", "1111111111111111111111111111111111111111")
+		f.SetGitCommit("https://gitea.example.com/org/a.git", "", "2222222222222222222222222222222222222222")
+		f.SetGitCommit("https://gitea.example.com/org/b.git", "main", "3333333333333333333333333333333333333333")
+		t.CheckNoError(f.Write(file))
+		t.CheckFileExistAndContent(file, []byte(header+`version: 1
+git:
+  - repo: https://gitea.example.com/org/a.git
+    commit: "2222222222222222222222222222222222222222"
+  - repo: https://gitea.example.com/org/b.git
+    ref: main
+    commit: "3333333333333333333333333333333333333333"
+`))
+
+		read, err := Read(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(f, read)
+		t.CheckDeepEqual("3333333333333333333333333333333333333333", read.GitCommit("https://gitea.example.com/org/b.git", "main"))
+		t.CheckDeepEqual("", read.GitCommit("https://gitea.example.com/org/b.git", "dev"))
+	})
+}
+
+func TestReadWriteRemoteObjects(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := t.NewTempDir().Path(FileName)
+
+		var missing *File
+		t.CheckTrue(missing.GCSGenerations("gs://bucket/configs/*") == nil)
+		t.CheckNil(missing.Chart("nginx", "https://charts.example.com", ""))
+
+		f := &File{}
+		f.SetGCSGenerations("gs://bucket/configs/*", map[string]int64{"configs/skaffold.yaml": 1})
+		f.SetGCSGenerations("gs://bucket/configs/*", map[string]int64{"configs/skaffold.yaml": 2, "configs/base.yaml": 1})
+		f.SetChart(ChartLock{Chart: "redis", Repo: "oci://registry.example.com/charts", Resolved: "18.0.0", Digest: "sha256:bbbb"})
+		f.SetChart(ChartLock{Chart: "nginx", Repo: "https://charts.example.com", Version: "^15.0.0", Resolved: "15.1.2", Digest: "sha256:aaaa"})
+		f.SetChart(ChartLock{Chart: "nginx", Repo: "https://charts.example.com", Version: "^15.0.0", Resolved: "15.1.3", Digest: "sha256:cccc"})
+		t.CheckNoError(f.Write(file))
+		t.CheckFileExistAndContent(file, []byte(header+`version: 1
+googleCloudStorage:
+  - source: gs://bucket/configs/*
+    generations:
+      configs/base.yaml: 1
+      configs/skaffold.yaml: 2
+helmCharts:
+  - chart: nginx
+    repo: https://charts.example.com
+    version: ^15.0.0
+    resolved: 15.1.3
+    digest: sha256:cccc
+  - chart: redis
+    repo: oci://registry.example.com/charts
+    resolved: 18.0.0
+    digest: sha256:bbbb
+`))
+
+		read, err := Read(file)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(f, read)
+		t.CheckDeepEqual(map[string]int64{"configs/skaffold.yaml": 2, "configs/base.yaml": 1}, read.GCSGenerations("gs://bucket/configs/*"))
+		t.CheckTrue(read.GCSGenerations("gs://bucket/other/*") == nil)
+		t.CheckDeepEqual("15.1.3", read.Chart("nginx", "https://charts.example.com", "^15.0.0").Resolved)
+		t.CheckNil(read.Chart("nginx", "https://charts.example.com", ""))
+	})
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := []struct {
+		description string
+		content     string
+		expected    string
+	}{
+		{
+			description: "unknown field",
+			content:     "version: 1\nunknown: true\n",
+			expected:    "parsing lock file",
+		},
+		{
+			description: "unsupported version",
+			content:     "version: 2\n",
+			expected:    "unsupported version 2, expected 1",
+		},
+		{
+			description: "missing commit",
+			content:     "version: 1\ngit:\n- repo: https://gitea.example.com/org/a.git\n",
+			expected:    "git entries require a repo and a commit",
+		},
+		{
+			description: "missing generations",
+			content:     "version: 1\ngoogleCloudStorage:\n- source: gs://bucket/configs/*\n",
+			expected:    "googleCloudStorage entries require a source and generations",
+		},
+		{
+			description: "missing digest",
+			content:     "version: 1\nhelmCharts:\n- chart: nginx\n  resolved: 15.1.2\n",
+			expected:    "helmCharts entries require a chart, a resolved version and a digest",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			file := t.NewTempDir().Write(FileName, test.content).Path(FileName)
+
+			_, err := Read(file)
+			t.CheckErrorContains(test.expected, err)
+		})
+	}
+}
@@ -26,9 +26,11 @@ import (
 	"strings"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	skaffoldErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/gcbreposv2"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/gcs"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/git"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser/configlocations"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema"
@@ -39,6 +41,7 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/tags"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringslice"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
 )
 
 type configOpts struct {
@@ -61,8 +64,12 @@ type record struct {
 	appliedProfiles  map[string]string      // config -> list of applied profiles
 	configNameToFile map[string]string      // configName -> file path
 	cachedRepos      map[string]interface{} // git repo -> cache path or error
-	cachedObjects    map[string]interface{} // google cloud storage object -> cache path or error
+	cachedObjects    map[string]interface{} // google cloud storage object -> cached objects or error
 	allProfiles      []string               // list of all profiles, from all configuration files
+	lockFile         *lock.File             // pins of remote dependencies
+	enforceLock      bool                   // whether remote dependencies must be pinned when there's a lock file
+	resolveCharts    bool                   // whether remote Helm charts are pulled to resolve their version and digest
+	remotes          []RemoteDependency     // remote dependencies, in the order they're resolved
 }
 
 func newRecord() *record {
@@ -84,9 +91,19 @@ func GetAllConfigs(ctx context.Context, opts config.SkaffoldOptions) ([]schemaUt
 
 // GetConfigSet returns the list of all skaffold configurations parsed from the target config file in addition to all resolved dependency configs as a `SkaffoldConfigSet`.
 // This struct additionally contains the file location that each skaffold configuration is parsed from.
+// When a `skaffold.lock` file exists next to the target config file, remote dependencies and remote Helm charts are resolved to their pins,
+// and must all be pinned.
 func GetConfigSet(ctx context.Context, opts config.SkaffoldOptions) (SkaffoldConfigSet, error) {
-	cOpts := configOpts{file: opts.ConfigurationFile, selection: nil, profiles: opts.Profiles, isRequired: false, isDependency: false, isRemote: false}
 	r := newRecord()
+	r.enforceLock = true
+	return getConfigSet(ctx, opts, r)
+}
+
+func getConfigSet(ctx context.Context, opts config.SkaffoldOptions, r *record) (SkaffoldConfigSet, error) {
+	cOpts := configOpts{file: opts.ConfigurationFile, selection: nil, profiles: opts.Profiles, isRequired: false, isDependency: false, isRemote: false}
+	if err := readLockFile(opts, r); err != nil {
+		return nil, err
+	}
 	cfgs, fieldsOverrodeByProfile, err := getConfigs(ctx, cOpts, opts, r)
 	if err != nil {
 		return nil, err
@@ -102,6 +119,10 @@ func GetConfigSet(ctx context.Context, opts config.SkaffoldOptions) (SkaffoldCon
 		return nil, sErrors.ConfigProfilesNotMatchedErr(unmatched)
 	}
 
+	if err := pinCharts(ctx, cfgs, opts, r); err != nil {
+		return nil, err
+	}
+
 	for _, c := range cfgs {
 		yinfos, err := configlocations.Parse(c.SourceFile, c.SkaffoldConfig, fieldsOverrodeByProfile)
 		if err != nil {
@@ -278,6 +299,7 @@ func filterActiveProfiles(d latest.ConfigDependency, profiles []string) []string
 // processEachDependency parses a config dependency with the calculated set of activated profiles.
 func processEachDependency(ctx context.Context, d latest.ConfigDependency, cfgOpts configOpts, opts config.SkaffoldOptions, r *record) (SkaffoldConfigSet, error) {
 	var repoInfo *git.Config
+	var remote RemoteDependency
 	configFilePath := ""
 	path := makeConfigPathAbsolute(d.Path, cfgOpts.file)
 	isRemoteCfg := false
@@ -290,37 +312,49 @@ func processEachDependency(ctx context.Context, d latest.ConfigDependency, cfgOp
 			Ref:          d.GitRepo.Ref,
 			Sync:         d.GitRepo.Sync,
 		}
+		remote.Kind = "git"
 	}
 
 	if d.GoogleCloudBuildRepoV2 != nil {
-		repInf, err := gcbreposv2.GetRepoInfo(ctx, d.GoogleCloudBuildRepoV2.ProjectID, d.GoogleCloudBuildRepoV2.Region, d.GoogleCloudBuildRepoV2.Connection, d.GoogleCloudBuildRepoV2.Repo)
-		if err != nil {
-			return nil, sErrors.ConfigParsingError(fmt.Errorf("getting GCB repo info for %s: %w", d.GoogleCloudBuildRepoV2.Repo, err))
-		}
 		configFilePath = d.GoogleCloudBuildRepoV2.Path
-		repoInfo = &git.Config{
-			Repo:         repInf.URI,
-			RepoCloneURI: repInf.CloneURI,
-			Ref:          d.GoogleCloudBuildRepoV2.Ref,
-			Sync:         d.GoogleCloudBuildRepoV2.Sync,
-		}
+		repoInfo = gcbRepoConfig(*d.GoogleCloudBuildRepoV2)
+		remote.Kind = "googleCloudBuildRepoV2"
 	}
 
 	if repoInfo != nil {
-		cachePath, err := cacheRepo(ctx, *repoInfo, configFilePath, opts, r)
+		remote.Repo, remote.Ref = repoInfo.Repo, repoInfo.Ref
+		if err := r.pinRepo(repoInfo, &remote); err != nil {
+			return nil, err
+		}
+		repoDir, err := cacheRepo(ctx, *repoInfo, opts, r)
 		if err != nil {
+			if isReportedAsIs(err) {
+				return nil, err
+			}
 			return nil, sErrors.ConfigParsingError(fmt.Errorf("caching remote dependency %s: %w", repoInfo.Repo, err))
 		}
-		path = cachePath
+		remote.CacheDir = repoDir
+		r.addRemote(remote)
+		path = filepath.Join(repoDir, configFilePath)
 		isRemoteCfg = true
 	}
 
 	if d.GoogleCloudStorage != nil {
-		cachePath, err := cacheGCSObject(ctx, *d.GoogleCloudStorage, opts, r)
+		remote = RemoteDependency{Kind: "googleCloudStorage", Source: d.GoogleCloudStorage.Source}
+		generations, err := r.pinnedGenerations(&remote)
 		if err != nil {
+			return nil, err
+		}
+		objects, err := cacheGCSObject(ctx, *d.GoogleCloudStorage, generations, opts, r)
+		if err != nil {
+			if isReportedAsIs(err) {
+				return nil, err
+			}
 			return nil, sErrors.ConfigParsingError(fmt.Errorf("caching remote dependency %s: %w", d.GoogleCloudStorage.Path, err))
 		}
-		path = cachePath
+		remote.CacheDir, remote.Generations = objects.dir, objects.generations
+		r.addRemote(remote)
+		path = filepath.Join(objects.dir, d.GoogleCloudStorage.Path)
 		isRemoteCfg = true
 	}
 
@@ -354,13 +388,13 @@ func processEachDependency(ctx context.Context, d latest.ConfigDependency, cfgOp
 	return depConfigs, nil
 }
 
-// cacheRepo downloads the referenced git repository to skaffold's cache if required and returns the path to the target configuration file in that repository.
-func cacheRepo(ctx context.Context, g git.Config, configFilePath string, opts config.SkaffoldOptions, r *record) (string, error) {
+// cacheRepo downloads the referenced git repository to skaffold's cache if required and returns the path to the repository root directory.
+func cacheRepo(ctx context.Context, g git.Config, opts config.SkaffoldOptions, r *record) (string, error) {
 	key := fmt.Sprintf("%s@%s", g.Repo, g.Ref)
 	if p, found := r.cachedRepos[key]; found {
 		switch v := p.(type) {
 		case string:
-			return filepath.Join(v, configFilePath), nil
+			return v, nil
 		case error:
 			return "", v
 		default:
@@ -374,31 +408,67 @@ func cacheRepo(ctx context.Context, g git.Config, configFilePath string, opts co
 			return "", err
 		}
 		r.cachedRepos[key] = p
-		return filepath.Join(p, configFilePath), nil
+		return p, nil
+	}
+}
+
+// gcbRepoConfig returns the git config of a Cloud Build repository. Cloud Build repositories are cached by resource name,
+// and their URI and short-lived read token are only resolved when they're cloned or fetched, so that they can be used offline.
+func gcbRepoConfig(g latest.GoogleCloudBuildRepoV2Info) *git.Config {
+	return &git.Config{
+		Repo: gcbreposv2.Name(g.ProjectID, g.Region, g.Connection, g.Repo),
+		Ref:  g.Ref,
+		Sync: g.Sync,
+		ResolveCloneURI: func(ctx context.Context) (string, error) {
+			repo, err := gcbreposv2.GetRepoInfo(ctx, g.ProjectID, g.Region, g.Connection, g.Repo)
+			if err != nil {
+				return "", fmt.Errorf("getting GCB repo info for %s: %w", g.Repo, err)
+			}
+			return repo.CloneURI, nil
+		},
 	}
 }
 
-// cacheGCSObject downloads the referenced Google Cloud Storage object to skaffold's cache if required and returns the path to the target configuration file.
-func cacheGCSObject(ctx context.Context, g latest.GoogleCloudStorageInfo, opts config.SkaffoldOptions, r *record) (string, error) {
+// isReportedAsIs checks if a remote dependency couldn't be synced because its credentials are invalid or expired,
+// or because it doesn't match the lock file, which is reported as is to tell users how to fix it.
+func isReportedAsIs(err error) bool {
+	var e skaffoldErrors.Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	code := e.StatusCode()
+	return code == proto.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR || code == proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR
+}
+
+// gcsObjects are Google Cloud Storage objects cached in skaffold's cache.
+type gcsObjects struct {
+	dir         string
+	generations map[string]int64
+}
+
+// cacheGCSObject downloads the referenced Google Cloud Storage objects to skaffold's cache if required, at the given generations when they're set,
+// and returns the cached objects.
+func cacheGCSObject(ctx context.Context, g latest.GoogleCloudStorageInfo, generations map[string]int64, opts config.SkaffoldOptions, r *record) (gcsObjects, error) {
 	key := g.Source
 	if p, found := r.cachedObjects[key]; found {
 		switch v := p.(type) {
-		case string:
-			return filepath.Join(v, g.Path), nil
+		case gcsObjects:
+			return v, nil
 		case error:
-			return "", v
+			return gcsObjects{}, v
 		default:
 			log.Entry(context.TODO()).Fatalf("unable to check download status of Google Cloud Storage objects at %s", g.Source)
-			return "", nil
+			return gcsObjects{}, nil
 		}
 	}
-	p, err := gcs.SyncObjects(ctx, g, opts)
+	p, downloaded, err := gcs.SyncObjects(ctx, g, opts, generations)
 	if err != nil {
 		r.cachedObjects[key] = err
-		return "", err
+		return gcsObjects{}, err
 	}
-	r.cachedObjects[key] = p
-	return filepath.Join(p, g.Path), nil
+	objects := gcsObjects{dir: p, generations: downloaded}
+	r.cachedObjects[key] = objects
+	return objects, nil
 }
 
 // checkRevisit ensures that each config is activated with the same set of active profiles
@@ -20,13 +20,22 @@ package parser
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
 
 	kyaml "sigs.k8s.io/kustomize/kyaml/yaml"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/gcbreposv2"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/parser/configlocations"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
 	"github.com/ryanharper/skaffold/v2/testutil"
 )
 
@@ -1547,3 +1556,184 @@ func TestConfigLocationsLocate(t *testing.T) {
 		})
 	}
 }
+
+// gitRunner returns a function that runs git commands in a directory and returns their output.
+func gitRunner(t *testutil.T) func(dir string, args ...string) string {
+	return func(dir string, args ...string) string {
+		cmd := exec.Command("git", append([]string{"-c", "user.name=skaffold", "-c", "user.email=skaffold@example.com"}, args...)...)
+		cmd.Dir = dir
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v: %v: %s", args, err, out)
+		}
+		return strings.TrimSpace(string(out))
+	}
+}
+
+func TestGetAllConfigsFromGCBRepoV2(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		git := gitRunner(t)
+		commit := func(name string) string {
+			tmpDir.Write("src/remote/skaffold.yaml", fmt.Sprintf("apiVersion: %s\nkind: Config\nmetadata:\n  name: %s\n", latest.Version, name))
+			git(tmpDir.Path("src"), "add", "-A")
+			git(tmpDir.Path("src"), "commit", "-q", "-m", name)
+			return git(tmpDir.Path("src"), "rev-parse", "HEAD")
+		}
+
+		tmpDir.Mkdir("src")
+		git(tmpDir.Path("src"), "init", "-q", "-b", "main")
+		first := commit("remote-v1")
+		git(tmpDir.Root(), "clone", "-q", "--bare", "src", "repo.git")
+
+		requires := func(ref string) {
+			tmpDir.Write("skaffold.yaml", fmt.Sprintf(`apiVersion: %s
+kind: Config
+metadata:
+  name: main
+requires:
+  - googleCloudBuildRepoV2:
+      projectID: my-project
+      region: us-central1
+      connection: my-connection
+      repo: my-repo
+      path: remote/skaffold.yaml
+      ref: %s
+`, latest.Version, ref))
+		}
+		names := func(syncRemoteCache string, client gcbreposv2.LocalClient) ([]string, error) {
+			t.Override(&gcbreposv2.RepositoryManagerClient, func(context.Context) (gcbreposv2.Client, error) { return client, nil })
+			sync := &config.SyncRemoteCacheOption{}
+			_ = sync.Set(syncRemoteCache)
+			cfgs, err := GetAllConfigs(context.Background(), config.SkaffoldOptions{
+				ConfigurationFile: tmpDir.Path("skaffold.yaml"),
+				RemoteCacheDir:    tmpDir.Path("cache"),
+				SyncRemoteCache:   *sync,
+			})
+			var names []string
+			for _, cfg := range cfgs {
+				names = append(names, cfg.(*latest.SkaffoldConfig).Metadata.Name)
+			}
+			return names, err
+		}
+		client := gcbreposv2.LocalClient{Repos: map[string]string{"my-repo": tmpDir.Path("repo.git")}}
+		expired := gcbreposv2.LocalClient{Repos: client.Repos, TokenExpired: true}
+
+		// the branch is cloned, then synced
+		requires("main")
+		found, err := names("always", client)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"remote-v1", "main"}, found)
+
+		commit("remote-v2")
+		git(tmpDir.Path("src"), "push", "-q", tmpDir.Path("repo.git"), "main")
+		found, err = names("always", client)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"remote-v2", "main"}, found)
+
+		// cached repositories are used offline, but can't be synced with expired credentials
+		found, err = names("missing", expired)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"remote-v2", "main"}, found)
+
+		_, err = names("always", expired)
+		var actionable sErrors.Error
+		t.CheckTrue(errors.As(err, &actionable))
+		t.CheckDeepEqual(proto.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR, actionable.StatusCode())
+
+		// a commit is cloned once, then used without credentials
+		requires(first)
+		found, err = names("always", client)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"remote-v1", "main"}, found)
+
+		found, err = names("always", expired)
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"remote-v1", "main"}, found)
+	})
+}
+
+func TestGetRemoteDependenciesWithLockFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		git := gitRunner(t)
+		commit := func(name string) string {
+			tmpDir.Write("src/remote/skaffold.yaml", fmt.Sprintf("apiVersion: %s\nkind: Config\nmetadata:\n  name: %s\n", latest.Version, name))
+			git(tmpDir.Path("src"), "add", "-A")
+			git(tmpDir.Path("src"), "commit", "-q", "-m", name)
+			return git(tmpDir.Path("src"), "rev-parse", "HEAD")
+		}
+
+		tmpDir.Mkdir("src")
+		git(tmpDir.Path("src"), "init", "-q", "-b", "main")
+		first := commit("remote-v1")
+		git(tmpDir.Root(), "clone", "-q", "--bare", "src", "repo.git")
+		repo := tmpDir.Path("repo.git")
+		tmpDir.Write("skaffold.yaml", fmt.Sprintf(`apiVersion: %s
+kind: Config
+metadata:
+  name: main
+requires:
+  - git:
+      repo: %s
+      path: remote/skaffold.yaml
+      ref: main
+`, latest.Version, repo))
+
+		opts := func(updateLock bool) config.SkaffoldOptions {
+			sync := &config.SyncRemoteCacheOption{}
+			_ = sync.Set("always")
+			return config.SkaffoldOptions{
+				ConfigurationFile: tmpDir.Path("skaffold.yaml"),
+				RemoteCacheDir:    tmpDir.Path("cache"),
+				SyncRemoteCache:   *sync,
+				UpdateLock:        updateLock,
+			}
+		}
+		names := func(updateLock bool) []string {
+			cfgs, err := GetAllConfigs(context.Background(), opts(updateLock))
+			t.CheckNoError(err)
+			var names []string
+			for _, cfg := range cfgs {
+				names = append(names, cfg.(*latest.SkaffoldConfig).Metadata.Name)
+			}
+			return names
+		}
+
+		deps, err := GetRemoteDependencies(context.Background(), opts(false))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(deps))
+		t.CheckDeepEqual(RemoteDependency{Kind: "git", Repo: repo, Ref: "main", CacheDir: deps[0].CacheDir, Commit: first}, deps[0])
+
+		// the lock file pins the branch to its commit, until the lock file is updated
+		f := &lock.File{}
+		f.SetGitCommit(repo, "main", first)
+		t.CheckNoError(f.Write(tmpDir.Path(lock.FileName)))
+
+		second := commit("remote-v2")
+		git(tmpDir.Path("src"), "push", "-q", repo, "main")
+		t.CheckDeepEqual([]string{"remote-v1", "main"}, names(false))
+
+		deps, err = GetRemoteDependencies(context.Background(), opts(false))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(RemoteDependency{Kind: "git", Repo: repo, Ref: "main", CacheDir: deps[0].CacheDir, Commit: first, Pinned: true}, deps[0])
+
+		t.CheckDeepEqual([]string{"remote-v2", "main"}, names(true))
+		deps, err = GetRemoteDependencies(context.Background(), opts(true))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(second, deps[0].Commit)
+
+		// invalid lock files fail parsing
+		tmpDir.Write(lock.FileName, "version: 1\ngit:\n- repo: "+repo+"\n")
+		_, err = GetAllConfigs(context.Background(), opts(false))
+		t.CheckErrorContains("git entries require a repo and a commit", err)
+	})
+}
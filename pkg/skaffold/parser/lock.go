@@ -0,0 +1,274 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/git"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/helm"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+// GetRemoteDependencies returns the remote dependencies and remote Helm charts of the target config file, with the commits,
+// generations and chart versions they're resolved to. Pins of the lock file are used, but aren't required.
+func GetRemoteDependencies(ctx context.Context, opts config.SkaffoldOptions) ([]RemoteDependency, error) {
+	r := newRecord()
+	r.resolveCharts = true
+	if _, err := getConfigSet(ctx, opts, r); err != nil {
+		return nil, err
+	}
+	for i, d := range r.remotes {
+		if d.Kind != "git" && d.Kind != "googleCloudBuildRepoV2" {
+			continue
+		}
+		commit, err := git.Commit(ctx, d.CacheDir)
+		if err != nil {
+			return nil, err
+		}
+		r.remotes[i].Commit = commit
+		if d.Kind == "git" {
+			if r.remotes[i].Credentials, err = git.CredentialSource(ctx, d.Repo); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.remotes, nil
+}
+
+// UpdateLockFile pins the remote dependencies and remote Helm charts of the target config file in its lock file.
+// Existing pins are kept, unless they're updated with `opts.UpdateLock`.
+func UpdateLockFile(ctx context.Context, opts config.SkaffoldOptions) ([]RemoteDependency, error) {
+	if opts.ConfigurationFile == "-" || util.IsURL(opts.ConfigurationFile) {
+		return nil, fmt.Errorf("%s can only be written next to a local config file", lock.FileName)
+	}
+	deps, err := GetRemoteDependencies(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &lock.File{}
+	for i, d := range deps {
+		switch d.Kind {
+		case "googleCloudStorage":
+			f.SetGCSGenerations(d.Source, d.Generations)
+		case "helmChart":
+			f.SetChart(lock.ChartLock{Chart: d.Chart, Repo: d.Repo, Version: d.Version, Resolved: d.Resolved, Digest: d.Digest})
+		default:
+			f.SetGitCommit(d.Repo, d.Ref, d.Commit)
+		}
+		deps[i].Pinned = true
+	}
+	if err := f.Write(lock.Path(opts.ConfigurationFile)); err != nil {
+		return nil, err
+	}
+	return deps, nil
+}
+
+// readLockFile reads the lock file next to the target config file, unless the lock file is being updated.
+func readLockFile(opts config.SkaffoldOptions, r *record) error {
+	if opts.UpdateLock || opts.ConfigurationFile == "-" || util.IsURL(opts.ConfigurationFile) {
+		return nil
+	}
+	f, err := lock.Read(lock.Path(opts.ConfigurationFile))
+	if err != nil {
+		return sErrors.ConfigParsingError(err)
+	}
+	r.lockFile = f
+	return nil
+}
+
+// mustPin checks if remote dependencies that aren't pinned by the lock file are rejected.
+func (r *record) mustPin() bool {
+	return r.enforceLock && r.lockFile != nil
+}
+
+// pinRepo sets the ref of a git repository to the commit it's pinned to.
+func (r *record) pinRepo(g *git.Config, d *RemoteDependency) error {
+	if commit := r.lockFile.GitCommit(g.Repo, g.Ref); commit != "" {
+		g.Ref = commit
+		d.Pinned = true
+		return nil
+	}
+	if r.mustPin() {
+		return lock.OutOfDateErr("git repository %q at ref %q isn't pinned", g.Repo, g.Ref)
+	}
+	return nil
+}
+
+// pinnedGenerations returns the generations that Google Cloud Storage objects are pinned to.
+func (r *record) pinnedGenerations(d *RemoteDependency) (map[string]int64, error) {
+	if generations := r.lockFile.GCSGenerations(d.Source); generations != nil {
+		d.Pinned = true
+		return generations, nil
+	}
+	if r.mustPin() {
+		return nil, lock.OutOfDateErr("Google Cloud Storage objects %q aren't pinned", d.Source)
+	}
+	return nil, nil
+}
+
+// addRemote records a remote dependency, once.
+func (r *record) addRemote(d RemoteDependency) {
+	if r.findRemote(d) == nil {
+		r.remotes = append(r.remotes, d)
+	}
+}
+
+func (r *record) findRemote(d RemoteDependency) *RemoteDependency {
+	for i, e := range r.remotes {
+		if e.Kind == d.Kind && e.Repo == d.Repo && e.Ref == d.Ref && e.Source == d.Source && e.Chart == d.Chart && e.Version == d.Version {
+			return &r.remotes[i]
+		}
+	}
+	return nil
+}
+
+// pinCharts deploys remote Helm charts from the archive of the version they're pinned to, after checking that the digest of the archive didn't change.
+// Without a verified archive, charts are deployed from their remote at the pinned version.
+// Charts with templated fields are resolved at deploy time, so they aren't pinned.
+func pinCharts(ctx context.Context, cfgs SkaffoldConfigSet, opts config.SkaffoldOptions, r *record) error {
+	for _, cfg := range cfgs {
+		for _, release := range remoteChartReleases(cfg.SkaffoldConfig) {
+			d := RemoteDependency{Kind: "helmChart", Chart: release.RemoteChart, Repo: release.Repo, Version: release.Version}
+			if pin := r.lockFile.Chart(release.RemoteChart, release.Repo, release.Version); pin != nil {
+				archive, err := verifyChart(ctx, *pin, opts)
+				if err != nil {
+					return err
+				}
+				d.Resolved, d.Digest, d.Pinned = pin.Resolved, pin.Digest, true
+				r.addRemote(d)
+				if archive == "" {
+					release.Version = pin.Resolved
+					continue
+				}
+				// the archive is a packaged chart with its dependencies, and remote charts aren't upgraded unless configured to.
+				release.ChartPath, release.RemoteChart, release.Repo, release.Version = archive, "", "", ""
+				release.SkipBuildDependencies = true
+				if release.UpgradeOnChange == nil {
+					release.UpgradeOnChange = util.Ptr(false)
+				}
+				continue
+			}
+			if r.mustPin() {
+				return lock.OutOfDateErr("remote Helm chart %q at version %q isn't pinned", release.RemoteChart, release.Version)
+			}
+			if r.resolveCharts && r.findRemote(d) == nil {
+				if err := resolveChart(ctx, &d); err != nil {
+					return err
+				}
+				r.addRemote(d)
+			}
+		}
+	}
+	return nil
+}
+
+// remoteChartReleases returns the Helm releases of remote charts that can be pinned.
+func remoteChartReleases(cfg *latest.SkaffoldConfig) []*latest.HelmRelease {
+	var releases []*latest.HelmRelease
+	add := func(rs []latest.HelmRelease) {
+		for i, rel := range rs {
+			if rel.RemoteChart != "" && !strings.Contains(rel.RemoteChart+rel.Repo+rel.Version, "{{") {
+				releases = append(releases, &rs[i])
+			}
+		}
+	}
+	if cfg.Render.Helm != nil {
+		add(cfg.Render.Helm.Releases)
+	}
+	if cfg.Deploy.LegacyHelmDeploy != nil {
+		add(cfg.Deploy.LegacyHelmDeploy.Releases)
+	}
+	return releases
+}
+
+// resolveChart pulls a remote Helm chart to resolve its version and digest.
+func resolveChart(ctx context.Context, d *RemoteDependency) error {
+	dir, err := os.MkdirTemp("", "skaffold-chart")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	c, err := helm.PullChart(ctx, d.Chart, d.Repo, d.Version, dir)
+	if err != nil {
+		return sErrors.ConfigParsingError(fmt.Errorf("resolving remote Helm chart: %w", err))
+	}
+	d.Resolved, d.Digest = c.Version, c.Digest
+	return nil
+}
+
+// verifyChart checks that the archive of a pinned Helm chart still has the same digest, and returns the path to the verified archive.
+// Verified archives are kept in skaffold's cache, so charts are only pulled again when their pin changes.
+// It returns an empty path when the archive isn't verified because remote cache sync is disabled.
+func verifyChart(ctx context.Context, pin lock.ChartLock, opts config.SkaffoldOptions) (string, error) {
+	remoteCacheDir, err := config.GetRemoteCacheDir(opts)
+	if err != nil {
+		return "", sErrors.ConfigParsingError(fmt.Errorf("failed determining remote cache directory: %w", err))
+	}
+	hash, err := chartCacheDir(pin)
+	if err != nil {
+		return "", sErrors.ConfigParsingError(err)
+	}
+	dir := filepath.Join(remoteCacheDir, hash)
+	if c, err := helm.ReadChart(dir); err == nil && c.Digest == pin.Digest {
+		return c.Path, nil
+	}
+	if opts.SyncRemoteCache.CloneDisabled() {
+		log.Entry(ctx).Warnf("Skipping digest verification of remote Helm chart %q version %s, since remote cache sync is disabled", pin.Chart, pin.Resolved)
+		return "", nil
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return "", sErrors.ConfigParsingError(err)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", sErrors.ConfigParsingError(fmt.Errorf("failed creating remote Helm chart cache directory: %w", err))
+	}
+	c, err := helm.PullChart(ctx, pin.Chart, pin.Repo, pin.Resolved, dir)
+	if err != nil {
+		return "", sErrors.ConfigParsingError(fmt.Errorf("verifying remote Helm chart: %w", err))
+	}
+	if c.Digest != pin.Digest {
+		os.RemoveAll(dir)
+		return "", lock.OutOfDateErr("the digest of remote Helm chart %q version %s changed from %s to %s", pin.Chart, pin.Resolved, pin.Digest, c.Digest)
+	}
+	return c.Path, nil
+}
+
+// chartCacheDir returns the cache directory name of a pinned Helm chart.
+func chartCacheDir(pin lock.ChartLock) (string, error) {
+	inputs := []string{pin.Chart, pin.Repo, pin.Resolved}
+	hasher := sha256.New()
+	if err := json.NewEncoder(hasher).Encode(inputs); err != nil {
+		return "", err
+	}
+	return base64.URLEncoding.EncodeToString(hasher.Sum(nil))[:32], nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package parser
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/config"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	helmutil "github.com/ryanharper/skaffold/v2/pkg/skaffold/helm"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/lock"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+// fakeHelm serves a chart archive to `helm pull`, and runs other commands.
+type fakeHelm struct {
+	util.Command
+	archive []byte
+	pulls   int
+}
+
+func (f *fakeHelm) RunCmdOut(ctx context.Context, cmd *exec.Cmd) ([]byte, error) {
+	if filepath.Base(cmd.Path) != "helm" {
+		return f.Command.RunCmdOut(ctx, cmd)
+	}
+	f.pulls++
+	dst := cmd.Args[len(cmd.Args)-1]
+	return nil, os.WriteFile(filepath.Join(dst, "nginx.tgz"), f.archive, 0644)
+}
+
+func chartArchive(t *testutil.T, version, values string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range map[string]string{"nginx/Chart.yaml": "name: nginx\nversion: " + version, "nginx/values.yaml": values} {
+		t.CheckNoError(tw.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content))}))
+		_, err := tw.Write([]byte(content))
+		t.CheckNoError(err)
+	}
+	t.CheckNoError(tw.Close())
+	t.CheckNoError(gz.Close())
+	return buf.Bytes()
+}
+
+func TestLockFile(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git isn't installed")
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		git := gitRunner(t)
+		tmpDir.Write("src/remote/skaffold.yaml", fmt.Sprintf("apiVersion: %s\nkind: Config\nmetadata:\n  name: remote\n", latest.Version))
+		git(tmpDir.Root(), "init", "-q", "-b", "main", "src")
+		git(tmpDir.Path("src"), "add", "-A")
+		git(tmpDir.Path("src"), "commit", "-q", "-m", "remote")
+		commit := git(tmpDir.Path("src"), "rev-parse", "HEAD")
+		git(tmpDir.Root(), "clone", "-q", "--bare", "src", "repo.git")
+		repo := tmpDir.Path("repo.git")
+		tmpDir.Write("skaffold.yaml", fmt.Sprintf(`apiVersion: %s
+kind: Config
+metadata:
+  name: main
+requires:
+  - git:
+      repo: %s
+      path: remote/skaffold.yaml
+      ref: main
+manifests:
+  helm:
+    releases:
+      - name: web
+        remoteChart: nginx
+        repo: https://charts.example.com
+        version: ^15.0.0
+`, latest.Version, repo))
+
+		helm := &fakeHelm{Command: util.DefaultExecCommand, archive: chartArchive(t, "15.1.2", "replicas: 1")}
+		t.Override(&util.DefaultExecCommand, helm)
+		opts := func(updateLock bool) config.SkaffoldOptions {
+			sync := &config.SyncRemoteCacheOption{}
+			_ = sync.Set("always")
+			return config.SkaffoldOptions{
+				ConfigurationFile: tmpDir.Path("skaffold.yaml"),
+				RemoteCacheDir:    tmpDir.Path("cache"),
+				SyncRemoteCache:   *sync,
+				UpdateLock:        updateLock,
+			}
+		}
+		checkCode := func(err error, code proto.StatusCode) {
+			var sErr sErrors.Error
+			if !errors.As(err, &sErr) {
+				t.Fatalf("expected an actionable error, got %v", err)
+			}
+			t.CheckDeepEqual(code, sErr.StatusCode())
+		}
+		chartRelease := func() latest.HelmRelease {
+			cfgs, err := GetAllConfigs(context.Background(), opts(false))
+			t.CheckNoError(err)
+			return cfgs[1].(*latest.SkaffoldConfig).Render.Helm.Releases[0]
+		}
+		pinnedDigest := func() string {
+			f, err := lock.Read(tmpDir.Path(lock.FileName))
+			t.CheckNoError(err)
+			return f.Chart("nginx", "https://charts.example.com", "^15.0.0").Digest
+		}
+		checkArchive := func(r latest.HelmRelease) {
+			t.CheckDeepEqual("", r.RemoteChart)
+			t.CheckDeepEqual("", r.Version)
+			t.CheckTrue(strings.HasPrefix(r.ChartPath, tmpDir.Path("cache")))
+			t.CheckTrue(r.SkipBuildDependencies)
+			c, err := helmutil.ReadChart(filepath.Dir(r.ChartPath))
+			t.CheckNoError(err)
+			t.CheckDeepEqual(pinnedDigest(), c.Digest)
+		}
+
+		// without a lock file, remote dependencies aren't pinned
+		t.CheckDeepEqual("^15.0.0", chartRelease().Version)
+		t.CheckDeepEqual(0, helm.pulls)
+
+		deps, err := UpdateLockFile(context.Background(), opts(false))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(deps))
+		f, err := lock.Read(tmpDir.Path(lock.FileName))
+		t.CheckNoError(err)
+		t.CheckDeepEqual(commit, f.GitCommit(repo, "main"))
+		pin := f.Chart("nginx", "https://charts.example.com", "^15.0.0")
+		t.CheckDeepEqual("15.1.2", pin.Resolved)
+
+		// pinned charts are deployed from their verified archive, which is cached
+		checkArchive(chartRelease())
+		checkArchive(chartRelease())
+		t.CheckDeepEqual(2, helm.pulls)
+
+		// a chart archive that changed under the same version is rejected
+		t.CheckNoError(os.RemoveAll(tmpDir.Path("cache")))
+		helm.archive = chartArchive(t, "15.1.2", "replicas: 2")
+		_, err = GetAllConfigs(context.Background(), opts(false))
+		t.CheckErrorContains("digest of remote Helm chart", err)
+		checkCode(err, proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR)
+
+		_, err = UpdateLockFile(context.Background(), opts(true))
+		t.CheckNoError(err)
+		checkArchive(chartRelease())
+
+		// remote dependencies missing from the lock file are rejected
+		f = &lock.File{}
+		f.SetGitCommit(repo, "main", commit)
+		t.CheckNoError(f.Write(tmpDir.Path(lock.FileName)))
+		_, err = GetAllConfigs(context.Background(), opts(false))
+		t.CheckErrorContains(`remote Helm chart "nginx" at version "^15.0.0" isn't pinned`, err)
+		checkCode(err, proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR)
+
+		f = &lock.File{}
+		f.SetChart(*pin)
+		t.CheckNoError(f.Write(tmpDir.Path(lock.FileName)))
+		_, err = GetAllConfigs(context.Background(), opts(false))
+		t.CheckErrorContains(fmt.Sprintf("git repository %q at ref \"main\" isn't pinned", repo), err)
+		checkCode(err, proto.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR)
+	})
+}
@@ -77,3 +77,33 @@ func (s SkaffoldConfigSet) LocateElement(obj interface{}, idx int) *configlocati
 	}
 	return loc
 }
+
+// RemoteDependency is a remote config dependency or a remote Helm chart, resolved to skaffold's cache.
+type RemoteDependency struct {
+	// Kind is `git`, `googleCloudBuildRepoV2`, `googleCloudStorage` or `helmChart`.
+	Kind string
+	// Repo is the URI of a git repository, the resource name of a Cloud Build repository, or the repository of a Helm chart.
+	Repo string
+	// Ref is the ref set in the config dependency, empty for the default branch.
+	Ref string
+	// Source is the source of Google Cloud Storage objects.
+	Source string
+	// Chart is the name of a remote Helm chart.
+	Chart string
+	// Version is the version or version range set in the Helm release, empty for the latest version.
+	Version string
+	// CacheDir is the directory of the dependency in skaffold's cache.
+	CacheDir string
+	// Commit is the commit checked out in the cache directory of a repository.
+	Commit string
+	// Generations are the generations of the cached Google Cloud Storage objects, by object name.
+	Generations map[string]int64
+	// Resolved is the resolved version of a Helm chart.
+	Resolved string
+	// Digest is the digest of the archive of a Helm chart.
+	Digest string
+	// Pinned is true when the dependency is pinned by the lock file.
+	Pinned bool
+	// Credentials is the source of the credentials of git repositories, empty for anonymous access.
+	Credentials string
+}
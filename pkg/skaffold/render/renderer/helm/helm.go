@@ -55,6 +55,7 @@ type Helm struct {
 	overrideProtocols []string
 
 	manifestOverrides  map[string]string
+	templateVars       *sUtil.TemplateVars
 	transformAllowlist map[apimachinery.GroupKind]latest.ResourceFilter
 	transformDenylist  map[apimachinery.GroupKind]latest.ResourceFilter
 }
@@ -71,7 +72,7 @@ func (h Helm) ManifestOverrides() map[string]string {
 	return h.manifestOverrides
 }
 
-func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string, configName string, manifestOverrides map[string]string) (Helm, error) {
+func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string, configName string, manifestOverrides map[string]string, templateVars *sUtil.TemplateVars) (Helm, error) {
 	generator := generate.NewGenerator(cfg.GetWorkingDir(), rCfg.Generate, "")
 	transformAllowlist, transformDenylist, err := util.ConsolidateTransformConfiguration(cfg)
 	if err != nil {
@@ -90,6 +91,7 @@ func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string,
 		labels:            labels,
 		namespace:         cfg.GetKubeNamespace(),
 		manifestOverrides: manifestOverrides,
+		templateVars:      templateVars,
 
 		transformAllowlist: transformAllowlist,
 		transformDenylist:  transformDenylist,
@@ -159,7 +161,7 @@ func (h Helm) generateHelmManifest(ctx context.Context, builds []graph.Artifact,
 		args = append(args, "--version", release.Version)
 	}
 
-	args, err = helm.ConstructOverrideArgs(&release, builds, args, h.manifestOverrides)
+	args, err = helm.ConstructOverrideArgs(&release, builds, h.templateVars.Values(), args, h.manifestOverrides)
 	if err != nil {
 		return nil, helm.UserErr("construct override args", err)
 	}
@@ -53,12 +53,13 @@ type Kpt struct {
 	injectNs          bool
 	pkgDir            []string
 	manifestOverrides map[string]string
+	templateVars      *util.TemplateVars
 
 	transformAllowlist map[apimachinery.GroupKind]latest.ResourceFilter
 	transformDenylist  map[apimachinery.GroupKind]latest.ResourceFilter
 }
 
-func New(cfg render.Config, rCfg latest.RenderConfig, hydrationDir string, labels map[string]string, configName string, ns string, manifestOverrides map[string]string, injectNs bool) (*Kpt, error) {
+func New(cfg render.Config, rCfg latest.RenderConfig, hydrationDir string, labels map[string]string, configName string, ns string, manifestOverrides map[string]string, injectNs bool, templateVars *util.TemplateVars) (*Kpt, error) {
 	transformAllowlist, transformDenylist, err := rUtil.ConsolidateTransformConfiguration(cfg)
 	if err != nil {
 		return nil, err
@@ -92,6 +93,7 @@ func New(cfg render.Config, rCfg latest.RenderConfig, hydrationDir string, label
 		configName:         configName,
 		pkgDir:             rCfg.Kpt,
 		manifestOverrides:  manifestOverrides,
+		templateVars:       templateVars,
 		Validator:          validator,
 		Transformer:        transformer,
 		hydrationDir:       hydrationDir,
@@ -135,7 +137,13 @@ func (r *Kpt) Render(ctx context.Context, out io.Writer, builds []graph.Artifact
 		}
 	}
 
-	manifestList, err := r.Transformer.Transform(ctx, manifestList)
+	// resolve references to values produced earlier in the run, like Terraform outputs
+	manifestList, err := manifestList.SubstituteTemplateVars(r.templateVars.Values())
+	if err != nil {
+		return manifest.ManifestListByConfig{}, err
+	}
+
+	manifestList, err = r.Transformer.Transform(ctx, manifestList)
 
 	if err != nil {
 		return manifest.ManifestListByConfig{}, err
@@ -49,6 +49,7 @@ type Kubectl struct {
 	Generator          generate.Generator
 	labels             map[string]string
 	manifestOverrides  map[string]string
+	templateVars       *util.TemplateVars
 	transformer        transform.Transformer
 	applySetters       applysetters.ApplySetters
 	validator          validate.Validator
@@ -56,7 +57,7 @@ type Kubectl struct {
 	transformDenylist  map[apimachinery.GroupKind]latest.ResourceFilter
 }
 
-func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string, configName string, ns string, manifestOverrides map[string]string, injectNs bool) (Kubectl, error) {
+func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string, configName string, ns string, manifestOverrides map[string]string, injectNs bool, templateVars *util.TemplateVars) (Kubectl, error) {
 	transformAllowlist, transformDenylist, err := rUtil.ConsolidateTransformConfiguration(cfg)
 	generator := generate.NewGenerator(cfg.GetWorkingDir(), rCfg.Generate, "")
 	if err != nil {
@@ -95,6 +96,7 @@ func New(cfg render.Config, rCfg latest.RenderConfig, labels map[string]string,
 		Generator:          generator,
 		labels:             labels,
 		manifestOverrides:  manifestOverrides,
+		templateVars:       templateVars,
 		validator:          validator,
 		transformer:        transformer,
 		applySetters:       ass,
@@ -114,6 +116,11 @@ func (r Kubectl) Render(ctx context.Context, out io.Writer, builds []graph.Artif
 	if err != nil {
 		return manifest.ManifestListByConfig{}, err
 	}
+	// resolve references to values produced earlier in the run, like Terraform outputs
+	manifests, err = manifests.SubstituteTemplateVars(r.templateVars.Values())
+	if err != nil {
+		return manifest.ManifestListByConfig{}, err
+	}
 
 	manifests, err = r.transformer.Transform(ctx, manifests)
 
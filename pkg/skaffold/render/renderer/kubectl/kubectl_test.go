@@ -117,7 +117,7 @@ func TestRender(t *testing.T) {
 				WorkingDir: tmpDirObj.Root(),
 			}
 			injectNs := test.namespaceFlag != ""
-			r, err := New(mockCfg, test.renderConfig, test.labels, "default", test.namespaceFlag, nil, injectNs)
+			r, err := New(mockCfg, test.renderConfig, test.labels, "default", test.namespaceFlag, nil, injectNs, nil)
 			t.CheckNoError(err)
 			var b bytes.Buffer
 			manifestList, errR := r.Render(context.Background(), &b, []graph.Artifact{{ImageName: "leeroy-web", Tag: "leeroy-web:v1"}},
@@ -182,7 +182,7 @@ func TestDependencies(t *testing.T) {
 			rCfg := latest.RenderConfig{
 				Generate: latest.Generate{RawK8s: test.manifests},
 			}
-			r, err := New(mockCfg, rCfg, map[string]string{}, "default", "", nil, false)
+			r, err := New(mockCfg, rCfg, map[string]string{}, "default", "", nil, false, nil)
 			t.CheckNoError(err)
 
 			dependencies, err := r.ManifestDeps()
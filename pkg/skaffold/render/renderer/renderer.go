@@ -29,6 +29,7 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/render/renderer/kubectl"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/render/renderer/kustomize"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 )
 
 type Renderer interface {
@@ -39,12 +40,13 @@ type Renderer interface {
 }
 
 // New creates a new Renderer object from the latestV2 API schema.
-func New(ctx context.Context, cfg render.Config, renderCfg latest.RenderConfig, hydrationDir string, labels map[string]string, configName string, manifestOverrides map[string]string) (GroupRenderer, error) {
+// The manifests can reference the given template variables, like Terraform outputs.
+func New(ctx context.Context, cfg render.Config, renderCfg latest.RenderConfig, hydrationDir string, labels map[string]string, configName string, manifestOverrides map[string]string, templateVars *util.TemplateVars) (GroupRenderer, error) {
 	var rs GroupRenderer
 	injectNs := cfg.GetKubeNamespace() != ""
 
 	if renderCfg.Kpt != nil {
-		r, err := kpt.New(cfg, renderCfg, hydrationDir, labels, configName, cfg.GetNamespace(), manifestOverrides, injectNs)
+		r, err := kpt.New(cfg, renderCfg, hydrationDir, labels, configName, cfg.GetNamespace(), manifestOverrides, injectNs, templateVars)
 		if err != nil {
 			return GroupRenderer{}, err
 		}
@@ -53,7 +55,7 @@ func New(ctx context.Context, cfg render.Config, renderCfg latest.RenderConfig,
 	}
 
 	if renderCfg.RawK8s != nil || renderCfg.RemoteManifests != nil {
-		r, err := kubectl.New(cfg, renderCfg, labels, configName, cfg.GetNamespace(), manifestOverrides, injectNs)
+		r, err := kubectl.New(cfg, renderCfg, labels, configName, cfg.GetNamespace(), manifestOverrides, injectNs, templateVars)
 		if err != nil {
 			return GroupRenderer{}, err
 		}
@@ -68,7 +70,7 @@ func New(ctx context.Context, cfg render.Config, renderCfg latest.RenderConfig,
 	}
 
 	if renderCfg.Helm != nil {
-		r, err := helm.New(cfg, renderCfg, labels, configName, manifestOverrides)
+		r, err := helm.New(cfg, renderCfg, labels, configName, manifestOverrides, templateVars)
 		if err != nil {
 			return GroupRenderer{}, err
 		}
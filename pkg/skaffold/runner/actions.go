@@ -30,6 +30,7 @@ import (
 
 func (r *SkaffoldRunner) Exec(ctx context.Context, out io.Writer, artifacts []graph.Artifact, action string) error {
 	out, ctx = output.WithEventContext(ctx, out, constants.Exec, constants.SubtaskIDNone)
+	artifacts = graph.ContainerImages(artifacts)
 
 	if len(artifacts) > 0 {
 		output.Default.Fprintln(out, "Tags used in execution:")
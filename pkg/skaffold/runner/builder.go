@@ -28,8 +28,10 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/hooks"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/platform"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/runner/runcontext"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/tag"
 )
 
 // builderCtx encapsulates a given skaffold run context along with additional builder constructs.
@@ -110,6 +112,19 @@ func (b *pipelineBuilderWithHooks) PostBuild(ctx context.Context, out io.Writer)
 	return nil
 }
 
+// SupportsMultiPlatformBuild returns true if the wrapped builder builds the artifact for multiple platforms at once.
+func (b *pipelineBuilderWithHooks) SupportsMultiPlatformBuild(a *latest.Artifact) bool {
+	mb, ok := b.PipelineBuilder.(build.MultiPlatformBuilder)
+	return ok && mb.SupportsMultiPlatformBuild(a)
+}
+
+// PrepareBatch forwards the artifacts of the current build to the wrapped builder if it builds artifacts together.
+func (b *pipelineBuilderWithHooks) PrepareBatch(ctx context.Context, artifacts []*latest.Artifact, building []*latest.Artifact, tags tag.ImageTags, platforms platform.Resolver) {
+	if bb, ok := b.PipelineBuilder.(build.BatchBuilder); ok {
+		bb.PrepareBatch(ctx, artifacts, building, tags, platforms)
+	}
+}
+
 func withPipelineBuildHooks(pb build.PipelineBuilder, buildHooks latest.BuildHooks) build.PipelineBuilder {
 	return &pipelineBuilderWithHooks{
 		PipelineBuilder: pb,
@@ -74,11 +74,15 @@ func (r *SkaffoldRunner) Deploy(ctx context.Context, out io.Writer, artifacts []
 
 	for _, artifact := range artifacts {
 		output.Default.Fprintf(out, " - %s -> ", artifact.ImageName)
-		fmt.Fprintln(out, artifact.Tag)
+		if artifact.MachineImage != "" {
+			fmt.Fprintln(out, artifact.MachineImage)
+		} else {
+			fmt.Fprintln(out, artifact.Tag)
+		}
 	}
 
 	var localImages []graph.Artifact
-	for _, a := range artifacts {
+	for _, a := range graph.ContainerImages(artifacts) {
 		if isLocal, err := r.isLocalImage(a.ImageName); err != nil {
 			return err
 		} else if isLocal {
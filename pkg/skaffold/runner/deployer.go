@@ -151,7 +151,7 @@ func GetDeployer(ctx context.Context, runCtx *runcontext.RunContext, labeller *l
 
 		if d.TerraformDeploy != nil {
 			//log.Entry(ctx).Infof("Creating Terraform deployer for configs for INLOOP %s", d)
-			t, err := terraform.NewDeployer(*d.TerraformDeploy, configName)
+			t, err := terraform.NewDeployer(runCtx, d.TerraformDeploy, configName)
 			if err != nil {
 				return nil, fmt.Errorf("creating terraform deployer: %w", err)
 			}
@@ -161,7 +161,7 @@ func GetDeployer(ctx context.Context, runCtx *runcontext.RunContext, labeller *l
 
 		if d.DockerDeploy != nil {
 			localDeploy = true
-			d, err := docker.NewDeployer(ctx, runCtx, labeller, d.DockerDeploy, runCtx.PortForwardResources(), configName)
+			d, err := docker.NewDeployer(ctx, runCtx, labeller, d.DockerDeploy, runCtx.PortForwardResources(), configName, runCtx.ConfigDir(configName))
 			if err != nil {
 				return nil, err
 			}
@@ -226,7 +226,16 @@ func GetDeployer(ctx context.Context, runCtx *runcontext.RunContext, labeller *l
 		return nil, errors.New("docker deployment not supported alongside cluster deployments")
 	}
 
-	return deploy.NewDeployerMux(deployers, runCtx.IterativeStatusCheck()), nil
+	if runCtx.DryRun() && runCtx.Opts.Command == "deploy" {
+		// Only deployers that can preview their changes honour --dry-run, the others would apply for real.
+		for _, d := range deployers {
+			if _, ok := d.(deploy.Planner); !ok {
+				return nil, fmt.Errorf("--dry-run is only supported by the terraform deployer, but config %q uses another deployer", d.ConfigName())
+			}
+		}
+	}
+
+	return deploy.NewDeployerMux(deployers, runCtx.IterativeStatusCheck(), runCtx.TemplateVars()), nil
 }
 
 /*
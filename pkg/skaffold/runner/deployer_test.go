@@ -56,6 +56,7 @@ func TestGetDeployer(tOuter *testing.T) {
 			helmVersion       string
 			expected          deploy.Deployer
 			apply             bool
+			dryRun            bool
 			shouldErr         bool
 			deepCheckDeployer bool
 		}{
@@ -73,7 +74,7 @@ func TestGetDeployer(tOuter *testing.T) {
 					},
 				},
 				helmVersion: `version.BuildInfo{Version:"v3.1.0"}`,
-				expected:    deploy.NewDeployerMux([]deploy.Deployer{&helm.Deployer{}}, false),
+				expected:    deploy.NewDeployerMux([]deploy.Deployer{&helm.Deployer{}}, false, nil),
 			},
 			{
 				description: "helm deployer with less than 3.0.0 version",
@@ -104,7 +105,7 @@ func TestGetDeployer(tOuter *testing.T) {
 					}, &label.DefaultLabeller{}, &latest.KubectlDeploy{
 						Flags: latest.KubectlFlags{},
 					}, nil, "default", nil)).(deploy.Deployer),
-				}, false),
+				}, false, nil),
 			},
 			{
 				description: "kpt deployer",
@@ -115,7 +116,7 @@ func TestGetDeployer(tOuter *testing.T) {
 				},
 				expected: deploy.NewDeployerMux([]deploy.Deployer{
 					&kptV2.Deployer{},
-				}, false),
+				}, false, nil),
 			},
 			{
 				description: "cloud run deployer",
@@ -129,7 +130,7 @@ func TestGetDeployer(tOuter *testing.T) {
 				expected: deploy.NewDeployerMux(
 					[]deploy.Deployer{
 						t.RequireNonNilResult(cloudrun.NewDeployer(&runcontext.RunContext{}, &label.DefaultLabeller{}, &latest.CloudRunDeploy{}, "default")).(deploy.Deployer)},
-					false),
+					false, nil),
 			},
 			{
 				description: "apply forces creation of kubectl deployer with kpt config",
@@ -184,7 +185,7 @@ func TestGetDeployer(tOuter *testing.T) {
 				expected: deploy.NewDeployerMux([]deploy.Deployer{
 					&helm.Deployer{},
 					&kubectl.Deployer{},
-				}, false),
+				}, false, nil),
 			},
 			{
 				description: "multiple deployers with kpt",
@@ -290,6 +291,16 @@ func TestGetDeployer(tOuter *testing.T) {
 				},
 				shouldErr: true,
 			},
+			{
+				description: "deploy --dry-run is not supported by kubectl deployer",
+				dryRun:      true,
+				cfg: latest.Pipeline{
+					Deploy: latest.DeployConfig{
+						DeployType: latest.DeployType{KubectlDeploy: &latest.KubectlDeploy{}},
+					},
+				},
+				shouldErr: true,
+			},
 		}
 		for _, test := range tests {
 			testutil.Run(tOuter, test.description, func(t *testutil.T) {
@@ -302,7 +313,9 @@ func TestGetDeployer(tOuter *testing.T) {
 
 				deployer, err := GetDeployer(context.Background(), &runcontext.RunContext{
 					Opts: config.SkaffoldOptions{
-						Apply: test.apply,
+						Apply:   test.apply,
+						DryRun:  test.dryRun,
+						Command: "deploy",
 					},
 					Pipelines: runcontext.NewPipelines(
 						map[string]latest.Pipeline{
@@ -49,3 +49,10 @@ func (w withNotification) Deploy(ctx context.Context, out io.Writer, builds []gr
 	}
 	return err
 }
+
+func (w withNotification) Plan(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	if planner, ok := w.Deployer.(deploy.Planner); ok {
+		return planner.Plan(ctx, out, builds)
+	}
+	return nil
+}
@@ -20,10 +20,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
 	"time"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/build"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/deploy"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
 	eventV2 "github.com/ryanharper/skaffold/v2/pkg/skaffold/event/v2"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
@@ -45,6 +47,9 @@ func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []gra
 		// Fetch the digest and append it to the tag with the format of "tag@digest"
 		if r.runCtx.DigestSource() == constants.RemoteDigestSource {
 			for i, a := range builds {
+				if a.MachineImage != "" {
+					continue
+				}
 				// remote digest to platform dependant build not supported
 				digest, err := docker.RemoteDigest(a.Tag, r.runCtx, nil)
 				if err != nil {
@@ -60,7 +65,21 @@ func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []gra
 	}
 
 	ctx, endTrace := instrumentation.StartTrace(ctx, "Render")
-	manifestList, err := r.renderer.Render(ctx, renderOut, builds, offline)
+	if r.runCtx.RenderOnly() {
+		// Deployers that can preview their changes, like Terraform, stop after computing the plan.
+		// Planning first lets the manifests reference the outputs known at plan time.
+		// The plan goes to stderr so that it never ends up in the rendered manifests.
+		if planner, ok := r.deployer.(deploy.Planner); ok {
+			if err := planner.Plan(ctx, os.Stderr, builds); err != nil {
+				eventV2.TaskFailed(constants.Render, err)
+				endTrace(instrumentation.TraceEndError(err))
+				return manifest.ManifestListByConfig{}, err
+			}
+		}
+	}
+
+	// Machine images, like the AMIs built by Packer, can only be referenced by deployers, not by manifests.
+	manifestList, err := r.renderer.Render(ctx, renderOut, graph.ContainerImages(builds), offline)
 	if err != nil {
 		eventV2.TaskFailed(constants.Render, err)
 		endTrace(instrumentation.TraceEndError(err))
@@ -47,7 +47,7 @@ func GetRenderer(ctx context.Context, runCtx *runcontext.RunContext, hydrationDi
 			mkvMap[k] = overridesMap[k]
 		}
 
-		rs, err := renderer.New(ctx, runCtx, p.Render, hydrationDir, labels, configName, mkvMap)
+		rs, err := renderer.New(ctx, runCtx, p.Render, hydrationDir, labels, configName, mkvMap, runCtx.TemplateVars())
 		if err != nil {
 			return nil, err
 		}
@@ -71,7 +71,7 @@ func GetRenderer(ctx context.Context, runCtx *runcontext.RunContext, hydrationDi
 					},
 				},
 			}
-			r, err := helm.New(runCtx, rCfg, labels, configName, nil)
+			r, err := helm.New(runCtx, rCfg, labels, configName, nil, runCtx.TemplateVars())
 			if err != nil {
 				return nil, err
 			}
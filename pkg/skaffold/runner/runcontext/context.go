@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 
 	"github.com/google/uuid"
@@ -43,6 +44,11 @@ type RunContext struct {
 	InsecureRegistries map[string]bool
 	Cluster            config.Cluster
 	RunID              string
+
+	// configDirs are the directories of the Skaffold configs, by config name.
+	configDirs map[string]string
+	// templateVars are the values produced during the run, like Terraform outputs, that manifests and templates can reference.
+	templateVars *util.TemplateVars
 }
 
 // Pipelines encapsulates multiple config pipelines
@@ -277,6 +283,22 @@ func (rc *RunContext) GetPipelines() []latest.Pipeline        { return rc.Pipeli
 func (rc *RunContext) GetInsecureRegistries() map[string]bool { return rc.InsecureRegistries }
 func (rc *RunContext) GetWorkingDir() string                  { return rc.WorkingDir }
 func (rc *RunContext) GetCluster() config.Cluster             { return rc.Cluster }
+
+// ConfigDir returns the directory of the Skaffold config with the given name, or the working directory when it isn't known.
+func (rc *RunContext) ConfigDir(configName string) string {
+	if dir, found := rc.configDirs[configName]; found {
+		return dir
+	}
+	return rc.WorkingDir
+}
+
+// SetConfigFile records the file of the Skaffold config with the given name, whose directory is returned by ConfigDir.
+func (rc *RunContext) SetConfigFile(configName, file string) {
+	if file != "" {
+		rc.configDirs[getConfigName(configName)] = filepath.Dir(file)
+	}
+}
+
 func (rc *RunContext) GetNamespace() string {
 	if rc.Opts.Namespace != "" {
 		return rc.Opts.Namespace
@@ -314,6 +336,8 @@ func (rc *RunContext) AutoSync() bool                                { return rc
 func (rc *RunContext) ContainerDebugging() bool                      { return rc.Opts.ContainerDebugging }
 func (rc *RunContext) CacheArtifacts() bool                          { return rc.Opts.CacheArtifacts }
 func (rc *RunContext) CacheFile() string                             { return rc.Opts.CacheFile }
+func (rc *RunContext) ExplainCache() bool                            { return rc.Opts.ExplainCache }
+func (rc *RunContext) SharedCaches() []string                        { return rc.Opts.SharedCaches }
 func (rc *RunContext) ConfigurationFile() string                     { return rc.Opts.ConfigurationFile }
 func (rc *RunContext) CustomLabels() []string                        { return rc.Opts.CustomLabels }
 func (rc *RunContext) CustomTag() string                             { return rc.Opts.CustomTag }
@@ -346,9 +370,11 @@ func (rc *RunContext) Trigger() string                               { return rc
 func (rc *RunContext) WaitForDeletions() config.WaitForDeletions     { return rc.Opts.WaitForDeletions }
 func (rc *RunContext) WatchPollInterval() int                        { return rc.Opts.WatchPollInterval }
 func (rc *RunContext) BuildConcurrency() int                         { return rc.Opts.BuildConcurrency }
+func (rc *RunContext) BuildConcurrencyPools() []string               { return rc.Opts.BuildConcurrencyPools }
 func (rc *RunContext) IsMultiConfig() bool                           { return rc.Pipelines.IsMultiPipeline() }
 func (rc *RunContext) IsDefaultKubeContext() bool                    { return rc.Opts.KubeContext == "" }
 func (rc *RunContext) GetRunID() string                              { return rc.RunID }
+func (rc *RunContext) TemplateVars() *util.TemplateVars              { return rc.templateVars }
 func (rc *RunContext) RPCPort() *int                                 { return rc.Opts.RPCPort.Value() }
 func (rc *RunContext) RPCHTTPPort() *int                             { return rc.Opts.RPCHTTPPort.Value() }
 func (rc *RunContext) PushImages() config.BoolOrUndefined            { return rc.Opts.PushImages }
@@ -451,5 +477,7 @@ func GetRunContext(ctx context.Context, opts config.SkaffoldOptions, configs []s
 		InsecureRegistries: insecureRegistries,
 		Cluster:            cluster,
 		RunID:              runID,
+		configDirs:         map[string]string{},
+		templateVars:       util.NewTemplateVars(),
 	}, nil
 }
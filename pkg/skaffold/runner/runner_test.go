@@ -531,7 +531,7 @@ func TestNewForConfig(t *testing.T) {
 			expectedTester: &test.FullTester{},
 			expectedDeployer: deploy.NewDeployerMux([]deploy.Deployer{
 				&kubectl.Deployer{},
-			}, false),
+			}, false, nil),
 		},
 	}
 	for _, tt := range tests {
@@ -30,7 +30,7 @@ func (r *SkaffoldRunner) Test(ctx context.Context, out io.Writer, artifacts []gr
 	eventV2.TaskInProgress(constants.Test, "Test")
 	out, ctx = output.WithEventContext(ctx, out, constants.Test, constants.SubtaskIDNone)
 
-	if err := r.tester.Test(ctx, out, artifacts); err != nil {
+	if err := r.tester.Test(ctx, out, graph.ContainerImages(artifacts)); err != nil {
 		eventV2.TaskFailed(constants.Test, err)
 		return err
 	}
@@ -68,9 +68,22 @@ func (w withTimings) Build(ctx context.Context, out io.Writer, tags tag.ImageTag
 		return nil, err
 	}
 	log.Entry(ctx).Infoln("Build completed in", timeutil.Humanize(time.Since(start)))
+	if tb, ok := w.Builder.(timelineBuilder); ok {
+		if lines := tb.Timeline().Lines(); len(lines) > 1 {
+			log.Entry(ctx).Infoln("Build timeline:")
+			for _, line := range lines {
+				log.Entry(ctx).Infoln(" ", line)
+			}
+		}
+	}
 	return bRes, nil
 }
 
+// timelineBuilder is implemented by builders that record when each artifact build started and ended.
+type timelineBuilder interface {
+	Timeline() build.Timeline
+}
+
 func (w withTimings) Test(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
 	start := time.Now()
 	output.Default.Fprintln(out, "Starting test...")
@@ -107,6 +120,21 @@ func (w withTimings) Deploy(ctx context.Context, out io.Writer, builds []graph.A
 	return err
 }
 
+func (w withTimings) Plan(ctx context.Context, out io.Writer, builds []graph.Artifact) error {
+	planner, ok := w.Deployer.(deploy.Planner)
+	if !ok {
+		return nil
+	}
+	start := time.Now()
+	log.Entry(ctx).Infoln("Starting plan...")
+
+	if err := planner.Plan(ctx, out, builds); err != nil {
+		return err
+	}
+	log.Entry(ctx).Infoln("Plan completed in", timeutil.Humanize(time.Since(start)))
+	return nil
+}
+
 func (w withTimings) Cleanup(ctx context.Context, out io.Writer, dryRun bool, config manifest.ManifestListByConfig) error {
 	start := time.Now()
 	output.Default.Fprintln(out, "Cleaning up...")
@@ -22,6 +22,7 @@ import (
 	"errors"
 	"io"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	logrustest "github.com/sirupsen/logrus/hooks/test"
@@ -144,6 +145,32 @@ func TestTimingsBuild(t *testing.T) {
 	}
 }
 
+type mockTimelineBuilder struct {
+	mockBuilder
+	timeline build.Timeline
+}
+
+func (m *mockTimelineBuilder) Timeline() build.Timeline { return m.timeline }
+
+func TestTimingsBuildTimeline(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		hook := &logrustest.Hook{}
+		log.AddHook(hook)
+
+		start := time.Now()
+		b := &mockTimelineBuilder{timeline: build.Timeline{
+			{ImageName: "base", Builder: "jib", Start: start, End: start.Add(2 * time.Second)},
+			{ImageName: "app", Builder: "docker", Start: start.Add(2 * time.Second), End: start.Add(3 * time.Second)},
+		}}
+		builder, _, _, _ := WithTimings(b, nil, nil, nil, false)
+
+		_, err := builder.Build(context.Background(), io.Discard, nil, platform.Resolver{}, nil)
+
+		t.CheckNoError(err)
+		t.CheckMatches(`^  app +docker +\| +=+\|  1 second$`, hook.LastEntry().Message)
+	})
+}
+
 func TestTimingsPrune(t *testing.T) {
 	tests := []struct {
 		description  string
@@ -53,6 +53,7 @@ func (r *SkaffoldRunner) VerifyAndLog(ctx context.Context, out io.Writer, artifa
 
 func (r *SkaffoldRunner) Verify(ctx context.Context, out io.Writer, artifacts []graph.Artifact) error {
 	defer r.verifier.GetStatusMonitor().Reset()
+	artifacts = graph.ContainerImages(artifacts)
 
 	out, ctx = output.WithEventContext(ctx, out, constants.Verify, constants.SubtaskIDNone)
 
@@ -39,6 +39,7 @@ const (
 	defaultCloudBuildKanikoImage = kaniko.DefaultImage
 	defaultCloudBuildPackImage   = "gcr.io/k8s-skaffold/pack"
 	defaultCloudBuildKoImage     = "gcr.io/k8s-skaffold/skaffold"
+	defaultBuildKitImage         = "moby/buildkit:v0.13.2-rootless"
 )
 
 // Set makes sure default values are set on a SkaffoldConfig.
@@ -51,7 +52,7 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultWorkspace(a)
 		setDefaultSync(a)
 
-		if c.Build.Cluster != nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil {
+		if c.Build.Cluster != nil && c.Build.Cluster.BuildKit == nil && a.CustomArtifact == nil && a.BuildpackArtifact == nil && a.PackerArtifact == nil {
 			defaultToKanikoArtifact(a)
 		} else {
 			defaultToDockerArtifact(a)
@@ -90,6 +91,7 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultCloudBuildKanikoImage,
 		setDefaultCloudBuildPackImage,
 		setDefaultCloudBuildKoImage,
+		setDefaultCloudBuildPackerImage,
 	)
 
 	if err := withClusterConfig(c,
@@ -97,6 +99,8 @@ func Set(c *latest.SkaffoldConfig) error {
 		setDefaultClusterTimeout,
 		setDefaultClusterPullSecret,
 		setDefaultClusterDockerConfigSecret,
+		setDefaultClusterPackerImage,
+		setDefaultClusterBuildKitImage,
 	); err != nil {
 		return err
 	}
@@ -209,6 +213,10 @@ func setDefaultCloudBuildKoImage(gcb *latest.GoogleCloudBuild) {
 	gcb.KoImage = valueOrDefault(gcb.KoImage, defaultCloudBuildKoImage)
 }
 
+func setDefaultCloudBuildPackerImage(gcb *latest.GoogleCloudBuild) {
+	gcb.PackerImage = valueOrDefault(gcb.PackerImage, constants.DefaultPackerImage)
+}
+
 func setDefaultTagger(c *latest.SkaffoldConfig) {
 	if c.Build.TagPolicy != (latest.TagPolicy{}) {
 		return
@@ -306,6 +314,18 @@ func setDefaultClusterTimeout(cluster *latest.ClusterDetails) error {
 	return nil
 }
 
+func setDefaultClusterPackerImage(cluster *latest.ClusterDetails) error {
+	cluster.PackerImage = valueOrDefault(cluster.PackerImage, constants.DefaultPackerImage)
+	return nil
+}
+
+func setDefaultClusterBuildKitImage(cluster *latest.ClusterDetails) error {
+	if cluster.BuildKit != nil {
+		cluster.BuildKit.Image = valueOrDefault(cluster.BuildKit.Image, defaultBuildKitImage)
+	}
+	return nil
+}
+
 func setDefaultClusterPullSecret(cluster *latest.ClusterDetails) error {
 	cluster.PullSecretMountPath = valueOrDefault(cluster.PullSecretMountPath, kaniko.DefaultSecretMountPath)
 	if cluster.PullSecretPath != "" {
@@ -287,6 +287,35 @@ func TestCustomBuildWithCluster(t *testing.T) {
 	testutil.CheckDeepEqual(t, (*latest.KanikoArtifact)(nil), cfg.Build.Artifacts[0].KanikoArtifact)
 }
 
+func TestBuildKitWithCluster(t *testing.T) {
+	cfg := &latest.SkaffoldConfig{
+		Pipeline: latest.Pipeline{
+			Build: latest.BuildConfig{
+				Artifacts: []*latest.Artifact{
+					{ImageName: "untyped"},
+					{
+						ImageName: "kaniko",
+						ArtifactType: latest.ArtifactType{
+							KanikoArtifact: &latest.KanikoArtifact{},
+						},
+					},
+				},
+				BuildType: latest.BuildType{
+					Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}},
+				},
+			},
+		},
+	}
+
+	err := Set(cfg)
+
+	testutil.CheckError(t, false, err)
+	testutil.CheckDeepEqual(t, defaultBuildKitImage, cfg.Build.Cluster.BuildKit.Image)
+	testutil.CheckDeepEqual(t, &latest.DockerArtifact{DockerfilePath: "Dockerfile"}, cfg.Build.Artifacts[0].DockerArtifact)
+	testutil.CheckDeepEqual(t, (*latest.KanikoArtifact)(nil), cfg.Build.Artifacts[0].KanikoArtifact)
+	testutil.CheckDeepEqual(t, true, cfg.Build.Artifacts[1].KanikoArtifact != nil)
+}
+
 func TestSetDefaultsOnCloudBuild(t *testing.T) {
 	cfg := &latest.SkaffoldConfig{
 		Pipeline: latest.Pipeline{
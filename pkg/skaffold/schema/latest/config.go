@@ -374,6 +374,10 @@ type LocalBuild struct {
 	// UseBuildkit use BuildKit to build Docker images. If unspecified, uses the Docker default.
 	UseBuildkit *bool `yaml:"useBuildkit,omitempty"`
 
+	// UseBuildxBake builds all the `docker` artifacts of a build with a single `docker buildx bake` invocation,
+	// so that stages shared between Dockerfiles are only built once.
+	UseBuildxBake bool `yaml:"useBuildxBake,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `1`.
 	Concurrency *int `yaml:"concurrency,omitempty"`
@@ -450,6 +454,12 @@ type GoogleCloudBuild struct {
 	// Defaults to `gcr.io/k8s-skaffold/skaffold`.
 	KoImage string `yaml:"koImage,omitempty"`
 
+	// PackerImage is the image that runs a Packer build.
+	// The image must contain Packer, and the Docker CLI for templates that build Docker images.
+	// See [Cloud Builders](https://cloud.google.com/cloud-build/docs/cloud-builders).
+	// Defaults to `hashicorp/packer:light`, which doesn't have the Docker CLI and only builds machine images.
+	PackerImage string `yaml:"packerImage,omitempty"`
+
 	// Concurrency is how many artifacts can be built concurrently. 0 means "no-limit".
 	// Defaults to `0`.
 	Concurrency int `yaml:"concurrency,omitempty"`
@@ -582,6 +592,7 @@ type ClusterDetails struct {
 	// PullSecretName is the name of the Kubernetes secret for pulling base images
 	// and pushing the final image. If given, the secret needs to contain the Google Cloud
 	// service account secret key under the key `kaniko-secret`.
+	// It only applies to Kaniko builds: BuildKit builds use `dockerConfig` instead.
 	// Defaults to `kaniko-secret`.
 	PullSecretName string `yaml:"pullSecretName,omitempty"`
 
@@ -632,6 +643,35 @@ type ClusterDetails struct {
 
 	// RandomDockerConfigSecret adds a random UUID postfix to the default name of the docker secret to facilitate parallel builds, e.g. docker-cfgfd154022-c761-416f-8eb3-cf8258450b85.
 	RandomDockerConfigSecret bool `yaml:"randomDockerConfigSecret,omitempty"`
+
+	// PackerImage is the image of the pod that runs the builds of Packer artifacts.
+	// The pod has no Docker daemon, so only templates that build machine images, like AMIs, are supported.
+	// Defaults to `hashicorp/packer:light`.
+	PackerImage string `yaml:"packerImage,omitempty"`
+
+	// BuildKit builds `docker` artifacts with [BuildKit](https://github.com/moby/buildkit) instead of Kaniko.
+	// Artifacts without a type default to `docker` artifacts when it is set.
+	BuildKit *BuildKitDetails `yaml:"buildkit,omitempty"`
+}
+
+// BuildKitDetails *beta* describes how `docker` artifacts are built with BuildKit in the cluster.
+type BuildKitDetails struct {
+	// Address is the address of an existing buildkitd service, for example `tcp://buildkitd.buildkit:1234`.
+	// If omitted, each artifact is built by its own rootless BuildKit pod.
+	Address string `yaml:"address,omitempty"`
+
+	// Image is the image of the build pod. It must contain `buildctl`, and `buildctl-daemonless.sh` when no address is set.
+	// Defaults to `moby/buildkit:v0.13.2-rootless`.
+	Image string `yaml:"image,omitempty"`
+
+	// ImportCache are the caches to import, as given to `buildctl --import-cache`.
+	// For example: `type=registry,ref=gcr.io/k8s-skaffold/cache`.
+	// The images of the artifacts' `cacheFrom` are imported as registry caches too.
+	ImportCache []string `yaml:"importCache,omitempty"`
+
+	// ExportCache are the caches to export, as given to `buildctl --export-cache`.
+	// For example: `type=registry,ref=gcr.io/k8s-skaffold/cache,mode=max`.
+	ExportCache []string `yaml:"exportCache,omitempty"`
 }
 
 // DockerConfig contains information about the docker `config.json` to mount.
@@ -702,21 +742,75 @@ type TestCase struct {
 	// For example: `["./test/*"]`.
 	TrivyTests []*TrivyTest `yaml:"trivyTests,omitempty"`
 
-	// PackerTests lists the Packer tests to run on that artifact.
-	// For example: `["./test/*"]`.
-	PackerTests []string `yaml:"packerTests,omitempty"`
+	// PackerTests lists the Packer templates to run on that artifact.
+	// Each template is validated then built with the image in its `image` variable, so that its
+	// provisioners, such as goss or inspec, can test the image.
+	// For example: `["./test/*.pkr.hcl"]`.
+	PackerTests []string `yaml:"packerTests,omitempty" skaffold:"filepath"`
 }
 
 // GrypeTest is a list of tests to run on images that Skaffold builds.
 type GrypeTest struct {
 	// Severity is the severity of the vulnerability.
+	// Vulnerabilities of this severity or higher fail the test, unless a comma-separated list of severities is given.
+	// For example: `high` or `medium,critical`.
 	Severity string `yaml:"severity,omitempty"`
+
+	// VulnerabilityPolicy decides which of the vulnerabilities found by Grype fail the test.
+	VulnerabilityPolicy `yaml:",inline"`
 }
 
 // TrivyTest is a list of tests to run on images that Skaffold builds.
 type TrivyTest struct {
 	// Severity is the severity of the vulnerability.
+	// Vulnerabilities of this severity or higher fail the test, unless a comma-separated list of severities is given.
+	// For example: `HIGH` or `HIGH,CRITICAL`.
 	Severity string `yaml:"severity,omitempty"`
+
+	// VulnerabilityPolicy decides which of the vulnerabilities found by Trivy fail the test.
+	VulnerabilityPolicy `yaml:",inline"`
+}
+
+// VulnerabilityPolicy decides which of the vulnerabilities found in an image fail its test.
+// Without `severity` or `thresholds`, vulnerabilities are reported but never fail the test.
+type VulnerabilityPolicy struct {
+	// Thresholds are the numbers of vulnerabilities of each severity that are allowed before the test fails.
+	// For example: `{"critical": 0, "high": 5}`.
+	Thresholds map[string]int `yaml:"thresholds,omitempty"`
+
+	// OnlyFixed only fails the test on the vulnerabilities that have a fix available.
+	OnlyFixed *bool `yaml:"onlyFixed,omitempty"`
+
+	// Ignore lists the vulnerabilities that don't fail the test.
+	Ignore []VulnerabilityIgnore `yaml:"ignore,omitempty"`
+
+	// PolicyFile is a YAML file with a policy shared between tests, that holds `severity`, `thresholds`, `onlyFixed` and `ignore` fields.
+	// The fields of the test take precedence over the ones of the file, and both lists of ignored vulnerabilities apply.
+	PolicyFile string `yaml:"policyFile,omitempty" skaffold:"filepath"`
+
+	// IgnoreFile lists vulnerabilities that don't fail the test, one per line, in the `.trivyignore` format.
+	// For example: `CVE-2023-44487 exp:2024-12-31`.
+	IgnoreFile string `yaml:"ignoreFile,omitempty" skaffold:"filepath"`
+
+	// SARIFReport is the path of a SARIF file to write the vulnerabilities to, for code-scanning UIs.
+	// Tests can share it: each image scanned by each scanner is a run of the report, located in the Dockerfile of the image.
+	SARIFReport string `yaml:"sarifReport,omitempty" skaffold:"filepath"`
+}
+
+// VulnerabilityIgnore is a vulnerability that doesn't fail tests, until it expires.
+type VulnerabilityIgnore struct {
+	// ID is the identifier of the vulnerability. For example: `CVE-2023-44487`.
+	ID string `yaml:"id" yamltags:"required"`
+
+	// Package only ignores the vulnerability in this package. Defaults to all packages.
+	Package string `yaml:"package,omitempty"`
+
+	// Expires is the date, as `YYYY-MM-DD`, after which the vulnerability fails tests again.
+	// Defaults to never.
+	Expires string `yaml:"expires,omitempty"`
+
+	// Reason documents why the vulnerability is ignored.
+	Reason string `yaml:"reason,omitempty"`
 }
 
 // Action describes a user defined action defined by a list of container to execute.
@@ -1002,7 +1096,11 @@ type TerraformDeploy struct {
 	// Deployments is a list of terraform deployments to run.
 	Deployments []TerrformDeployments `yaml:"deployments,omitempty"`
 
-	// LifecycleHooks describes a set of lifecycle host hooks that are executed before and after the Cloud Run deployer.
+	// Concurrency is how many deployments can run concurrently, once the deployments they depend on have completed.
+	// 0 means "no-limit". Defaults to `0`.
+	Concurrency int `yaml:"concurrency,omitempty"`
+
+	// LifecycleHooks describes a set of lifecycle host hooks that are executed before and after all the Terraform deployments.
 	LifecycleHooks TerraformDeployHooks `yaml:"hooks,omitempty"`
 }
 
@@ -1022,7 +1120,14 @@ type TerrformDeployments struct {
 	// Defaults to the current working directory.
 	Dir string `yaml:"dir,omitempty" skaffold:"filepath"`
 	// Vars is a map of variables to be passed to the Terraform command.
+	// Values are templated and can reference the outputs of the deployments listed in `dependsOn`,
+	// for example `{{.TF_network_subnet_id}}` for the `subnet_id` output of the `network` deployment.
+	// Sensitive outputs aren't available.
 	Vars map[string]string `yaml:"vars,omitempty"`
+	// ImageVars maps Terraform variables to the images of built artifacts, for example `{api_image: gcr.io/x/api}`.
+	// Each variable is set to the tagged reference of the image that was built, pinned to its digest when the image was pushed.
+	// A variable that is also set in `vars` keeps the value from `vars`.
+	ImageVars map[string]string `yaml:"imageVars,omitempty"`
 	// VarFiles is a list of variable files to be used by Terraform.
 	VarFiles []string `yaml:"varFiles,omitempty" skaffold:"filepath"`
 	// BackendConfig is a map of backend configuration to be used by Terraform.
@@ -1036,14 +1141,29 @@ type TerrformDeployments struct {
 	WorkspaceCommand string `yaml:"workspaceCommand,omitempty"`
 	// Workspace is the name of the Terraform workspace to use.
 	Workspace string `yaml:"workspace,omitempty"`
-	// AutoApprove automatically approves Terraform operations without prompting.
+	// AutoApprove is deprecated and has no effect: the saved plan of each deployment is always applied without prompting for confirmation.
 	AutoApprove bool `yaml:"autoApprove,omitempty"`
-	// LifecycleHooks describes a set of lifecycle host hooks that are executed before and after the Cloud Run deployer.
+	// PlanFile is the path, relative to `dir`, where the plan is saved by `terraform plan` before it is applied.
+	// Defaults to `skaffold.tfplan`. Plans that aren't applied, for dry runs and `skaffold render`, are saved to a temporary file instead.
+	PlanFile string `yaml:"planFile,omitempty"`
+	// LockTimeout is how long Terraform waits to acquire the state lock, for example `30s`. Passed as `-lock-timeout`.
+	LockTimeout string `yaml:"lockTimeout,omitempty"`
+	// LockRetry retries the Terraform commands that fail because the state is locked by another run.
+	LockRetry *TerraformLockRetry `yaml:"lockRetry,omitempty"`
+	// LifecycleHooks describes a set of lifecycle host hooks that are executed before and after this deployment is applied.
 	LifecycleHooks TerraformDeployHooks `yaml:"hooks,omitempty"`
 	// DependsOn is a list of deployments that this deployment depends on.
 	DependsOn []string `yaml:"dependsOn,omitempty"`
 }
 
+// TerraformLockRetry describes how Terraform commands are retried while the state is locked.
+type TerraformLockRetry struct {
+	// Attempts is the number of times a command is retried. Defaults to `0`.
+	Attempts int `yaml:"attempts,omitempty"`
+	// Delay is the time to wait between attempts, for example `30s`. Defaults to `10s`.
+	Delay string `yaml:"delay,omitempty"`
+}
+
 // CloudRunDeploy *alpha* deploys the container to Google Cloud Run.
 type CloudRunDeploy struct {
 	// ProjectID the GCP Project to use for Cloud Run.
@@ -1065,7 +1185,13 @@ type DockerDeploy struct {
 	// UseCompose tells skaffold whether or not to deploy using `docker-compose`.
 	UseCompose bool `yaml:"useCompose,omitempty"`
 
+	// ComposeFile is the path to the Compose file deployed when `useCompose` is true.
+	// Defaults to the first of `compose.yaml`, `compose.yml`, `docker-compose.yml` and `docker-compose.yaml` found in the directory of the Skaffold config
+	// or its parents, along with its override file, like `docker compose` does.
+	ComposeFile string `yaml:"composeFile,omitempty" skaffold:"filepath"`
+
 	// Images are the container images to run in Docker.
+	// With `useCompose`, these are the images whose Skaffold-built tags replace the ones of the Compose services.
 	Images []string `yaml:"images" yamltags:"required"`
 }
 
@@ -1413,10 +1539,14 @@ type PackerArtifact struct {
 	// For example: `["-force", "-debug"]`.
 	BuildArgs []string `yaml:"buildArgs,omitempty"`
 
-	// PostProcessors specifies which post-processors to use.
-	// If empty, all post-processors in the template will be used.
+	// PostProcessors specifies which builds and post-processors to run, passed to `packer build` as `-only`.
+	// If empty, all builds and post-processors in the template will be run.
 	PostProcessors []string `yaml:"postProcessors,omitempty"`
 
+	// Except specifies builds and post-processors to skip, passed to `packer build` as `-except`.
+	// Can't be used with `postProcessors`.
+	Except []string `yaml:"except,omitempty"`
+
 	// Env are environment variables passed to Packer.
 	// For example: `["PKR_VAR_foo=bar", "PKR_VAR_baz=qux"]`.
 	Env []string `yaml:"env,omitempty" skaffold:"template"`
@@ -1424,6 +1554,26 @@ type PackerArtifact struct {
 	// ContextDir is the directory containing the Packer context.
 	// If not specified, it defaults to the directory containing the template file.
 	ContextDir string `yaml:"contextDir,omitempty" skaffold:"filepath"`
+
+	// Platforms are the platforms the template can build images for, for example `["linux/amd64", "linux/arm64"]`.
+	// The target platform is passed to the template in the `target_platform`, `target_os` and `target_arch` variables,
+	// and images built for multiple platforms are combined into a manifest list.
+	// If empty, the template is assumed to support any platform.
+	Platforms []string `yaml:"platforms,omitempty"`
+
+	// Dependencies are the file dependencies that Skaffold should watch for both rebuilding and file syncing for this artifact.
+	// Defaults to the template, its variable files, and the files referenced by its `file`, `shell` and `ansible` provisioners.
+	Dependencies *PackerDependencies `yaml:"dependencies,omitempty"`
+}
+
+// PackerDependencies is used to specify the file dependencies of a Packer artifact.
+type PackerDependencies struct {
+	// Paths should be set to the file dependencies for this artifact, so that the Skaffold file watcher knows when to rebuild.
+	Paths []string `yaml:"paths,omitempty"`
+
+	// Ignore specifies the paths that should be ignored by Skaffold's file watcher.
+	// If a file exists in both `paths` and in `ignore`, it will be ignored, and will be excluded from rebuilds.
+	Ignore []string `yaml:"ignore,omitempty"`
 }
 
 // ArtifactDependency describes a specific build dependency for an artifact.
@@ -1934,11 +2084,11 @@ type CloudRunDeployHooks struct {
 	PostHooks []HostHook `yaml:"after,omitempty"`
 }
 
-// TerraformDeployHooks describes the list of lifecycle hooks to execute in the host before and after the Cloud Run deployer.
+// TerraformDeployHooks describes the list of lifecycle hooks to execute in the host before and after Terraform deployments.
 type TerraformDeployHooks struct {
-	// PreHooks describes the list of lifecycle hooks to execute *before* the Cloud Run deployer.
+	// PreHooks describes the list of lifecycle hooks to execute *before* the Terraform deployments.
 	PreHooks []HostHook `yaml:"before,omitempty"`
-	// PostHooks describes the list of lifecycle hooks to execute *after* the Cloud Run deployer.
+	// PostHooks describes the list of lifecycle hooks to execute *after* the Terraform deployments, whose outputs are exposed as `TF_<deployment>_<output>` environment variables.
 	PostHooks []HostHook `yaml:"after,omitempty"`
 }
 
@@ -145,6 +145,7 @@ func TestApplyProfiles(t *testing.T) {
 									KanikoImage: kaniko.DefaultImage,
 									PackImage:   "gcr.io/k8s-skaffold/pack",
 									KoImage:     "gcr.io/k8s-skaffold/skaffold",
+									PackerImage: "hashicorp/packer:light",
 								},
 							},
 						},
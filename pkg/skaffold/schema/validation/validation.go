@@ -86,6 +86,7 @@ func ProcessToErrorWithLocation(configs parser.SkaffoldConfigSet, validateConfig
 		errs = append(errs, validateTaggingPolicy(config, config.Build)...)
 		errs = append(errs, validateCustomTest(config, config.Test)...)
 		errs = append(errs, validateGCBConfig(config, config.Build)...)
+		errs = append(errs, validateTerraformDeploy(config, config.Deploy.TerraformDeploy)...)
 	}
 	errs = append(errs, validateArtifactDependencies(configs)...)
 	if validateConfig.CheckDeploySource {
@@ -655,7 +656,7 @@ func validateArtifactTypes(cfg *parser.SkaffoldConfigEntry, bc latest.BuildConfi
 	case bc.GoogleCloudBuild != nil:
 		for i, a := range bc.Artifacts {
 			at := misc.ArtifactType(a)
-			if at != misc.Kaniko && at != misc.Docker && at != misc.Jib && at != misc.Buildpack && at != misc.Ko {
+			if at != misc.Kaniko && at != misc.Docker && at != misc.Jib && at != misc.Buildpack && at != misc.Ko && at != misc.Packer {
 				cfgErrs = append(cfgErrs, ErrorWithLocation{
 					Error:    fmt.Errorf("found a '%s' artifact, which is incompatible with the 'gcb' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'googleCloudBuild' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)),
 					Location: cfg.YAMLInfos.Locate(&cfg.Build.Artifacts[i].ArtifactType),
@@ -664,7 +665,10 @@ func validateArtifactTypes(cfg *parser.SkaffoldConfigEntry, bc latest.BuildConfi
 		}
 	case bc.Cluster != nil:
 		for i, a := range bc.Artifacts {
-			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom {
+			if misc.ArtifactType(a) == misc.Docker && bc.Cluster.BuildKit != nil {
+				continue
+			}
+			if misc.ArtifactType(a) != misc.Kaniko && misc.ArtifactType(a) != misc.Custom && misc.ArtifactType(a) != misc.Packer {
 				cfgErrs = append(cfgErrs, ErrorWithLocation{
 					Error:    fmt.Errorf("found a '%s' artifact, which is incompatible with the 'cluster' builder:\n\n%s\n\nTo use the '%s' builder, remove the 'cluster' stanza from the 'build' section of your configuration. For information, see https://skaffold.dev/docs/pipeline-stages/builders/", misc.ArtifactType(a), misc.FormatArtifact(a), misc.ArtifactType(a)),
 					Location: cfg.YAMLInfos.Locate(&cfg.Build.Artifacts[i].ArtifactType),
@@ -688,6 +692,44 @@ func validateGCBConfig(cfg *parser.SkaffoldConfigEntry, bc latest.BuildConfig) (
 	return cfgErrs
 }
 
+// validateTerraformDeploy makes sure that terraform deployment names are unique, that `dependsOn` only references deployments that exist
+// and that `concurrency` isn't negative.
+func validateTerraformDeploy(cfg *parser.SkaffoldConfigEntry, tf *latest.TerraformDeploy) (cfgErrs []ErrorWithLocation) {
+	if tf == nil {
+		return nil
+	}
+
+	if tf.Concurrency < 0 {
+		cfgErrs = append(cfgErrs, ErrorWithLocation{
+			Error:    fmt.Errorf("terraform concurrency must be 0 (no limit) or a positive number, got %d", tf.Concurrency),
+			Location: cfg.YAMLInfos.LocateField(tf, "Concurrency"),
+		})
+	}
+
+	names := map[string]bool{}
+	for i, d := range tf.Deployments {
+		if names[d.Name] {
+			cfgErrs = append(cfgErrs, ErrorWithLocation{
+				Error:    fmt.Errorf("found duplicate terraform deployment %q. terraform deployment names must be unique", d.Name),
+				Location: cfg.YAMLInfos.LocateField(&tf.Deployments[i], "Name"),
+			})
+		}
+		names[d.Name] = true
+	}
+
+	for i, d := range tf.Deployments {
+		for _, dep := range d.DependsOn {
+			if !names[dep] {
+				cfgErrs = append(cfgErrs, ErrorWithLocation{
+					Error:    fmt.Errorf("terraform deployment %q depends on %q, which isn't defined in the same config", d.Name, dep),
+					Location: cfg.YAMLInfos.LocateField(&tf.Deployments[i], "DependsOn"),
+				})
+			}
+		}
+	}
+	return cfgErrs
+}
+
 // validateLogPrefix checks that logs are configured with a valid prefix.
 func validateLogPrefix(cfg *parser.SkaffoldConfigEntry, lc latest.LogsConfig) []ErrorWithLocation {
 	validPrefixes := []string{"", "auto", "container", "podAndContainer", "none"}
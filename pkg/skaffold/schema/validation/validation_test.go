@@ -104,6 +104,37 @@ func TestValidateArtifactTypes(t *testing.T) {
 			},
 			expectedErrs: 1,
 		},
+		{
+			description: "cluster - docker artifact with buildkit",
+			bc: latest.BuildConfig{
+				BuildType: latest.BuildType{
+					Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}},
+				},
+				Artifacts: []*latest.Artifact{
+					{
+						ImageName:    "leeroy-web",
+						Workspace:    "leeroy-web",
+						ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{}},
+					},
+				},
+			},
+		},
+		{
+			description: "cluster - buildpacks artifact with buildkit",
+			bc: latest.BuildConfig{
+				BuildType: latest.BuildType{
+					Cluster: &latest.ClusterDetails{BuildKit: &latest.BuildKitDetails{}},
+				},
+				Artifacts: []*latest.Artifact{
+					{
+						ImageName:    "leeroy-web",
+						Workspace:    "leeroy-web",
+						ArtifactType: latest.ArtifactType{BuildpackArtifact: &latest.BuildpackArtifact{}},
+					},
+				},
+			},
+			expectedErrs: 1,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -1322,6 +1353,68 @@ func TestValidateGCBConfig(t *testing.T) {
 	}
 }
 
+func TestValidateTerraformDeploy(t *testing.T) {
+	tests := []struct {
+		desc        string
+		deployments []latest.TerrformDeployments
+		concurrency int
+		expected    int
+	}{
+		{
+			desc: "valid dependencies",
+			deployments: []latest.TerrformDeployments{
+				{Name: "network"},
+				{Name: "db", DependsOn: []string{"network"}},
+				{Name: "app", DependsOn: []string{"network", "db"}},
+			},
+		},
+		{
+			desc: "missing dependency",
+			deployments: []latest.TerrformDeployments{
+				{Name: "network"},
+				{Name: "app", DependsOn: []string{"network", "db"}},
+			},
+			expected: 1,
+		},
+		{
+			desc: "duplicate names",
+			deployments: []latest.TerrformDeployments{
+				{Name: "network"},
+				{Name: "network"},
+			},
+			expected: 1,
+		},
+		{
+			desc:        "no concurrency limit",
+			deployments: []latest.TerrformDeployments{{Name: "network"}},
+			concurrency: 0,
+		},
+		{
+			desc:        "negative concurrency",
+			deployments: []latest.TerrformDeployments{{Name: "network"}},
+			concurrency: -1,
+			expected:    1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.desc, func(t *testutil.T) {
+			tf := &latest.TerraformDeploy{Deployments: test.deployments, Concurrency: test.concurrency}
+			errs := validateTerraformDeploy(&parser.SkaffoldConfigEntry{
+				YAMLInfos: configlocations.NewYAMLInfos(),
+				SkaffoldConfig: &latest.SkaffoldConfig{
+					Pipeline: latest.Pipeline{
+						Deploy: latest.DeployConfig{
+							DeployType: latest.DeployType{TerraformDeploy: tf},
+						},
+					},
+				},
+			}, tf)
+
+			t.CheckDeepEqual(test.expected, len(errs))
+		})
+	}
+}
+
 func TestValidateAcyclicDependencies(t *testing.T) {
 	tests := []struct {
 		description string
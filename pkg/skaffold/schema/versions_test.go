@@ -531,6 +531,7 @@ func withGoogleCloudBuild(id string, ops ...func(*latest.BuildConfig)) func(*lat
 			KanikoImage: kaniko.DefaultImage,
 			PackImage:   "gcr.io/k8s-skaffold/pack",
 			KoImage:     "gcr.io/k8s-skaffold/skaffold",
+			PackerImage: "hashicorp/packer:light",
 		}}}
 		for _, op := range ops {
 			op(&b)
@@ -547,6 +548,7 @@ func withClusterBuild(secretName, mountPath, namespace, secret string, timeout s
 			PullSecretPath:      secret,
 			PullSecretMountPath: mountPath,
 			Timeout:             timeout,
+			PackerImage:         "hashicorp/packer:light",
 		}}}
 		for _, op := range ops {
 			op(&b)
@@ -2,46 +2,104 @@ package grype
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os/exec"
 
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/vulnerability"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 )
 
+// scanner runs Grype.
+var scanner = vulnerability.Scanner{
+	Name:      "Grype",
+	Scan:      scan,
+	DBVersion: dbVersion,
+}
+
 type Runner struct {
 	cfg        docker.Config
 	grypeTests []*latest.GrypeTest
 	imageName  string
 	workspace  string
+	// source is where SARIF reports locate the vulnerabilities of the image.
+	source string
+	cache  *vulnerability.Cache
 }
 
-func New(cfg docker.Config, imageName string, ws string, grypeTests []*latest.GrypeTest) (*Runner, error) {
+func New(cfg docker.Config, imageName string, ws string, source string, grypeTests []*latest.GrypeTest, cache *vulnerability.Cache) (*Runner, error) {
 	return &Runner{
 		cfg:        cfg,
 		imageName:  imageName,
 		grypeTests: grypeTests,
 		workspace:  ws,
+		source:     source,
+		cache:      cache,
 	}, nil
 }
 
+// Test scans the image with Grype, and checks the vulnerabilities it finds against the policy of each test.
 func (r *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
-	for _, test := range r.grypeTests {
-		fmt.Printf("grype test failed: %v", imageTag)
-		args := []string{imageTag, "--fail-on", test.Severity}
-		cmd := exec.CommandContext(ctx, "grype", args...)
-		cmd.Stdout = out
-		cmd.Stderr = out
-
-		if err := util.RunCmd(ctx, cmd); err != nil {
-			return fmt.Errorf("grype test failed: %w", err)
+	var vulns []vulnerability.Vulnerability
+	for i, test := range r.grypeTests {
+		policy, err := vulnerability.NewPolicy(test.Severity, test.VulnerabilityPolicy)
+		if err != nil {
+			return err
+		}
+
+		if i == 0 {
+			if vulns, err = r.cache.Scan(ctx, scanner, imageTag); err != nil {
+				return vulnerability.ScanErr(scanner.Name, imageTag, err)
+			}
+		}
+		if err := policy.Check(ctx, out, scanner.Name, imageTag, r.source, vulns); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// TestDependencies lists the policy and ignore files of the tests.
 func (r *Runner) TestDependencies(ctx context.Context) ([]string, error) {
-	return nil, nil
+	var deps []string
+	for _, test := range r.grypeTests {
+		deps = append(deps, vulnerability.Dependencies(test.VulnerabilityPolicy)...)
+	}
+	return deps, nil
+}
+
+func scan(ctx context.Context, image string) ([]vulnerability.Vulnerability, error) {
+	log.Entry(ctx).Infof("Running Grype test on %s", image)
+	cmd := exec.CommandContext(ctx, "grype", image, "--output", "json", "--quiet")
+	report, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return vulnerability.ParseGrype(report)
+}
+
+// dbVersion returns the schema and the build time of the vulnerability database of Grype.
+func dbVersion(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "grype", "db", "status", "--output", "json")
+	b, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+
+	var status struct {
+		SchemaVersion string `json:"schemaVersion"`
+		Built         string `json:"built"`
+	}
+	if err := json.Unmarshal(b, &status); err != nil {
+		return "", fmt.Errorf("parsing grype database status: %w", err)
+	}
+	if status.Built == "" {
+		return "", errors.New("grype hasn't downloaded its vulnerability database yet")
+	}
+	return status.SchemaVersion + "/" + status.Built, nil
 }
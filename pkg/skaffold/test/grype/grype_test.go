@@ -0,0 +1,115 @@
+package grype
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/vulnerability"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+	testEvent "github.com/ryanharper/skaffold/v2/testutil/event"
+)
+
+const report = `{
+  "matches": [
+    {
+      "vulnerability": {"id": "CVE-2023-5363", "severity": "High", "fix": {"versions": ["3.1.4-r0"], "state": "fixed"}},
+      "artifact": {"name": "libcrypto3", "version": "3.1.3-r0"}
+    },
+    {
+      "vulnerability": {"id": "CVE-2023-5678", "severity": "Medium", "fix": {"versions": [], "state": "not-fixed"}},
+      "artifact": {"name": "libcrypto3", "version": "3.1.3-r0"}
+    }
+  ]
+}`
+
+func TestGrypeTests(t *testing.T) {
+	tests := []struct {
+		description  string
+		grypeTests   []*latest.GrypeTest
+		commands     util.Command
+		expectedCode proto.StatusCode
+		shouldErr    bool
+	}{
+		{
+			description: "no vulnerability fails the policy",
+			grypeTests:  []*latest.GrypeTest{{Severity: "critical"}},
+			commands:    testutil.CmdRunOut("grype image:tag --output json --quiet", report),
+		},
+		{
+			description:  "vulnerabilities fail the policy",
+			grypeTests:   []*latest.GrypeTest{{Severity: "critical"}, {Severity: "high"}},
+			commands:     testutil.CmdRunOut("grype image:tag --output json --quiet", report),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_VULNERABILITY_POLICY_ERR,
+		},
+		{
+			description: "ignored vulnerability",
+			grypeTests: []*latest.GrypeTest{{
+				Severity:            "high",
+				VulnerabilityPolicy: latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{{ID: "CVE-2023-5363"}}},
+			}},
+			commands: testutil.CmdRunOut("grype image:tag --output json --quiet", report),
+		},
+		{
+			description:  "scan failure",
+			grypeTests:   []*latest.GrypeTest{{Severity: "high"}},
+			commands:     testutil.CmdRunOutErr("grype image:tag --output json --quiet", "", errors.New("unable to find the specified image")),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_VULNERABILITY_SCAN_ERR,
+		},
+		{
+			description:  "invalid policy",
+			grypeTests:   []*latest.GrypeTest{{Severity: "severe"}},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			runner, err := New(&mockConfig{}, "image", ".", ".", test.grypeTests, nil)
+			t.CheckNoError(err)
+			err = runner.Test(context.Background(), io.Discard, "image:tag")
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				var actionable sErrors.Error
+				t.CheckTrue(errors.As(err, &actionable))
+				t.CheckDeepEqual(test.expectedCode, actionable.StatusCode())
+			}
+		})
+	}
+}
+
+func TestGrypeCache(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		const image = "image:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f"
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("grype db status --output json", `{"schemaVersion": "v5", "built": "2024-06-15T01:30:00Z"}`).
+			AndRunOut("grype "+image+" --output json --quiet", report).
+			AndRunOut("grype db status --output json", `{"schemaVersion": "v5", "built": "2024-06-15T01:30:00Z"}`).
+			AndRunOut("grype image:tag --output json --quiet", report))
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		cache := vulnerability.NewCacheFile(t.NewTempDir().Path("vulnerabilities"))
+		runner, err := New(&mockConfig{}, "image", ".", ".", []*latest.GrypeTest{{Severity: "critical"}}, cache)
+		t.CheckNoError(err)
+
+		// images tagged with their ID are cached, other tags are always scanned
+		for _, tag := range []string{image, image, "image:tag"} {
+			t.CheckNoError(runner.Test(context.Background(), io.Discard, tag))
+		}
+	})
+}
+
+type mockConfig struct {
+	docker.Config
+}
@@ -0,0 +1,44 @@
+package packer
+
+import (
+	"fmt"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+)
+
+func expandingFilePathsErr(err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("expanding packer test paths: %s", err),
+			ErrCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	)
+}
+
+func packerInitErr(template string, err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("installing the plugins of packer test template %s: %s", template, err),
+			ErrCode: proto.StatusCode_TEST_PACKER_INIT_ERR,
+		},
+	)
+}
+
+func packerValidateErr(template string, err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("packer test template %s is invalid: %s", template, err),
+			ErrCode: proto.StatusCode_TEST_PACKER_VALIDATE_ERR,
+		},
+	)
+}
+
+func packerRunErr(template string, imageTag string, err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("packer test %s failed on image %s: %s", template, imageTag, err),
+			ErrCode: proto.StatusCode_TEST_PACKER_RUN_ERR,
+		},
+	)
+}
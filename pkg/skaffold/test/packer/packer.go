@@ -0,0 +1,111 @@
+package packer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os/exec"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/event"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+)
+
+// imageVar is the template variable that receives the reference to the image under test.
+const imageVar = "PKR_VAR_image"
+
+type Runner struct {
+	cfg         docker.Config
+	imageName   string
+	workspace   string
+	packerTests []string
+}
+
+// New creates a new packer.Runner.
+func New(cfg docker.Config, imageName string, ws string, packerTests []string) *Runner {
+	return &Runner{
+		cfg:         cfg,
+		imageName:   imageName,
+		workspace:   ws,
+		packerTests: packerTests,
+	}
+}
+
+// Test is the entrypoint for running Packer tests.
+// Each test template is validated, then built with the reference to the image in its `image` variable,
+// so that its provisioners (goss, inspec, shell...) can check the image.
+func (r *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
+	event.TestInProgress()
+	if err := r.runPackerTests(ctx, out, imageTag); err != nil {
+		event.TestFailed(r.imageName, err)
+		return err
+	}
+	event.TestComplete()
+	return nil
+}
+
+func (r *Runner) runPackerTests(ctx context.Context, out io.Writer, imageTag string) error {
+	templates, err := r.TestDependencies(ctx)
+	if err != nil {
+		return err
+	}
+
+	env, err := r.env(ctx, imageTag)
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		log.Entry(ctx).Infof("Running Packer test %s on %s", template, imageTag)
+		output.Default.Fprintf(out, "Running Packer test %s\n", template)
+
+		if err := r.runPacker(ctx, out, env, "init", template); err != nil {
+			return packerInitErr(template, err)
+		}
+		if err := r.runPacker(ctx, out, env, "validate", template); err != nil {
+			return packerValidateErr(template, err)
+		}
+		if err := r.runPacker(ctx, out, env, "build", "-color=false", template); err != nil {
+			output.Red.Fprintf(out, "Packer test %s failed.\n", template)
+			return packerRunErr(template, imageTag, err)
+		}
+		output.Green.Fprintf(out, "Packer test %s passed.\n", template)
+	}
+	return nil
+}
+
+func (r *Runner) runPacker(ctx context.Context, out io.Writer, env []string, args ...string) error {
+	cmd := exec.CommandContext(ctx, "packer", args...)
+	cmd.Dir = r.workspace
+	cmd.Env = env
+	cmd.Stdout = out
+	cmd.Stderr = out
+
+	return util.RunCmd(ctx, cmd)
+}
+
+// TestDependencies returns the Packer test templates.
+func (r *Runner) TestDependencies(context.Context) ([]string, error) {
+	files, err := util.ExpandPathsGlob(r.workspace, r.packerTests)
+	if err != nil {
+		return nil, expandingFilePathsErr(err)
+	}
+	return files, nil
+}
+
+// env returns the environment of the Packer commands, with the image under test and the extra docker
+// environment, for example when running on minikube.
+func (r *Runner) env(ctx context.Context, imageTag string) ([]string, error) {
+	env := append(util.OSEnviron(),
+		fmt.Sprintf("%s=%s", imageVar, imageTag),
+		fmt.Sprintf("IMAGE=%s", imageTag),
+	)
+
+	localDaemon, err := docker.NewAPIClient(ctx, r.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("getting docker client information: %w", err)
+	}
+	return append(env, localDaemon.ExtraEnv()...), nil
+}
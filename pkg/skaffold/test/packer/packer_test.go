@@ -0,0 +1,101 @@
+package packer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+	testEvent "github.com/ryanharper/skaffold/v2/testutil/event"
+)
+
+func TestPackerTests(t *testing.T) {
+	tests := []struct {
+		description  string
+		commands     func(test1, test2 string) util.Command
+		expectedCode proto.StatusCode
+		shouldErr    bool
+	}{
+		{
+			description: "all tests pass",
+			commands: func(test1, test2 string) util.Command {
+				return testutil.CmdRunEnv("packer init "+test1, []string{"PKR_VAR_image=image:tag", "IMAGE=image:tag"}).
+					AndRun("packer validate "+test1).
+					AndRunEnv("packer build -color=false "+test1, []string{"PKR_VAR_image=image:tag"}).
+					AndRun("packer init " + test2).
+					AndRun("packer validate " + test2).
+					AndRun("packer build -color=false " + test2)
+			},
+		},
+		{
+			description: "plugins can't be installed",
+			commands: func(test1, test2 string) util.Command {
+				return testutil.CmdRunErr("packer init "+test1, errors.New("failed to get plugin"))
+			},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_PACKER_INIT_ERR,
+		},
+		{
+			description: "invalid template",
+			commands: func(test1, test2 string) util.Command {
+				return testutil.CmdRun("packer init "+test1).
+					AndRunErr("packer validate "+test1, errors.New("unsupported argument"))
+			},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_PACKER_VALIDATE_ERR,
+		},
+		{
+			description: "failing test",
+			commands: func(test1, test2 string) util.Command {
+				return testutil.CmdRun("packer init "+test1).
+					AndRun("packer validate "+test1).
+					AndRunErr("packer build -color=false "+test1, errors.New("goss validation failed"))
+			},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_PACKER_RUN_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			tmpDir := t.NewTempDir().Touch("tests/goss.pkr.hcl", "tests/inspec.pkr.hcl")
+			t.Override(&util.OSEnviron, func() []string { return nil })
+			t.Override(&docker.NewAPIClient, func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+				return docker.NewLocalDaemon(&testutil.FakeAPIClient{}, nil, false, nil), nil
+			})
+			t.Override(&util.DefaultExecCommand, test.commands(tmpDir.Path("tests/goss.pkr.hcl"), tmpDir.Path("tests/inspec.pkr.hcl")))
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			runner := New(&mockConfig{}, "image", tmpDir.Root(), []string{"tests/*.pkr.hcl"})
+			err := runner.Test(context.Background(), io.Discard, "image:tag")
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				var actionable sErrors.Error
+				t.CheckTrue(errors.As(err, &actionable))
+				t.CheckDeepEqual(test.expectedCode, actionable.StatusCode())
+			}
+		})
+	}
+}
+
+func TestTestDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("tests/goss.pkr.hcl", "tests/goss.yaml", "smoke.pkr.hcl")
+
+		runner := New(&mockConfig{}, "image", tmpDir.Root(), []string{"tests/*.pkr.hcl", "smoke.pkr.hcl"})
+		deps, err := runner.TestDependencies(context.Background())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(tmpDir.Paths("tests/goss.pkr.hcl", "smoke.pkr.hcl"), deps)
+	})
+}
+
+type mockConfig struct {
+	docker.Config
+}
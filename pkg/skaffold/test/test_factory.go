@@ -28,25 +28,38 @@ import (
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/graph"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/logfile"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/custom"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/grype"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/packer"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/structure"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/trivy"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/vulnerability"
 )
 
 type Config interface {
 	docker.Config
 
 	TestCases() []*latest.TestCase
+	Artifacts() []*latest.Artifact
 	Muted() config.Muted
+	CacheArtifacts() bool
 }
 
 // NewTester parses the provided test cases from the Skaffold config,
 // and returns a Tester instance with all the necessary test runners
 // to run all specified tests.
 func NewTester(ctx context.Context, cfg Config, imagesAreLocal func(imageName string) (bool, error)) (Tester, error) {
-	testers, err := getImageTesters(ctx, cfg, imagesAreLocal, cfg.TestCases())
+	var scans *vulnerability.Cache
+	if cfg.CacheArtifacts() {
+		var err error
+		if scans, err = vulnerability.NewCache(); err != nil {
+			log.Entry(ctx).Warnf("Error creating vulnerability cache, scanning all images: %v", err)
+		}
+	}
+
+	testers, err := getImageTesters(ctx, cfg, imagesAreLocal, cfg.TestCases(), scans)
 	if err != nil {
 		return nil, err
 	}
@@ -125,16 +138,22 @@ func (t FullTester) runTests(ctx context.Context, out io.Writer, bRes []graph.Ar
 	return nil
 }
 
-func getImageTesters(ctx context.Context, cfg docker.Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest.TestCase) (ImageTesters, error) {
+func getImageTesters(ctx context.Context, cfg Config, imagesAreLocal func(imageName string) (bool, error), tcs []*latest.TestCase, scans *vulnerability.Cache) (ImageTesters, error) {
+	artifacts := map[string]*latest.Artifact{}
+	for _, a := range cfg.Artifacts() {
+		artifacts[a.ImageName] = a
+	}
+
 	runners := make(map[string][]ImageTester)
 	for _, tc := range tcs {
+		source := vulnerability.Source(artifacts[tc.ImageName])
 		isLocal, err := imagesAreLocal(tc.ImageName)
 		if err != nil {
 			return nil, err
 		}
 
 		if tc.GrypeTests != nil {
-			grypeRunner, err := grype.New(cfg, tc.ImageName, tc.Workspace, tc.GrypeTests)
+			grypeRunner, err := grype.New(cfg, tc.ImageName, tc.Workspace, source, tc.GrypeTests, scans)
 			if err != nil {
 				return nil, err
 			}
@@ -142,7 +161,7 @@ func getImageTesters(ctx context.Context, cfg docker.Config, imagesAreLocal func
 		}
 
 		if tc.TrivyTests != nil {
-			trivyRunner := trivy.New(cfg, tc.TrivyTests)
+			trivyRunner := trivy.New(source, tc.TrivyTests, scans)
 			runners[tc.ImageName] = append(runners[tc.ImageName], trivyRunner)
 		}
 
@@ -154,6 +173,11 @@ func getImageTesters(ctx context.Context, cfg docker.Config, imagesAreLocal func
 			runners[tc.ImageName] = append(runners[tc.ImageName], structureRunner)
 		}
 
+		if len(tc.PackerTests) != 0 {
+			packerRunner := packer.New(cfg, tc.ImageName, tc.Workspace, tc.PackerTests)
+			runners[tc.ImageName] = append(runners[tc.ImageName], packerRunner)
+		}
+
 		for _, customTest := range tc.CustomTests {
 			customRunner, err := custom.New(cfg, tc.ImageName, tc.Workspace, customTest)
 			if err != nil {
@@ -205,6 +205,40 @@ func TestTestFailureRemoteImage(t *testing.T) {
 	})
 }
 
+func TestPackerTestSuccess(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Touch("tests/goss.pkr.hcl")
+		template := tmpDir.Path("tests/goss.pkr.hcl")
+		t.Override(&util.DefaultExecCommand, testutil.CmdRun("packer init "+template).
+			AndRun("packer validate "+template).
+			AndRunEnv("packer build -color=false "+template, []string{"PKR_VAR_image=image:tag"}))
+		t.Override(&docker.NewAPIClient, func(context.Context, docker.Config) (docker.LocalDaemon, error) {
+			return fakeLocalDaemon(&testutil.FakeAPIClient{}), nil
+		})
+
+		cfg := &mockConfig{
+			tests: []*latest.TestCase{{
+				ImageName:   "image",
+				Workspace:   tmpDir.Root(),
+				PackerTests: []string{"tests/*.pkr.hcl"},
+			}},
+		}
+
+		tester, err := NewTester(context.Background(), cfg, func(imageName string) (bool, error) { return true, nil })
+		t.CheckNoError(err)
+		deps, err := tester.TestDependencies(context.Background(), &latest.Artifact{ImageName: "image"})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{template}, deps)
+
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		err = tester.Test(context.Background(), io.Discard, []graph.Artifact{{
+			ImageName: "image",
+			Tag:       "image:tag",
+		}})
+		t.CheckNoError(err)
+	})
+}
+
 func TestTestFailure(t *testing.T) {
 	testutil.Run(t, "", func(t *testutil.T) {
 		t.NewTempDir().Touch("test.yaml").Chdir()
@@ -2,41 +2,99 @@ package trivy
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os/exec"
 
-	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/vulnerability"
 	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
 )
 
+// scanner runs Trivy.
+var scanner = vulnerability.Scanner{
+	Name:      "Trivy",
+	Scan:      scan,
+	DBVersion: dbVersion,
+}
+
 type Runner struct {
-	cfg        docker.Config
 	trivyTests []*latest.TrivyTest
+	// source is where SARIF reports locate the vulnerabilities of the image.
+	source string
+	cache  *vulnerability.Cache
 }
 
-func New(cfg docker.Config, trivyTests []*latest.TrivyTest) *Runner {
+func New(source string, trivyTests []*latest.TrivyTest, cache *vulnerability.Cache) *Runner {
 	return &Runner{
-		cfg:        cfg,
+		source:     source,
 		trivyTests: trivyTests,
+		cache:      cache,
 	}
 }
 
+// Test scans the image with Trivy, and checks the vulnerabilities it finds against the policy of each test.
 func (r *Runner) Test(ctx context.Context, out io.Writer, imageTag string) error {
-	for _, test := range r.trivyTests {
-		args := []string{"image", "--severity", test.Severity, imageTag}
-		cmd := exec.CommandContext(ctx, "trivy", args...)
-		cmd.Stdout = out
-		cmd.Stderr = out
+	var vulns []vulnerability.Vulnerability
+	for i, test := range r.trivyTests {
+		policy, err := vulnerability.NewPolicy(test.Severity, test.VulnerabilityPolicy)
+		if err != nil {
+			return err
+		}
 
-		if err := util.RunCmd(ctx, cmd); err != nil {
-			return fmt.Errorf("trivy test failed: %w", err)
+		if i == 0 {
+			if vulns, err = r.cache.Scan(ctx, scanner, imageTag); err != nil {
+				return vulnerability.ScanErr(scanner.Name, imageTag, err)
+			}
+		}
+		if err := policy.Check(ctx, out, scanner.Name, imageTag, r.source, vulns); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// TestDependencies lists the policy and ignore files of the tests.
 func (r *Runner) TestDependencies(ctx context.Context) ([]string, error) {
-	return nil, nil
+	var deps []string
+	for _, test := range r.trivyTests {
+		deps = append(deps, vulnerability.Dependencies(test.VulnerabilityPolicy)...)
+	}
+	return deps, nil
+}
+
+func scan(ctx context.Context, image string) ([]vulnerability.Vulnerability, error) {
+	log.Entry(ctx).Infof("Running Trivy test on %s", image)
+	cmd := exec.CommandContext(ctx, "trivy", "image", "--format", "json", "--quiet", image)
+	report, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return nil, err
+	}
+	return vulnerability.ParseTrivy(report)
+}
+
+// dbVersion returns the version and the update time of the vulnerability database of Trivy.
+func dbVersion(ctx context.Context) (string, error) {
+	cmd := exec.CommandContext(ctx, "trivy", "version", "--format", "json")
+	b, err := util.RunCmdOut(ctx, cmd)
+	if err != nil {
+		return "", err
+	}
+
+	var version struct {
+		VulnerabilityDB *struct {
+			Version   int    `json:"Version"`
+			UpdatedAt string `json:"UpdatedAt"`
+		} `json:"VulnerabilityDB"`
+	}
+	if err := json.Unmarshal(b, &version); err != nil {
+		return "", fmt.Errorf("parsing trivy version: %w", err)
+	}
+	if version.VulnerabilityDB == nil {
+		return "", errors.New("trivy hasn't downloaded its vulnerability database yet")
+	}
+	return fmt.Sprintf("%d/%s", version.VulnerabilityDB.Version, version.VulnerabilityDB.UpdatedAt), nil
 }
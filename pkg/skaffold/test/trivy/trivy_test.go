@@ -0,0 +1,121 @@
+package trivy
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/test/vulnerability"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+	testEvent "github.com/ryanharper/skaffold/v2/testutil/event"
+)
+
+const report = `{
+  "Results": [
+    {
+      "Target": "image:tag (alpine 3.18.4)",
+      "Vulnerabilities": [
+        {"VulnerabilityID": "CVE-2023-5363", "PkgName": "libcrypto3", "InstalledVersion": "3.1.3-r0", "FixedVersion": "3.1.4-r0", "Severity": "HIGH"},
+        {"VulnerabilityID": "CVE-2023-5678", "PkgName": "libcrypto3", "InstalledVersion": "3.1.3-r0", "Severity": "MEDIUM"}
+      ]
+    }
+  ]
+}`
+
+func TestTrivyTests(t *testing.T) {
+	tests := []struct {
+		description  string
+		trivyTests   []*latest.TrivyTest
+		commands     util.Command
+		expectedCode proto.StatusCode
+		shouldErr    bool
+	}{
+		{
+			description: "no vulnerability fails the policy",
+			trivyTests:  []*latest.TrivyTest{{Severity: "CRITICAL"}},
+			commands:    testutil.CmdRunOut("trivy image --format json --quiet image:tag", report),
+		},
+		{
+			description:  "vulnerabilities fail the policy",
+			trivyTests:   []*latest.TrivyTest{{Severity: "CRITICAL"}, {Severity: "HIGH"}},
+			commands:     testutil.CmdRunOut("trivy image --format json --quiet image:tag", report),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_VULNERABILITY_POLICY_ERR,
+		},
+		{
+			description: "ignored vulnerability",
+			trivyTests: []*latest.TrivyTest{{
+				Severity:            "HIGH",
+				VulnerabilityPolicy: latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{{ID: "CVE-2023-5363"}}},
+			}},
+			commands: testutil.CmdRunOut("trivy image --format json --quiet image:tag", report),
+		},
+		{
+			description:  "scan failure",
+			trivyTests:   []*latest.TrivyTest{{Severity: "HIGH"}},
+			commands:     testutil.CmdRunOutErr("trivy image --format json --quiet image:tag", "", errors.New("unable to find the specified image")),
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_VULNERABILITY_SCAN_ERR,
+		},
+		{
+			description:  "invalid policy",
+			trivyTests:   []*latest.TrivyTest{{Severity: "SEVERE"}},
+			shouldErr:    true,
+			expectedCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&util.DefaultExecCommand, test.commands)
+			testEvent.InitializeState([]latest.Pipeline{{}})
+
+			err := New(".", test.trivyTests, nil).Test(context.Background(), io.Discard, "image:tag")
+
+			t.CheckError(test.shouldErr, err)
+			if test.shouldErr {
+				var actionable sErrors.Error
+				t.CheckTrue(errors.As(err, &actionable))
+				t.CheckDeepEqual(test.expectedCode, actionable.StatusCode())
+			}
+		})
+	}
+}
+
+func TestTrivyCache(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		const image = "gcr.io/project/image@sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f"
+		t.Override(&util.DefaultExecCommand, testutil.
+			CmdRunOut("trivy version --format json", `{"VulnerabilityDB": {"Version": 2, "UpdatedAt": "2024-06-15T06:00:00Z"}}`).
+			AndRunOut("trivy image --format json --quiet "+image, report).
+			AndRunOut("trivy version --format json", `{"VulnerabilityDB": {"Version": 2, "UpdatedAt": "2024-06-15T06:00:00Z"}}`).
+			AndRunOut("trivy version --format json", `{"VulnerabilityDB": {"Version": 2, "UpdatedAt": "2024-06-16T06:00:00Z"}}`).
+			AndRunOut("trivy image --format json --quiet "+image, report))
+		testEvent.InitializeState([]latest.Pipeline{{}})
+		cache := vulnerability.NewCacheFile(t.NewTempDir().Path("vulnerabilities"))
+		runner := New(".", []*latest.TrivyTest{{Severity: "CRITICAL"}}, cache)
+
+		// scanned, cached, then scanned again with a new database
+		for i := 0; i < 3; i++ {
+			t.CheckNoError(runner.Test(context.Background(), io.Discard, image))
+		}
+	})
+}
+
+func TestTrivyDependencies(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		runner := New(".", []*latest.TrivyTest{
+			{Severity: "HIGH", VulnerabilityPolicy: latest.VulnerabilityPolicy{PolicyFile: "/policy.yaml"}},
+			{VulnerabilityPolicy: latest.VulnerabilityPolicy{IgnoreFile: "/.trivyignore"}},
+		}, nil)
+
+		deps, err := runner.TestDependencies(context.Background())
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"/policy.yaml", "/.trivyignore"}, deps)
+	})
+}
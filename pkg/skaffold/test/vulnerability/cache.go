@@ -0,0 +1,186 @@
+package vulnerability
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/constants"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+// maxCacheAge is how long the results of a scan are kept when their image isn't scanned again.
+const maxCacheAge = 30 * 24 * time.Hour
+
+// imageIDTag matches the tags of local images that Skaffold tags with their image ID.
+var imageIDTag = regexp.MustCompile(`^[a-f0-9]{64}$`)
+
+// Scanner scans images for vulnerabilities.
+type Scanner struct {
+	// Name is the name of the scanner in reports.
+	Name string
+	// Scan lists the vulnerabilities of an image.
+	Scan func(ctx context.Context, image string) ([]Vulnerability, error)
+	// DBVersion returns the version of the vulnerability database that the scanner uses.
+	DBVersion func(ctx context.Context) (string, error)
+}
+
+// Cache stores the vulnerabilities found in images by scanner, image digest and version of the vulnerability database.
+// Images found in Skaffold's build cache keep their digest, so they're only scanned again when the database is updated.
+// A nil Cache scans every image.
+type Cache struct {
+	file string
+	// scans runs a single scan of an image at a time, that concurrent tests of the image wait for.
+	scans singleflight.Group
+	// mutex guards the results, but isn't held while scanning.
+	mutex   sync.Mutex
+	results map[string]cacheEntry
+	loaded  bool
+}
+
+type cacheEntry struct {
+	DBVersion       string          `yaml:"dbVersion"`
+	ScannedAt       time.Time       `yaml:"scannedAt"`
+	Vulnerabilities []Vulnerability `yaml:"vulnerabilities,omitempty"`
+}
+
+// NewCache creates a cache of scan results, stored in the Skaffold directory of the user.
+func NewCache() (*Cache, error) {
+	home, err := homedir.Dir()
+	if err != nil {
+		return nil, fmt.Errorf("retrieving home directory: %w", err)
+	}
+	return NewCacheFile(filepath.Join(home, constants.DefaultSkaffoldDir, constants.DefaultVulnerabilitiesFile)), nil
+}
+
+// NewCacheFile creates a cache of scan results stored in the given file.
+func NewCacheFile(file string) *Cache {
+	return &Cache{file: file}
+}
+
+// Scan returns the vulnerabilities of an image, scanning it only when its results aren't cached.
+func (c *Cache) Scan(ctx context.Context, scanner Scanner, image string) ([]Vulnerability, error) {
+	if c == nil {
+		return scanner.Scan(ctx, image)
+	}
+
+	digest := imageDigest(image)
+	if digest == "" {
+		log.Entry(ctx).Debugf("Not caching the %s results of %s since its digest is unknown", scanner.Name, image)
+		return scanner.Scan(ctx, image)
+	}
+	dbVersion, err := scanner.DBVersion(ctx)
+	if err != nil {
+		log.Entry(ctx).Debugf("Not caching the %s results of %s since the version of its database is unknown: %v", scanner.Name, image, err)
+		return scanner.Scan(ctx, image)
+	}
+
+	key := scanner.Name + "/" + digest
+	if vulns, found := c.lookup(ctx, key, dbVersion); found {
+		log.Entry(ctx).Infof("Skipping %s scan of %s, its results are cached", scanner.Name, image)
+		return vulns, nil
+	}
+
+	v, err, _ := c.scans.Do(key+"/"+dbVersion, func() (interface{}, error) {
+		// the image may have been scanned since the lookup
+		if vulns, found := c.lookup(ctx, key, dbVersion); found {
+			return vulns, nil
+		}
+		vulns, err := scanner.Scan(ctx, image)
+		if err != nil {
+			return nil, err
+		}
+		c.store(ctx, key, cacheEntry{DBVersion: dbVersion, ScannedAt: time.Now(), Vulnerabilities: vulns})
+		return vulns, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]Vulnerability), nil
+}
+
+// lookup returns the cached vulnerabilities of an image, if it was scanned with the given version of the database.
+func (c *Cache) lookup(ctx context.Context, key, dbVersion string) ([]Vulnerability, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.load(ctx)
+
+	entry, found := c.results[key]
+	if !found || entry.DBVersion != dbVersion {
+		return nil, false
+	}
+	return entry.Vulnerabilities, true
+}
+
+// store caches the result of a scan.
+func (c *Cache) store(ctx context.Context, key string, entry cacheEntry) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.load(ctx)
+
+	c.results[key] = entry
+	c.save(ctx)
+}
+
+func (c *Cache) load(ctx context.Context) {
+	if c.loaded {
+		return
+	}
+	c.loaded = true
+	c.results = map[string]cacheEntry{}
+
+	b, err := os.ReadFile(c.file)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.Entry(ctx).Warnf("Error reading vulnerability cache, scanning all images: %v", err)
+		}
+		return
+	}
+	if err := yaml.Unmarshal(b, &c.results); err != nil {
+		log.Entry(ctx).Warnf("Error reading vulnerability cache, scanning all images: %v", err)
+		c.results = map[string]cacheEntry{}
+	}
+}
+
+func (c *Cache) save(ctx context.Context) {
+	for key, entry := range c.results {
+		if time.Since(entry.ScannedAt) > maxCacheAge {
+			delete(c.results, key)
+		}
+	}
+
+	b, err := yaml.Marshal(c.results)
+	if err == nil {
+		if err = os.MkdirAll(filepath.Dir(c.file), 0755); err == nil {
+			err = os.WriteFile(c.file, b, 0644)
+		}
+	}
+	if err != nil {
+		log.Entry(ctx).Warnf("Error saving vulnerability cache; images may be scanned again: %v", err)
+	}
+}
+
+// imageDigest returns the digest of an image tagged with its digest, or the ID of a local image tagged with its ID.
+// Other tags can be moved to other images, so their results aren't cached.
+func imageDigest(image string) string {
+	ref, err := docker.ParseReference(image)
+	if err != nil {
+		return ""
+	}
+	if ref.Digest != "" {
+		return ref.Digest
+	}
+	if imageIDTag.MatchString(ref.Tag) {
+		return "sha256:" + ref.Tag
+	}
+	return ""
+}
@@ -0,0 +1,137 @@
+package vulnerability
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestCache(t *testing.T) {
+	const image = "gcr.io/project/image@sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f"
+	vulns := []Vulnerability{
+		{ID: "CVE-1", Package: "openssl", InstalledVersion: "3.0.12", FixedVersion: "3.0.13", Severity: Critical},
+		{ID: "CVE-2", Package: "curl", Severity: Low},
+	}
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		file := t.NewTempDir().Path("vulnerabilities")
+		scans := 0
+		dbVersion := "1"
+		scanner := Scanner{
+			Name: "Scanner",
+			Scan: func(context.Context, string) ([]Vulnerability, error) {
+				scans++
+				return vulns, nil
+			},
+			DBVersion: func(context.Context) (string, error) { return dbVersion, nil },
+		}
+
+		found, err := NewCacheFile(file).Scan(context.Background(), scanner, image)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(vulns, found)
+		t.CheckDeepEqual(1, scans)
+
+		// results are read back from the file
+		found, err = NewCacheFile(file).Scan(context.Background(), scanner, image)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(vulns, found)
+		t.CheckDeepEqual(1, scans)
+
+		dbVersion = "2"
+		_, err = NewCacheFile(file).Scan(context.Background(), scanner, image)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, scans)
+	})
+}
+
+func TestCacheWithoutDBVersion(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		cache := NewCacheFile(t.NewTempDir().Path("vulnerabilities"))
+		scans := 0
+		scanner := Scanner{
+			Name: "Scanner",
+			Scan: func(context.Context, string) ([]Vulnerability, error) {
+				scans++
+				return nil, nil
+			},
+			DBVersion: func(context.Context) (string, error) { return "", errors.New("no database") },
+		}
+
+		for i := 0; i < 2; i++ {
+			_, err := cache.Scan(context.Background(), scanner, "image@sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f")
+			t.CheckNoError(err)
+		}
+		t.CheckDeepEqual(2, scans)
+	})
+}
+
+func TestCacheConcurrentScans(t *testing.T) {
+	const (
+		app = "app@sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f"
+		db  = "db@sha256:1d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f"
+	)
+
+	testutil.Run(t, "", func(t *testutil.T) {
+		cache := NewCacheFile(t.NewTempDir().Path("vulnerabilities"))
+		var scans int32
+		// each scan only finishes once both images are being scanned, so they must run concurrently
+		var started sync.WaitGroup
+		started.Add(2)
+		scanner := Scanner{
+			Name: "Scanner",
+			Scan: func(_ context.Context, image string) ([]Vulnerability, error) {
+				atomic.AddInt32(&scans, 1)
+				started.Done()
+				started.Wait()
+				return []Vulnerability{{ID: image}}, nil
+			},
+			DBVersion: func(context.Context) (string, error) { return "1", nil },
+		}
+
+		var wg sync.WaitGroup
+		results := make([][]Vulnerability, 4)
+		for i, image := range []string{app, db, app, db} {
+			wg.Add(1)
+			go func(i int, image string) {
+				defer wg.Done()
+				results[i], _ = cache.Scan(context.Background(), scanner, image)
+			}(i, image)
+		}
+		wg.Wait()
+
+		t.CheckDeepEqual(int32(2), atomic.LoadInt32(&scans))
+		t.CheckDeepEqual([][]Vulnerability{{{ID: app}}, {{ID: db}}, {{ID: app}}, {{ID: db}}}, results)
+	})
+}
+
+func TestImageDigest(t *testing.T) {
+	tests := []struct {
+		description string
+		image       string
+		expected    string
+	}{
+		{
+			description: "tagged with digest",
+			image:       "gcr.io/project/image:v1@sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f",
+			expected:    "sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f",
+		},
+		{
+			description: "tagged with image id",
+			image:       "image:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f",
+			expected:    "sha256:0d1b6d1bc6a6b9a5fe95c7e4b3a8c8f1e9f0f2c3d4e5f60718293a4b5c6d7e8f",
+		},
+		{
+			description: "mutable tag",
+			image:       "image:latest",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, imageDigest(test.image))
+		})
+	}
+}
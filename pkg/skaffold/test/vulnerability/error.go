@@ -0,0 +1,53 @@
+package vulnerability
+
+import (
+	"fmt"
+	"strings"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util/stringslice"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+)
+
+// ScanErr is returned when a scanner fails to scan an image, or returns a report that can't be read.
+func ScanErr(scanner string, image string, err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("%s failed to scan image %s: %s", scanner, image, err),
+			ErrCode: proto.StatusCode_TEST_VULNERABILITY_SCAN_ERR,
+		},
+	)
+}
+
+func policyConfigErr(err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("invalid vulnerability policy: %s", err),
+			ErrCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	)
+}
+
+func sarifReportErr(path string, err error) error {
+	return sErrors.NewError(err,
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("writing SARIF report %s: %s", path, err),
+			ErrCode: proto.StatusCode_TEST_USER_CONFIG_ERR,
+		},
+	)
+}
+
+func policyErr(scanner string, image string, failed []Finding) error {
+	var ids []string
+	for _, f := range failed {
+		if !stringslice.Contains(ids, f.ID) {
+			ids = append(ids, f.ID)
+		}
+	}
+	return sErrors.NewError(fmt.Errorf("%d vulnerabilities fail the policy", len(failed)),
+		&proto.ActionableErr{
+			Message: fmt.Sprintf("%s found vulnerabilities that fail the policy in image %s: %s", scanner, image, strings.Join(ids, ", ")),
+			ErrCode: proto.StatusCode_TEST_VULNERABILITY_POLICY_ERR,
+		},
+	)
+}
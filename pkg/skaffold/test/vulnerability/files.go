@@ -0,0 +1,69 @@
+package vulnerability
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/yaml"
+)
+
+// policyFile is a policy shared between tests.
+type policyFile struct {
+	Severity   string                       `yaml:"severity,omitempty"`
+	Thresholds map[string]int               `yaml:"thresholds,omitempty"`
+	OnlyFixed  bool                         `yaml:"onlyFixed,omitempty"`
+	Ignore     []latest.VulnerabilityIgnore `yaml:"ignore,omitempty"`
+}
+
+func readPolicyFile(path string) (policyFile, error) {
+	var policy policyFile
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return policy, fmt.Errorf("reading policy file: %w", err)
+	}
+	if err := yaml.UnmarshalStrict(b, &policy); err != nil {
+		return policy, fmt.Errorf("parsing policy file %s: %w", path, err)
+	}
+	for _, i := range policy.Ignore {
+		if i.ID == "" {
+			return policy, fmt.Errorf("parsing policy file %s: ignored vulnerabilities need an id", path)
+		}
+	}
+	return policy, nil
+}
+
+// readIgnoreFile reads the vulnerabilities of an ignore file in the `.trivyignore` format:
+// one identifier per line, optionally followed by `exp:YYYY-MM-DD`, and `#` comments.
+func readIgnoreFile(path string) ([]latest.VulnerabilityIgnore, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading ignore file: %w", err)
+	}
+
+	var ignores []latest.VulnerabilityIgnore
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+
+		i := latest.VulnerabilityIgnore{ID: fields[0]}
+		for _, field := range fields[1:] {
+			if strings.HasPrefix(field, "#") {
+				break
+			}
+			expires, found := strings.CutPrefix(field, "exp:")
+			if !found {
+				return nil, fmt.Errorf("parsing ignore file %s: unexpected %q on line %d", path, field, line)
+			}
+			i.Expires = expires
+		}
+		ignores = append(ignores, i)
+	}
+	return ignores, scanner.Err()
+}
@@ -0,0 +1,101 @@
+package vulnerability
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// trivyReport is the part of the JSON report of `trivy image --format json` that holds the vulnerabilities.
+type trivyReport struct {
+	Results []struct {
+		Target          string `json:"Target"`
+		Vulnerabilities []struct {
+			VulnerabilityID  string `json:"VulnerabilityID"`
+			PkgName          string `json:"PkgName"`
+			InstalledVersion string `json:"InstalledVersion"`
+			FixedVersion     string `json:"FixedVersion"`
+			Severity         string `json:"Severity"`
+			Title            string `json:"Title"`
+			PrimaryURL       string `json:"PrimaryURL"`
+		} `json:"Vulnerabilities"`
+	} `json:"Results"`
+}
+
+// grypeReport is the part of the JSON report of `grype -o json` that holds the vulnerabilities.
+type grypeReport struct {
+	Matches []struct {
+		Vulnerability struct {
+			ID          string `json:"id"`
+			Severity    string `json:"severity"`
+			DataSource  string `json:"dataSource"`
+			Description string `json:"description"`
+			Fix         struct {
+				Versions []string `json:"versions"`
+				State    string   `json:"state"`
+			} `json:"fix"`
+		} `json:"vulnerability"`
+		Artifact struct {
+			Name      string `json:"name"`
+			Version   string `json:"version"`
+			Locations []struct {
+				Path string `json:"path"`
+			} `json:"locations"`
+		} `json:"artifact"`
+	} `json:"matches"`
+}
+
+// ParseTrivy reads the vulnerabilities of a Trivy JSON report.
+func ParseTrivy(b []byte) ([]Vulnerability, error) {
+	var report trivyReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil, fmt.Errorf("parsing trivy report: %w", err)
+	}
+
+	var vulns []Vulnerability
+	for _, result := range report.Results {
+		for _, v := range result.Vulnerabilities {
+			severity, _ := ParseSeverity(v.Severity)
+			vulns = append(vulns, Vulnerability{
+				ID:               v.VulnerabilityID,
+				Package:          v.PkgName,
+				InstalledVersion: v.InstalledVersion,
+				FixedVersion:     v.FixedVersion,
+				Severity:         severity,
+				Title:            v.Title,
+				URL:              v.PrimaryURL,
+				Location:         result.Target,
+			})
+		}
+	}
+	return vulns, nil
+}
+
+// ParseGrype reads the vulnerabilities of a Grype JSON report.
+func ParseGrype(b []byte) ([]Vulnerability, error) {
+	var report grypeReport
+	if err := json.Unmarshal(b, &report); err != nil {
+		return nil, fmt.Errorf("parsing grype report: %w", err)
+	}
+
+	var vulns []Vulnerability
+	for _, m := range report.Matches {
+		severity, _ := ParseSeverity(m.Vulnerability.Severity)
+		v := Vulnerability{
+			ID:               m.Vulnerability.ID,
+			Package:          m.Artifact.Name,
+			InstalledVersion: m.Artifact.Version,
+			Severity:         severity,
+			Title:            m.Vulnerability.Description,
+			URL:              m.Vulnerability.DataSource,
+		}
+		if m.Vulnerability.Fix.State == "fixed" {
+			v.FixedVersion = strings.Join(m.Vulnerability.Fix.Versions, ", ")
+		}
+		if len(m.Artifact.Locations) > 0 {
+			v.Location = m.Artifact.Locations[0].Path
+		}
+		vulns = append(vulns, v)
+	}
+	return vulns, nil
+}
@@ -0,0 +1,151 @@
+package vulnerability
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestParseSeverity(t *testing.T) {
+	tests := []struct {
+		severity  string
+		expected  Severity
+		shouldErr bool
+	}{
+		{severity: "CRITICAL", expected: Critical},
+		{severity: "High", expected: High},
+		{severity: " medium", expected: Medium},
+		{severity: "Negligible", expected: Negligible},
+		{severity: "UNKNOWN", expected: Unknown},
+		{severity: "severe", shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.severity, func(t *testutil.T) {
+			s, err := ParseSeverity(test.severity)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, s)
+		})
+	}
+}
+
+func TestParseTrivy(t *testing.T) {
+	report := `{
+  "SchemaVersion": 2,
+  "ArtifactName": "gcr.io/project/app:abc",
+  "Results": [
+    {
+      "Target": "gcr.io/project/app:abc (debian 12.4)",
+      "Class": "os-pkgs",
+      "Vulnerabilities": [
+        {
+          "VulnerabilityID": "CVE-2023-5678",
+          "PkgName": "libssl3",
+          "InstalledVersion": "3.0.11-1",
+          "FixedVersion": "3.0.13-1",
+          "Severity": "MEDIUM",
+          "Title": "openssl: Generating excessively long X9.42 DH keys is slow",
+          "PrimaryURL": "https://avd.aquasec.com/nvd/cve-2023-5678"
+        }
+      ]
+    },
+    {
+      "Target": "app/package-lock.json",
+      "Class": "lang-pkgs",
+      "Vulnerabilities": [
+        {
+          "VulnerabilityID": "GHSA-xxxx",
+          "PkgName": "lodash",
+          "InstalledVersion": "4.17.15",
+          "Severity": "CRITICAL"
+        }
+      ]
+    },
+    {
+      "Target": "Python",
+      "Class": "lang-pkgs"
+    }
+  ]
+}`
+
+	vulns, err := ParseTrivy([]byte(report))
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []Vulnerability{
+		{
+			ID:               "CVE-2023-5678",
+			Package:          "libssl3",
+			InstalledVersion: "3.0.11-1",
+			FixedVersion:     "3.0.13-1",
+			Severity:         Medium,
+			Title:            "openssl: Generating excessively long X9.42 DH keys is slow",
+			URL:              "https://avd.aquasec.com/nvd/cve-2023-5678",
+			Location:         "gcr.io/project/app:abc (debian 12.4)",
+		},
+		{
+			ID:               "GHSA-xxxx",
+			Package:          "lodash",
+			InstalledVersion: "4.17.15",
+			Severity:         Critical,
+			Location:         "app/package-lock.json",
+		},
+	}, vulns)
+}
+
+func TestParseGrype(t *testing.T) {
+	report := `{
+  "matches": [
+    {
+      "vulnerability": {
+        "id": "CVE-2023-44487",
+        "dataSource": "https://nvd.nist.gov/vuln/detail/CVE-2023-44487",
+        "severity": "High",
+        "description": "HTTP/2 rapid reset",
+        "fix": {"versions": ["0.17.0"], "state": "fixed"}
+      },
+      "artifact": {
+        "name": "golang.org/x/net",
+        "version": "v0.15.0",
+        "type": "go-module",
+        "locations": [{"path": "/app/server"}]
+      }
+    },
+    {
+      "vulnerability": {
+        "id": "CVE-2005-2541",
+        "severity": "Negligible",
+        "fix": {"versions": [], "state": "wont-fix"}
+      },
+      "artifact": {"name": "tar", "version": "1.34"}
+    }
+  ],
+  "source": {"type": "image"}
+}`
+
+	vulns, err := ParseGrype([]byte(report))
+
+	testutil.CheckErrorAndDeepEqual(t, false, err, []Vulnerability{
+		{
+			ID:               "CVE-2023-44487",
+			Package:          "golang.org/x/net",
+			InstalledVersion: "v0.15.0",
+			FixedVersion:     "0.17.0",
+			Severity:         High,
+			Title:            "HTTP/2 rapid reset",
+			URL:              "https://nvd.nist.gov/vuln/detail/CVE-2023-44487",
+			Location:         "/app/server",
+		},
+		{
+			ID:               "CVE-2005-2541",
+			Package:          "tar",
+			InstalledVersion: "1.34",
+			Severity:         Negligible,
+		},
+	}, vulns)
+}
+
+func TestParseInvalidReport(t *testing.T) {
+	_, err := ParseTrivy([]byte("Vulnerabilities found"))
+	testutil.CheckError(t, true, err)
+
+	_, err = ParseGrype([]byte("NAME INSTALLED FIXED-IN"))
+	testutil.CheckError(t, true, err)
+}
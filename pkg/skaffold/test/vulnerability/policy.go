@@ -0,0 +1,229 @@
+package vulnerability
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// expiryLayout is the layout of the expiry dates of ignored vulnerabilities.
+const expiryLayout = "2006-01-02"
+
+// Status tells whether a vulnerability fails the test.
+type Status string
+
+const (
+	// Failed vulnerabilities fail the test.
+	Failed Status = "Failed"
+	// Ignored vulnerabilities are in the ignore list of the policy.
+	Ignored Status = "Ignored"
+	// Allowed vulnerabilities are found, but don't fail the test.
+	Allowed Status = "Allowed"
+)
+
+// Policy decides which vulnerabilities fail a test.
+type Policy struct {
+	failOn     map[Severity]bool
+	thresholds map[Severity]int
+	onlyFixed  bool
+	ignore     []ignore
+	// sarifReport is the path of the SARIF report to write, if any.
+	sarifReport string
+}
+
+type ignore struct {
+	latest.VulnerabilityIgnore
+	expires time.Time
+}
+
+// Finding is a vulnerability and its status under a policy.
+type Finding struct {
+	Vulnerability
+	Status Status
+	// Reason is why an ignored vulnerability is ignored.
+	Reason string
+}
+
+// Result is the evaluation of the vulnerabilities of an image.
+type Result struct {
+	Findings []Finding
+	// Expired lists the ignored vulnerabilities whose exception expired.
+	Expired []latest.VulnerabilityIgnore
+}
+
+// NewPolicy creates a policy from the severity and the policy of a Trivy or Grype test.
+// A single severity fails on that severity or higher, while a comma-separated list only fails on the listed severities.
+func NewPolicy(severity string, cfg latest.VulnerabilityPolicy) (*Policy, error) {
+	p, err := newPolicy(severity, cfg)
+	if err != nil {
+		return nil, policyConfigErr(err)
+	}
+	return p, nil
+}
+
+func newPolicy(severity string, cfg latest.VulnerabilityPolicy) (*Policy, error) {
+	p := &Policy{
+		failOn:      map[Severity]bool{},
+		thresholds:  map[Severity]int{},
+		sarifReport: cfg.SARIFReport,
+	}
+
+	thresholds := map[string]int{}
+	ignores := cfg.Ignore
+	if cfg.PolicyFile != "" {
+		shared, err := readPolicyFile(cfg.PolicyFile)
+		if err != nil {
+			return nil, err
+		}
+		if severity == "" {
+			severity = shared.Severity
+		}
+		for name, max := range shared.Thresholds {
+			thresholds[name] = max
+		}
+		p.onlyFixed = shared.OnlyFixed
+		ignores = append(ignores, shared.Ignore...)
+	}
+	for name, max := range cfg.Thresholds {
+		thresholds[name] = max
+	}
+	if cfg.OnlyFixed != nil {
+		p.onlyFixed = *cfg.OnlyFixed
+	}
+	if cfg.IgnoreFile != "" {
+		listed, err := readIgnoreFile(cfg.IgnoreFile)
+		if err != nil {
+			return nil, err
+		}
+		ignores = append(ignores, listed...)
+	}
+
+	if severity != "" {
+		names := strings.Split(severity, ",")
+		for _, name := range names {
+			s, err := ParseSeverity(name)
+			if err != nil {
+				return nil, err
+			}
+			p.failOn[s] = true
+			if len(names) == 1 {
+				for higher := s + 1; higher <= Critical; higher++ {
+					p.failOn[higher] = true
+				}
+			}
+		}
+	}
+
+	for name, max := range thresholds {
+		s, err := ParseSeverity(name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold: %w", err)
+		}
+		p.thresholds[s] = max
+	}
+
+	for _, i := range ignores {
+		ig := ignore{VulnerabilityIgnore: i}
+		if i.Expires != "" {
+			expires, err := time.Parse(expiryLayout, i.Expires)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiry date %q of ignored vulnerability %s, expected YYYY-MM-DD", i.Expires, i.ID)
+			}
+			// exceptions last until the end of their expiry date
+			ig.expires = expires.AddDate(0, 0, 1)
+		}
+		p.ignore = append(p.ignore, ig)
+	}
+	return p, nil
+}
+
+// Dependencies lists the policy and ignore files of a test, that change the result of the test when edited.
+func Dependencies(cfg latest.VulnerabilityPolicy) []string {
+	var deps []string
+	for _, file := range []string{cfg.PolicyFile, cfg.IgnoreFile} {
+		if file != "" {
+			deps = append(deps, file)
+		}
+	}
+	return deps
+}
+
+// Check evaluates the vulnerabilities that a scanner found in an image, reports them and fails when some of them
+// fail the policy. The source of the image is where SARIF reports locate the vulnerabilities.
+func (p *Policy) Check(ctx context.Context, out io.Writer, scanner, image, source string, vulns []Vulnerability) error {
+	result := p.Evaluate(vulns, time.Now())
+	Report(ctx, out, scanner, image, result)
+
+	if p.sarifReport != "" {
+		if err := WriteSARIF(p.sarifReport, scanner, image, source, result); err != nil {
+			return sarifReportErr(p.sarifReport, err)
+		}
+	}
+	if failed := result.Failed(); len(failed) > 0 {
+		return policyErr(scanner, image, failed)
+	}
+	return nil
+}
+
+// Evaluate decides which vulnerabilities fail the test at a given time.
+func (p *Policy) Evaluate(vulns []Vulnerability, now time.Time) Result {
+	var result Result
+	expired := map[int]bool{}
+	count := map[Severity]int{}
+	for _, v := range vulns {
+		f := Finding{Vulnerability: v, Status: Allowed}
+		if i, found := p.ignored(v); found {
+			if p.ignore[i].expires.IsZero() || now.Before(p.ignore[i].expires) {
+				f.Status = Ignored
+				f.Reason = p.ignore[i].Reason
+				result.Findings = append(result.Findings, f)
+				continue
+			}
+			if !expired[i] {
+				expired[i] = true
+				result.Expired = append(result.Expired, p.ignore[i].VulnerabilityIgnore)
+			}
+		}
+
+		if !p.onlyFixed || v.Fixed() {
+			count[v.Severity]++
+			if p.failOn[v.Severity] {
+				f.Status = Failed
+			}
+		}
+		result.Findings = append(result.Findings, f)
+	}
+
+	// all the vulnerabilities of a severity fail the test when there are more than its threshold
+	for i, f := range result.Findings {
+		max, found := p.thresholds[f.Severity]
+		if found && count[f.Severity] > max && f.Status == Allowed && (!p.onlyFixed || f.Fixed()) {
+			result.Findings[i].Status = Failed
+		}
+	}
+	return result
+}
+
+func (p *Policy) ignored(v Vulnerability) (int, bool) {
+	for i, ig := range p.ignore {
+		if ig.ID == v.ID && (ig.Package == "" || ig.Package == v.Package) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// Failed returns the vulnerabilities that fail the test.
+func (r Result) Failed() []Finding {
+	var failed []Finding
+	for _, f := range r.Findings {
+		if f.Status == Failed {
+			failed = append(failed, f)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,198 @@
+package vulnerability
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/util"
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestPolicy(t *testing.T) {
+	critical := Vulnerability{ID: "CVE-1", Package: "openssl", Severity: Critical, FixedVersion: "3.0.13"}
+	high := Vulnerability{ID: "CVE-2", Package: "curl", Severity: High}
+	otherHigh := Vulnerability{ID: "CVE-3", Package: "zlib", Severity: High, FixedVersion: "1.3"}
+	medium := Vulnerability{ID: "CVE-4", Package: "tar", Severity: Medium, FixedVersion: "1.35"}
+	vulns := []Vulnerability{critical, high, otherHigh, medium}
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description     string
+		severity        string
+		policy          latest.VulnerabilityPolicy
+		expected        []Status
+		expectedExpired []string
+	}{
+		{
+			description: "no policy only reports",
+			expected:    []Status{Allowed, Allowed, Allowed, Allowed},
+		},
+		{
+			description: "severity and higher",
+			severity:    "HIGH",
+			expected:    []Status{Failed, Failed, Failed, Allowed},
+		},
+		{
+			description: "listed severities",
+			severity:    "medium,CRITICAL",
+			expected:    []Status{Failed, Allowed, Allowed, Failed},
+		},
+		{
+			description: "thresholds",
+			policy:      latest.VulnerabilityPolicy{Thresholds: map[string]int{"critical": 1, "high": 1}},
+			expected:    []Status{Allowed, Failed, Failed, Allowed},
+		},
+		{
+			description: "only fixed",
+			severity:    "high",
+			policy:      latest.VulnerabilityPolicy{OnlyFixed: util.Ptr(true), Thresholds: map[string]int{"medium": 0}},
+			expected:    []Status{Failed, Allowed, Failed, Failed},
+		},
+		{
+			description: "only fixed vulnerabilities count towards thresholds",
+			policy:      latest.VulnerabilityPolicy{OnlyFixed: util.Ptr(true), Thresholds: map[string]int{"high": 1}},
+			expected:    []Status{Allowed, Allowed, Allowed, Allowed},
+		},
+		{
+			description: "ignored vulnerabilities",
+			severity:    "high",
+			policy: latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{
+				{ID: "CVE-1", Reason: "not reachable"},
+				{ID: "CVE-2", Package: "other"},
+				{ID: "CVE-3", Expires: "2024-06-15"},
+			}},
+			expected: []Status{Ignored, Failed, Ignored, Allowed},
+		},
+		{
+			description: "expired exception",
+			severity:    "high",
+			policy: latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{
+				{ID: "CVE-1", Expires: "2024-06-14"},
+			}},
+			expected:        []Status{Failed, Failed, Failed, Allowed},
+			expectedExpired: []string{"CVE-1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p, err := NewPolicy(test.severity, test.policy)
+			t.CheckNoError(err)
+
+			result := p.Evaluate(vulns, now)
+
+			var statuses []Status
+			for _, f := range result.Findings {
+				statuses = append(statuses, f.Status)
+			}
+			var expired []string
+			for _, i := range result.Expired {
+				expired = append(expired, i.ID)
+			}
+			t.CheckDeepEqual(test.expected, statuses)
+			t.CheckDeepEqual(test.expectedExpired, expired)
+		})
+	}
+}
+
+func TestPolicyFiles(t *testing.T) {
+	critical := Vulnerability{ID: "CVE-1", Package: "openssl", Severity: Critical}
+	high := Vulnerability{ID: "CVE-2", Package: "curl", Severity: High, FixedVersion: "8.5.0"}
+	otherHigh := Vulnerability{ID: "CVE-3", Package: "zlib", Severity: High}
+	vulns := []Vulnerability{critical, high, otherHigh}
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		description string
+		severity    string
+		policy      latest.VulnerabilityPolicy
+		expected    []Status
+	}{
+		{
+			description: "shared policy",
+			policy:      latest.VulnerabilityPolicy{PolicyFile: "policy.yaml"},
+			expected:    []Status{Ignored, Failed, Allowed},
+		},
+		{
+			description: "test disables only fixed of the shared policy",
+			policy:      latest.VulnerabilityPolicy{PolicyFile: "policy.yaml", OnlyFixed: util.Ptr(false)},
+			expected:    []Status{Ignored, Failed, Failed},
+		},
+		{
+			description: "test overrides the shared policy",
+			severity:    "critical",
+			policy:      latest.VulnerabilityPolicy{PolicyFile: "policy.yaml", Thresholds: map[string]int{"high": 1}},
+			expected:    []Status{Ignored, Allowed, Allowed},
+		},
+		{
+			description: "ignore file",
+			severity:    "high",
+			policy:      latest.VulnerabilityPolicy{IgnoreFile: ".trivyignore"},
+			expected:    []Status{Failed, Ignored, Failed},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().
+				Write("policy.yaml", "severity: high\nonlyFixed: true\nthresholds:\n  high: 0\nignore:\n  - id: CVE-1\n    reason: not reachable\n").
+				Write(".trivyignore", "# accepted risks\nCVE-2 exp:2024-06-30\n\nCVE-3 exp:2024-06-01 # expired\n").
+				Chdir()
+
+			p, err := NewPolicy(test.severity, test.policy)
+			t.CheckNoError(err)
+
+			result := p.Evaluate(vulns, now)
+
+			var statuses []Status
+			for _, f := range result.Findings {
+				statuses = append(statuses, f.Status)
+			}
+			t.CheckDeepEqual(test.expected, statuses)
+		})
+	}
+}
+
+func TestInvalidPolicy(t *testing.T) {
+	tests := []struct {
+		description string
+		severity    string
+		policy      latest.VulnerabilityPolicy
+	}{
+		{
+			description: "unknown severity",
+			severity:    "severe",
+		},
+		{
+			description: "unknown threshold",
+			policy:      latest.VulnerabilityPolicy{Thresholds: map[string]int{"important": 0}},
+		},
+		{
+			description: "invalid expiry date",
+			policy:      latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{{ID: "CVE-1", Expires: "15/06/2024"}}},
+		},
+		{
+			description: "missing policy file",
+			policy:      latest.VulnerabilityPolicy{PolicyFile: "missing.yaml"},
+		},
+		{
+			description: "unknown field in policy file",
+			policy:      latest.VulnerabilityPolicy{PolicyFile: "unknown.yaml"},
+		},
+		{
+			description: "invalid ignore file",
+			policy:      latest.VulnerabilityPolicy{IgnoreFile: "invalid.trivyignore"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().
+				Write("unknown.yaml", "severities: high\n").
+				Write("invalid.trivyignore", "CVE-1 until:2024-06-30\n").
+				Chdir()
+
+			_, err := NewPolicy(test.severity, test.policy)
+
+			t.CheckError(true, err)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package vulnerability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/docker"
+	eventV2 "github.com/ryanharper/skaffold/v2/pkg/skaffold/event/v2"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/output/log"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+)
+
+// securitySeverity is the score of each severity in SARIF reports, that code-scanning UIs use to rank the results.
+var securitySeverity = map[Severity]string{
+	Unknown:    "0.0",
+	Negligible: "0.5",
+	Low:        "2.0",
+	Medium:     "5.5",
+	High:       "8.0",
+	Critical:   "9.5",
+}
+
+// sarifMutex serializes the updates of SARIF reports, that several tests can share.
+var sarifMutex sync.Mutex
+
+// Report prints the result of a scan, and emits an event for each vulnerability.
+func Report(ctx context.Context, out io.Writer, scanner, image string, result Result) {
+	for _, i := range result.Expired {
+		log.Entry(ctx).Warnf("The exception for vulnerability %s expired on %s", i.ID, i.Expires)
+	}
+
+	count := map[Severity]int{}
+	ignored := 0
+	for _, f := range result.Findings {
+		eventV2.VulnerabilityFound(strings.ToLower(scanner), image, f.ID, f.Package, f.InstalledVersion, f.FixedVersion, f.Severity.String(), string(f.Status))
+		if f.Status == Ignored {
+			ignored++
+			continue
+		}
+		count[f.Severity]++
+	}
+
+	var summary []string
+	for s := Critical; s >= Unknown; s-- {
+		if count[s] > 0 {
+			summary = append(summary, fmt.Sprintf("%d %s", count[s], s))
+		}
+	}
+	msg := fmt.Sprintf("%s found %d vulnerabilities in %s", scanner, len(result.Findings)-ignored, image)
+	if len(summary) > 0 {
+		msg += fmt.Sprintf(" (%s)", strings.Join(summary, ", "))
+	}
+	if ignored > 0 {
+		msg += fmt.Sprintf(", %d ignored", ignored)
+	}
+	output.Default.Fprintln(out, msg)
+
+	for _, f := range result.Failed() {
+		fix := "no fix available"
+		if f.Fixed() {
+			fix = "fixed in " + f.FixedVersion
+		}
+		output.Red.Fprintf(out, " - %s (%s) in %s %s, %s\n", f.ID, f.Severity, f.Package, f.InstalledVersion, fix)
+	}
+}
+
+// WriteSARIF writes the vulnerabilities found in an image to a SARIF report, as a run identified by the scanner and the
+// image name. The previous run of the same scanner and image is replaced, while the runs of other images are kept.
+// The results are located in the source of the image, and ignored vulnerabilities are reported as suppressed results.
+func WriteSARIF(path, scanner, image, source string, result Result) error {
+	rules := map[string]sarifRule{}
+	var results []sarifResult
+	for _, f := range result.Findings {
+		if _, found := rules[f.ID]; !found {
+			rules[f.ID] = sarifRule{
+				ID:               f.ID,
+				ShortDescription: sarifMessage{Text: title(f.Vulnerability)},
+				HelpURI:          f.URL,
+				Properties: sarifRuleProperties{
+					SecuritySeverity: securitySeverity[f.Severity],
+					Tags:             []string{"security", "vulnerability", f.Severity.String()},
+				},
+			}
+		}
+
+		r := sarifResult{
+			RuleID: f.ID,
+			Level:  "warning",
+			Message: sarifMessage{
+				Text: fmt.Sprintf("%s %s in %s: %s (%s)", f.Package, f.InstalledVersion, location(f.Vulnerability, image), f.ID, f.Severity),
+			},
+			Locations: []sarifLocation{{PhysicalLocation: sarifPhysicalLocation{
+				ArtifactLocation: sarifArtifactLocation{URI: source},
+			}}},
+		}
+		switch f.Status {
+		case Failed:
+			r.Level = "error"
+		case Ignored:
+			r.Level = "note"
+			r.Suppressions = []sarifSuppression{{Kind: "external", Justification: f.Reason}}
+		}
+		results = append(results, r)
+	}
+
+	var ids []string
+	for id := range rules {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	driver := sarifDriver{Name: scanner}
+	for _, id := range ids {
+		driver.Rules = append(driver.Rules, rules[id])
+	}
+
+	run := sarifRun{
+		Tool:              sarifTool{Driver: driver},
+		AutomationDetails: &sarifAutomationDetails{ID: runID(scanner, image)},
+		Results:           results,
+	}
+
+	sarifMutex.Lock()
+	defer sarifMutex.Unlock()
+
+	report := sarifLog{
+		Version: "2.1.0",
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+	}
+	if b, err := os.ReadFile(path); err == nil {
+		var previous sarifLog
+		if err := json.Unmarshal(b, &previous); err != nil {
+			return fmt.Errorf("parsing existing SARIF report: %w", err)
+		}
+		for _, r := range previous.Runs {
+			if r.AutomationDetails != nil && r.AutomationDetails.ID != run.AutomationDetails.ID {
+				report.Runs = append(report.Runs, r)
+			}
+		}
+	} else if !os.IsNotExist(err) {
+		return fmt.Errorf("reading existing SARIF report: %w", err)
+	}
+	report.Runs = append(report.Runs, run)
+	sort.Slice(report.Runs, func(i, j int) bool {
+		return report.Runs[i].AutomationDetails.ID < report.Runs[j].AutomationDetails.ID
+	})
+
+	b, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating directory of SARIF report: %w", err)
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// runID identifies the run of a scanner on an image, whatever its tag, like `trivy/gcr.io/project/app/`.
+func runID(scanner, image string) string {
+	name := image
+	if ref, err := docker.ParseReference(image); err == nil {
+		name = ref.BaseName
+	}
+	return fmt.Sprintf("%s/%s/", strings.ToLower(scanner), name)
+}
+
+// Source returns the file that the vulnerabilities of an artifact are located in by SARIF reports: its Dockerfile,
+// or its context for the other builders. The path is relative to the current directory, where the repository usually is.
+func Source(a *latest.Artifact) string {
+	if a == nil {
+		return "."
+	}
+	source := a.Workspace
+	if a.DockerArtifact != nil && a.DockerArtifact.DockerfilePath != "" {
+		source = filepath.Join(a.Workspace, a.DockerArtifact.DockerfilePath)
+	}
+	if filepath.IsAbs(source) {
+		if cwd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(cwd, source); err == nil && !strings.HasPrefix(rel, "..") {
+				source = rel
+			}
+		}
+	}
+	if source == "" {
+		source = "."
+	}
+	return filepath.ToSlash(source)
+}
+
+func title(v Vulnerability) string {
+	if v.Title != "" {
+		return v.Title
+	}
+	return fmt.Sprintf("%s in %s", v.ID, v.Package)
+}
+
+func location(v Vulnerability, image string) string {
+	if v.Location != "" {
+		return v.Location
+	}
+	return image
+}
+
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool              sarifTool               `json:"tool"`
+	AutomationDetails *sarifAutomationDetails `json:"automationDetails,omitempty"`
+	Results           []sarifResult           `json:"results"`
+}
+
+type sarifAutomationDetails struct {
+	ID string `json:"id"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID               string              `json:"id"`
+	ShortDescription sarifMessage        `json:"shortDescription"`
+	HelpURI          string              `json:"helpUri,omitempty"`
+	Properties       sarifRuleProperties `json:"properties"`
+}
+
+type sarifRuleProperties struct {
+	SecuritySeverity string   `json:"security-severity"`
+	Tags             []string `json:"tags"`
+}
+
+type sarifResult struct {
+	RuleID       string             `json:"ruleId"`
+	Level        string             `json:"level"`
+	Message      sarifMessage       `json:"message"`
+	Locations    []sarifLocation    `json:"locations"`
+	Suppressions []sarifSuppression `json:"suppressions,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
@@ -0,0 +1,140 @@
+package vulnerability
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"testing"
+
+	sErrors "github.com/ryanharper/skaffold/v2/pkg/skaffold/errors"
+	"github.com/ryanharper/skaffold/v2/pkg/skaffold/schema/latest"
+	"github.com/ryanharper/skaffold/v2/proto/v1"
+	"github.com/ryanharper/skaffold/v2/testutil"
+	testEvent "github.com/ryanharper/skaffold/v2/testutil/event"
+)
+
+func TestCheck(t *testing.T) {
+	vulns := []Vulnerability{
+		{ID: "CVE-1", Package: "openssl", InstalledVersion: "3.0.11", FixedVersion: "3.0.13", Severity: Critical},
+		{ID: "CVE-2", Package: "curl", InstalledVersion: "7.88", Severity: High},
+		{ID: "CVE-3", Package: "tar", InstalledVersion: "1.34", Severity: Low},
+	}
+
+	tests := []struct {
+		description    string
+		severity       string
+		policy         latest.VulnerabilityPolicy
+		expectedOutput string
+		shouldErr      bool
+	}{
+		{
+			description:    "passing",
+			severity:       "critical",
+			policy:         latest.VulnerabilityPolicy{Ignore: []latest.VulnerabilityIgnore{{ID: "CVE-1"}}},
+			expectedOutput: "Trivy found 2 vulnerabilities in app:abc (1 high, 1 low), 1 ignored\n",
+		},
+		{
+			description:    "failing",
+			severity:       "high",
+			expectedOutput: "Trivy found 3 vulnerabilities in app:abc (1 critical, 1 high, 1 low)\n - CVE-1 (critical) in openssl 3.0.11, fixed in 3.0.13\n - CVE-2 (high) in curl 7.88, no fix available\n",
+			shouldErr:      true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latest.Pipeline{{}})
+			p, err := NewPolicy(test.severity, test.policy)
+			t.CheckNoError(err)
+
+			var out bytes.Buffer
+			err = p.Check(context.Background(), &out, "Trivy", "app:abc", "Dockerfile", vulns)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+			if test.shouldErr {
+				var actionable sErrors.Error
+				t.CheckTrue(errors.As(err, &actionable))
+				t.CheckDeepEqual(proto.StatusCode_TEST_VULNERABILITY_POLICY_ERR, actionable.StatusCode())
+			}
+		})
+	}
+}
+
+func TestWriteSARIF(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		tmpDir := t.NewTempDir()
+		result := Result{Findings: []Finding{
+			{Vulnerability: Vulnerability{ID: "CVE-1", Package: "openssl", InstalledVersion: "3.0.11", Severity: Critical, Title: "openssl: bad", URL: "https://cve/1", Location: "debian 12"}, Status: Failed},
+			{Vulnerability: Vulnerability{ID: "CVE-1", Package: "libssl3", InstalledVersion: "3.0.11", Severity: Critical}, Status: Failed},
+			{Vulnerability: Vulnerability{ID: "CVE-2", Package: "curl", InstalledVersion: "7.88", Severity: High}, Status: Ignored, Reason: "not reachable"},
+			{Vulnerability: Vulnerability{ID: "CVE-3", Package: "tar", InstalledVersion: "1.34", Severity: Low}, Status: Allowed},
+		}}
+
+		t.CheckNoError(WriteSARIF(tmpDir.Path("reports/app.sarif"), "Grype", "db:def", "db/Dockerfile", Result{}))
+		t.CheckNoError(WriteSARIF(tmpDir.Path("reports/app.sarif"), "Grype", "app:old", "app/Dockerfile", Result{}))
+		err := WriteSARIF(tmpDir.Path("reports/app.sarif"), "Grype", "app:abc", "app/Dockerfile", result)
+		t.CheckNoError(err)
+
+		var sarif sarifLog
+		t.CheckNoError(json.Unmarshal([]byte(readFile(t, tmpDir.Path("reports/app.sarif"))), &sarif))
+		t.CheckDeepEqual("2.1.0", sarif.Version)
+		t.CheckDeepEqual(2, len(sarif.Runs))
+		t.CheckDeepEqual(&sarifAutomationDetails{ID: "grype/db/"}, sarif.Runs[1].AutomationDetails)
+		run := sarif.Runs[0]
+		t.CheckDeepEqual(&sarifAutomationDetails{ID: "grype/app/"}, run.AutomationDetails)
+		t.CheckDeepEqual("Grype", run.Tool.Driver.Name)
+		t.CheckDeepEqual([]sarifRule{
+			{ID: "CVE-1", ShortDescription: sarifMessage{Text: "openssl: bad"}, HelpURI: "https://cve/1", Properties: sarifRuleProperties{SecuritySeverity: "9.5", Tags: []string{"security", "vulnerability", "critical"}}},
+			{ID: "CVE-2", ShortDescription: sarifMessage{Text: "CVE-2 in curl"}, Properties: sarifRuleProperties{SecuritySeverity: "8.0", Tags: []string{"security", "vulnerability", "high"}}},
+			{ID: "CVE-3", ShortDescription: sarifMessage{Text: "CVE-3 in tar"}, Properties: sarifRuleProperties{SecuritySeverity: "2.0", Tags: []string{"security", "vulnerability", "low"}}},
+		}, run.Tool.Driver.Rules)
+
+		var levels []string
+		for _, r := range run.Results {
+			levels = append(levels, r.Level)
+		}
+		t.CheckDeepEqual([]string{"error", "error", "note", "warning"}, levels)
+		t.CheckDeepEqual("openssl 3.0.11 in debian 12: CVE-1 (critical)", run.Results[0].Message.Text)
+		t.CheckDeepEqual("libssl3 3.0.11 in app:abc: CVE-1 (critical)", run.Results[1].Message.Text)
+		for _, r := range run.Results {
+			t.CheckDeepEqual("app/Dockerfile", r.Locations[0].PhysicalLocation.ArtifactLocation.URI)
+		}
+		t.CheckDeepEqual([]sarifSuppression{{Kind: "external", Justification: "not reachable"}}, run.Results[2].Suppressions)
+	})
+}
+
+func TestSource(t *testing.T) {
+	tests := []struct {
+		description string
+		artifact    *latest.Artifact
+		expected    string
+	}{
+		{
+			description: "dockerfile",
+			artifact:    &latest.Artifact{Workspace: "app", ArtifactType: latest.ArtifactType{DockerArtifact: &latest.DockerArtifact{DockerfilePath: "build/Dockerfile"}}},
+			expected:    "app/build/Dockerfile",
+		},
+		{
+			description: "other builder",
+			artifact:    &latest.Artifact{Workspace: "app", ArtifactType: latest.ArtifactType{KoArtifact: &latest.KoArtifact{}}},
+			expected:    "app",
+		},
+		{
+			description: "no artifact",
+			expected:    ".",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, Source(test.artifact))
+		})
+	}
+}
+
+func readFile(t *testutil.T, path string) string {
+	b, err := os.ReadFile(path)
+	t.CheckNoError(err)
+	return string(b)
+}
@@ -0,0 +1,67 @@
+package vulnerability
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Severity is the severity of a vulnerability, as reported by the scanners.
+type Severity int
+
+const (
+	Unknown Severity = iota
+	Negligible
+	Low
+	Medium
+	High
+	Critical
+)
+
+var severityNames = []string{"unknown", "negligible", "low", "medium", "high", "critical"}
+
+func (s Severity) String() string {
+	if s < Unknown || s > Critical {
+		return severityNames[Unknown]
+	}
+	return severityNames[s]
+}
+
+// ParseSeverity reads a severity written in any case, as Trivy and Grype do.
+func ParseSeverity(s string) (Severity, error) {
+	for i, name := range severityNames {
+		if strings.EqualFold(strings.TrimSpace(s), name) {
+			return Severity(i), nil
+		}
+	}
+	return Unknown, fmt.Errorf("unknown severity %q, expected one of %s", s, strings.Join(severityNames, ", "))
+}
+
+// MarshalText writes the severity by name in the results cache.
+func (s Severity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText reads a severity from the results cache.
+func (s *Severity) UnmarshalText(text []byte) error {
+	severity, err := ParseSeverity(string(text))
+	*s = severity
+	return err
+}
+
+// Vulnerability is a vulnerability found by a scanner in a package of an image.
+type Vulnerability struct {
+	ID               string   `yaml:"id"`
+	Package          string   `yaml:"package,omitempty"`
+	InstalledVersion string   `yaml:"installedVersion,omitempty"`
+	FixedVersion     string   `yaml:"fixedVersion,omitempty"`
+	Severity         Severity `yaml:"severity"`
+	Title            string   `yaml:"title,omitempty"`
+	URL              string   `yaml:"url,omitempty"`
+	// Location is where the package was found in the image, for example the OS or a lock file.
+	Location string `yaml:"location,omitempty"`
+}
+
+// Fixed checks if a version of the package fixes the vulnerability.
+func (v Vulnerability) Fixed() bool {
+	return v.FixedVersion != ""
+}
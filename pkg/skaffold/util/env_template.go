@@ -24,6 +24,7 @@ import (
 	"os/exec"
 	"sort"
 	"strings"
+	"sync"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -39,6 +40,58 @@ var (
 	}
 )
 
+// TemplateVars holds values produced during a run, like Terraform outputs, that templates can reference.
+// They are stored by source, so that a source can replace or drop the values it set before.
+type TemplateVars struct {
+	mutex sync.RWMutex
+	vars  map[string]map[string]string
+}
+
+// NewTemplateVars returns an empty set of template variables.
+func NewTemplateVars() *TemplateVars {
+	return &TemplateVars{vars: map[string]map[string]string{}}
+}
+
+// Set replaces all the values previously set by the same source, and nil or empty values remove them.
+func (t *TemplateVars) Set(source string, vars map[string]string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if len(vars) == 0 {
+		delete(t.vars, source)
+		return
+	}
+	copied := make(map[string]string, len(vars))
+	for k, v := range vars {
+		copied[k] = v
+	}
+	t.vars[source] = copied
+}
+
+// Values returns a copy of the values set by every source. A nil TemplateVars has no values.
+func (t *TemplateVars) Values() map[string]string {
+	vars := map[string]string{}
+	if t == nil {
+		return vars
+	}
+
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	sources := make([]string, 0, len(t.vars))
+	for source := range t.vars {
+		sources = append(sources, source)
+	}
+	sort.Strings(sources)
+
+	for _, source := range sources {
+		for k, v := range t.vars[source] {
+			vars[k] = v
+		}
+	}
+	return vars
+}
+
 // ExpandEnvTemplate parses and executes template s with an optional environment map
 func ExpandEnvTemplate(s string, envMap map[string]string) (string, error) {
 	tmpl, err := ParseEnvTemplate(s)
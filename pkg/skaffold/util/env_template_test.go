@@ -86,6 +86,22 @@ func TestEnvTemplate_ExecuteEnvTemplate(t *testing.T) {
 	}
 }
 
+func TestTemplateVars(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		vars := NewTemplateVars()
+		vars.Set("net", map[string]string{"TF_net_subnet": "subnet-1", "TF_net_old": "stale"})
+		vars.Set("db", map[string]string{"TF_db_host": "10.0.0.2"})
+		vars.Set("net", map[string]string{"TF_net_subnet": "subnet-1"})
+		t.CheckDeepEqual(map[string]string{"TF_net_subnet": "subnet-1", "TF_db_host": "10.0.0.2"}, vars.Values())
+
+		vars.Set("db", nil)
+		t.CheckDeepEqual(map[string]string{"TF_net_subnet": "subnet-1"}, vars.Values())
+
+		var none *TemplateVars
+		t.CheckDeepEqual(map[string]string{}, none.Values())
+	})
+}
+
 func TestEnvTemplate_ExpandEnvTemplateOrFail(t *testing.T) {
 	tests := []struct {
 		description string
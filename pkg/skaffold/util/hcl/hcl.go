@@ -0,0 +1,131 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hcl reads the blocks and string attributes of HCL files, like Terraform configurations and Packer templates,
+// without evaluating them.
+package hcl
+
+import (
+	"strings"
+
+	hclv2 "github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// Block is a block of an HCL file.
+type Block struct {
+	Type   string
+	Labels []string
+	// Attributes holds the strings of the attributes whose value is a string or a list of strings.
+	// Strings with interpolations are kept as written, for instance `${path.root}/setup.sh`.
+	// The attributes of object values are named after the attribute and their key, for instance `config.bucket`.
+	Attributes map[string][]string
+	Blocks     []Block
+}
+
+// Attribute returns the value of an attribute that is a single string.
+func (b Block) Attribute(name string) (string, bool) {
+	if values := b.Attributes[name]; len(values) == 1 {
+		return values[0], true
+	}
+	return "", false
+}
+
+// Parse parses the content of an HCL file and returns its top-level blocks.
+func Parse(src []byte, filename string) ([]Block, error) {
+	f, diags := hclsyntax.ParseConfig(src, filename, hclv2.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	return blocks(src, f.Body.(*hclsyntax.Body)), nil
+}
+
+// Find returns the blocks of a type, at any depth.
+func Find(blocks []Block, blockType string) []Block {
+	var found []Block
+	for _, b := range blocks {
+		if b.Type == blockType {
+			found = append(found, b)
+		}
+		found = append(found, Find(b.Blocks, blockType)...)
+	}
+	return found
+}
+
+func blocks(src []byte, body *hclsyntax.Body) []Block {
+	var parsed []Block
+	for _, b := range body.Blocks {
+		block := Block{
+			Type:       b.Type,
+			Labels:     b.Labels,
+			Attributes: map[string][]string{},
+			Blocks:     blocks(src, b.Body),
+		}
+		for name, attr := range b.Body.Attributes {
+			addAttribute(block.Attributes, src, name, attr.Expr)
+		}
+		parsed = append(parsed, block)
+	}
+	return parsed
+}
+
+func addAttribute(attributes map[string][]string, src []byte, name string, expr hclsyntax.Expression) {
+	switch e := expr.(type) {
+	case *hclsyntax.TupleConsExpr:
+		for _, item := range e.Exprs {
+			if s, ok := stringValue(src, item); ok {
+				attributes[name] = append(attributes[name], s)
+			}
+		}
+	case *hclsyntax.ObjectConsExpr:
+		for _, item := range e.Items {
+			key := hclv2.ExprAsKeyword(item.KeyExpr)
+			if key == "" {
+				if s, ok := stringValue(src, item.KeyExpr); ok {
+					key = s
+				}
+			}
+			if key != "" {
+				addAttribute(attributes, src, name+"."+key, item.ValueExpr)
+			}
+		}
+	default:
+		if s, ok := stringValue(src, expr); ok {
+			attributes[name] = []string{s}
+		}
+	}
+}
+
+// stringValue returns the value of a literal string, or the source of a quoted string with interpolations.
+func stringValue(src []byte, expr hclsyntax.Expression) (string, bool) {
+	if v, diags := expr.Value(nil); !diags.HasErrors() {
+		if v.IsKnown() && !v.IsNull() && v.Type() == cty.String {
+			return v.AsString(), true
+		}
+		return "", false
+	}
+
+	if _, isTemplate := expr.(*hclsyntax.TemplateExpr); !isTemplate {
+		return "", false
+	}
+	raw := string(expr.Range().SliceBytes(src))
+	if len(raw) < 2 || !strings.HasPrefix(raw, `"`) || !strings.HasSuffix(raw, `"`) {
+		// Heredocs with interpolations
+		return "", false
+	}
+	return raw[1 : len(raw)-1], true
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2024 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package hcl
+
+import (
+	"testing"
+
+	"github.com/ryanharper/skaffold/v2/testutil"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		description string
+		src         string
+		blockType   string
+		expected    []Block
+		shouldErr   bool
+	}{
+		{
+			description: "string and list attributes",
+			src: `build {
+  provisioner "shell" {
+    script  = "setup.sh"
+    scripts = ["a.sh", "${path.root}/b.sh", var.script]
+    count   = 2
+  }
+}`,
+			blockType: "provisioner",
+			expected: []Block{{
+				Type:   "provisioner",
+				Labels: []string{"shell"},
+				Attributes: map[string][]string{
+					"script":  {"setup.sh"},
+					"scripts": {"a.sh", "${path.root}/b.sh"},
+				},
+			}},
+		},
+		{
+			description: "object attributes",
+			src: `data "terraform_remote_state" "vpc" {
+  backend = "gcs"
+  config = {
+    bucket   = "tf-state"
+    "prefix" = "network"
+  }
+}`,
+			blockType: "data",
+			expected: []Block{{
+				Type:   "data",
+				Labels: []string{"terraform_remote_state", "vpc"},
+				Attributes: map[string][]string{
+					"backend":       {"gcs"},
+					"config.bucket": {"tf-state"},
+					"config.prefix": {"network"},
+				},
+			}},
+		},
+		{
+			description: "braces in strings and heredocs",
+			src: `provisioner "shell" {
+  execute_command = "sh -c '{{ .Path }}'"
+  inline_shebang  = <<EOT
+}
+EOT
+  script = "setup.sh"
+}`,
+			blockType: "provisioner",
+			expected: []Block{{
+				Type:   "provisioner",
+				Labels: []string{"shell"},
+				Attributes: map[string][]string{
+					"execute_command": {"sh -c '{{ .Path }}'"},
+					"inline_shebang":  {"}\n"},
+					"script":          {"setup.sh"},
+				},
+			}},
+		},
+		{
+			description: "invalid syntax",
+			src:         `build { provisioner "shell" {} }`,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			blocks, err := Parse([]byte(test.src), "test.hcl")
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, Find(blocks, test.blockType))
+			}
+		})
+	}
+}
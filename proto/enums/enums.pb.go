@@ -803,6 +803,8 @@ const (
 	StatusCode_DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR StatusCode = 1030
 	// The Cloud Run Client was unable to delete the service.
 	StatusCode_DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR StatusCode = 1031
+	// The Terraform state is locked by another run.
+	StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED StatusCode = 1032
 	// Error expanding paths
 	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1101
 	// Error running container-structure-test
@@ -829,6 +831,16 @@ const (
 	StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR StatusCode = 1113
 	// Error retrieving the command
 	StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR StatusCode = 1114
+	// Packer test template is invalid
+	StatusCode_TEST_PACKER_VALIDATE_ERR StatusCode = 1115
+	// Packer test template failed against the image
+	StatusCode_TEST_PACKER_RUN_ERR StatusCode = 1116
+	// Vulnerability scanner failed to scan the image
+	StatusCode_TEST_VULNERABILITY_SCAN_ERR StatusCode = 1117
+	// Vulnerabilities found in the image fail the vulnerability policy
+	StatusCode_TEST_VULNERABILITY_POLICY_ERR StatusCode = 1118
+	// Packer test template plugins could not be installed
+	StatusCode_TEST_PACKER_INIT_ERR StatusCode = 1119
 	// Render errors
 	// The Kptfile cannot be created via `kpt pkg init`.
 	StatusCode_RENDER_KPTFILE_INIT_ERR StatusCode = 1501
@@ -886,6 +898,10 @@ const (
 	StatusCode_CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR StatusCode = 1217
 	// Skaffold config version mismatch
 	StatusCode_CONFIG_UPGRADE_ERR StatusCode = 1218
+	// Remote config repository credentials are invalid or expired
+	StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR StatusCode = 1219
+	// Remote dependencies don't match the lock file
+	StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR StatusCode = 1220
 	// Catch-all `skaffold inspect` command error
 	StatusCode_INSPECT_UNKNOWN_ERR StatusCode = 1301
 	// Trying to add new build environment that already exists
@@ -1061,6 +1077,7 @@ var (
 		1029: "DEPLOY_CLOUD_RUN_GET_SERVICE_ERR",
 		1030: "DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR",
 		1031: "DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR",
+		1032: "DEPLOY_TERRAFORM_STATE_LOCKED",
 		1101: "TEST_USER_CONFIG_ERR",
 		1102: "TEST_CST_USER_ERR",
 		1103: "TEST_IMG_PULL_ERR",
@@ -1074,6 +1091,11 @@ var (
 		1112: "TEST_CUSTOM_DEPENDENCIES_CMD_ERR",
 		1113: "TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR",
 		1114: "TEST_CUSTOM_CMD_RETRIEVE_ERR",
+		1115: "TEST_PACKER_VALIDATE_ERR",
+		1116: "TEST_PACKER_RUN_ERR",
+		1117: "TEST_VULNERABILITY_SCAN_ERR",
+		1118: "TEST_VULNERABILITY_POLICY_ERR",
+		1119: "TEST_PACKER_INIT_ERR",
 		1501: "RENDER_KPTFILE_INIT_ERR",
 		1401: "RENDER_KPTFILE_INVALID_YAML_ERR",
 		1402: "RENDER_KPTFILE_INVALID_SCHEMA_ERR",
@@ -1102,6 +1124,8 @@ var (
 		1216: "CONFIG_MISSING_MANIFEST_FILE_ERR",
 		1217: "CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR",
 		1218: "CONFIG_UPGRADE_ERR",
+		1219: "CONFIG_REMOTE_REPO_AUTH_ERR",
+		1220: "CONFIG_LOCK_FILE_OUT_OF_DATE_ERR",
 		1301: "INSPECT_UNKNOWN_ERR",
 		1302: "INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR",
 		1303: "INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR",
@@ -1269,6 +1293,7 @@ var (
 		"DEPLOY_CLOUD_RUN_GET_SERVICE_ERR":                 1029,
 		"DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR":              1030,
 		"DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR":              1031,
+		"DEPLOY_TERRAFORM_STATE_LOCKED":                    1032,
 		"TEST_USER_CONFIG_ERR":                             1101,
 		"TEST_CST_USER_ERR":                                1102,
 		"TEST_IMG_PULL_ERR":                                1103,
@@ -1282,6 +1307,11 @@ var (
 		"TEST_CUSTOM_DEPENDENCIES_CMD_ERR":                 1112,
 		"TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR":          1113,
 		"TEST_CUSTOM_CMD_RETRIEVE_ERR":                     1114,
+		"TEST_PACKER_VALIDATE_ERR":                         1115,
+		"TEST_PACKER_RUN_ERR":                              1116,
+		"TEST_VULNERABILITY_SCAN_ERR":                      1117,
+		"TEST_VULNERABILITY_POLICY_ERR":                    1118,
+		"TEST_PACKER_INIT_ERR":                             1119,
 		"RENDER_KPTFILE_INIT_ERR":                          1501,
 		"RENDER_KPTFILE_INVALID_YAML_ERR":                  1401,
 		"RENDER_KPTFILE_INVALID_SCHEMA_ERR":                1402,
@@ -1310,6 +1340,8 @@ var (
 		"CONFIG_MISSING_MANIFEST_FILE_ERR":                 1216,
 		"CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR":           1217,
 		"CONFIG_UPGRADE_ERR":                               1218,
+		"CONFIG_REMOTE_REPO_AUTH_ERR":                      1219,
+		"CONFIG_LOCK_FILE_OUT_OF_DATE_ERR":                 1220,
 		"INSPECT_UNKNOWN_ERR":                              1301,
 		"INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR":             1302,
 		"INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR":             1303,
@@ -1418,6 +1450,10 @@ const (
 	SuggestionCode_INSTALL_KUBECTL SuggestionCode = 220
 	// Specify Cloud Run Location
 	SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION SuggestionCode = 230
+	// Wait for the Terraform state lock to be released
+	SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK SuggestionCode = 240
+	// Release a stale Terraform state lock
+	SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE SuggestionCode = 241
 	// Container run error
 	SuggestionCode_CHECK_CONTAINER_LOGS SuggestionCode = 301
 	// Pod Health check error
@@ -1483,6 +1519,10 @@ const (
 	SuggestionCode_CONFIG_ENABLE_REMOTE_REPO_SYNC SuggestionCode = 711
 	// Upgrade skaffold config version to latest
 	SuggestionCode_CONFIG_FIX_SKAFFOLD_CONFIG_VERSION SuggestionCode = 712
+	// Refresh the credentials of the remote config repository
+	SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS SuggestionCode = 713
+	// Update the lock file of remote dependencies
+	SuggestionCode_CONFIG_UPDATE_LOCK_FILE SuggestionCode = 714
 	// Create new build env in a profile instead, or use the 'modify' command
 	SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE SuggestionCode = 800
 	// Check profile selection, or use the 'add' command instead
@@ -1534,6 +1574,8 @@ var (
 		209:  "ALIGN_KPT_INVENTORY",
 		220:  "INSTALL_KUBECTL",
 		230:  "SPECIFY_CLOUD_RUN_LOCATION",
+		240:  "WAIT_FOR_TERRAFORM_STATE_LOCK",
+		241:  "FORCE_UNLOCK_TERRAFORM_STATE",
 		301:  "CHECK_CONTAINER_LOGS",
 		302:  "CHECK_READINESS_PROBE",
 		303:  "CHECK_CONTAINER_IMAGE",
@@ -1566,6 +1608,8 @@ var (
 		710:  "CONFIG_FIX_MISSING_MANIFEST_FILE",
 		711:  "CONFIG_ENABLE_REMOTE_REPO_SYNC",
 		712:  "CONFIG_FIX_SKAFFOLD_CONFIG_VERSION",
+		713:  "CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS",
+		714:  "CONFIG_UPDATE_LOCK_FILE",
 		800:  "INSPECT_USE_MODIFY_OR_NEW_PROFILE",
 		801:  "INSPECT_USE_ADD_BUILD_ENV",
 		802:  "INSPECT_CHECK_INPUT_PROFILE",
@@ -1609,6 +1653,8 @@ var (
 		"ALIGN_KPT_INVENTORY":                                    209,
 		"INSTALL_KUBECTL":                                        220,
 		"SPECIFY_CLOUD_RUN_LOCATION":                             230,
+		"WAIT_FOR_TERRAFORM_STATE_LOCK":                          240,
+		"FORCE_UNLOCK_TERRAFORM_STATE":                           241,
 		"CHECK_CONTAINER_LOGS":                                   301,
 		"CHECK_READINESS_PROBE":                                  302,
 		"CHECK_CONTAINER_IMAGE":                                  303,
@@ -1641,6 +1687,8 @@ var (
 		"CONFIG_FIX_MISSING_MANIFEST_FILE":                       710,
 		"CONFIG_ENABLE_REMOTE_REPO_SYNC":                         711,
 		"CONFIG_FIX_SKAFFOLD_CONFIG_VERSION":                     712,
+		"CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS":                 713,
+		"CONFIG_UPDATE_LOCK_FILE":                                714,
 		"INSPECT_USE_MODIFY_OR_NEW_PROFILE":                      800,
 		"INSPECT_USE_ADD_BUILD_ENV":                              801,
 		"INSPECT_CHECK_INPUT_PROFILE":                            802,
@@ -1725,7 +1773,7 @@ var file_enums_proto_rawDesc = []byte{
 	0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04, 0x12, 0x09, 0x0a,
 	0x05, 0x50, 0x41, 0x4e, 0x49, 0x43, 0x10, 0x05, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43,
 	0x45, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x53, 0x54, 0x41, 0x4e, 0x44, 0x41, 0x52, 0x44, 0x10,
-	0x07, 0x2a, 0xd0, 0x37, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x07, 0x2a, 0xd7, 0x39, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
 	0x12, 0x06, 0x0a, 0x02, 0x4f, 0x4b, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x13, 0x53, 0x54, 0x41, 0x54,
 	0x55, 0x53, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10,
 	0xc8, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x53, 0x55, 0x43, 0x43,
@@ -2063,264 +2111,290 @@ var file_enums_proto_rawDesc = []byte{
 	0x52, 0x56, 0x49, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x86, 0x08, 0x12, 0x28, 0x0a, 0x23,
 	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e,
 	0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x53, 0x45, 0x52, 0x56, 0x49, 0x43, 0x45, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x87, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x55,
-	0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcd,
-	0x08, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x53, 0x54, 0x5f, 0x55, 0x53,
-	0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53,
-	0x54, 0x5f, 0x49, 0x4d, 0x47, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcf,
-	0x08, 0x12, 0x1e, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
-	0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd0,
-	0x08, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
-	0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e, 0x5f, 0x5a, 0x45, 0x52,
-	0x4f, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd1, 0x08, 0x12, 0x25, 0x0a,
-	0x20, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44,
-	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xd2, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53,
-	0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd3, 0x08, 0x12, 0x26, 0x0a, 0x21,
-	0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f,
-	0x52, 0x55, 0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xd4, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53,
-	0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x58, 0x49, 0x54,
-	0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd6, 0x08, 0x12, 0x1c, 0x0a, 0x17, 0x54, 0x45, 0x53,
-	0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x45, 0x53, 0x54, 0x5f,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43,
-	0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd8, 0x08, 0x12, 0x2c,
-	0x0a, 0x27, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45,
-	0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x55, 0x4e, 0x4d, 0x41, 0x52,
-	0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9, 0x08, 0x12, 0x21, 0x0a, 0x1c,
-	0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f,
-	0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xda, 0x08, 0x12,
-	0x1c, 0x0a, 0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdd, 0x0b, 0x12, 0x24, 0x0a,
-	0x1f, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f,
-	0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xf9, 0x0a, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50,
-	0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43,
-	0x48, 0x45, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfa, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52,
-	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50,
-	0x41, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfb, 0x0a, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45,
-	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x41,
-	0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfc,
-	0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4c,
-	0x41, 0x43, 0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfd, 0x0a,
-	0x12, 0x22, 0x0a, 0x1d, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
-	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xfe, 0x0a, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53,
-	0x45, 0x54, 0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xff, 0x0a, 0x12,
-	0x1e, 0x0a, 0x19, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45,
-	0x53, 0x54, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x80, 0x0b, 0x12,
-	0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f,
-	0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0x81, 0x0b, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xb1, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46,
+	0x45, 0x52, 0x52, 0x10, 0x87, 0x08, 0x12, 0x22, 0x0a, 0x1d, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59,
+	0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x45, 0x44, 0x10, 0x88, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x54, 0x45,
+	0x53, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xcd, 0x08, 0x12, 0x16, 0x0a, 0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x53,
+	0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x08, 0x12, 0x16, 0x0a,
+	0x11, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x47, 0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xcf, 0x08, 0x12, 0x1e, 0x0a, 0x19, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55,
+	0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xd0, 0x08, 0x12, 0x2a, 0x0a, 0x25, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55,
+	0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4e, 0x4f, 0x4e,
+	0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x45, 0x58, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd1,
+	0x08, 0x12, 0x25, 0x0a, 0x20, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x44, 0x4f, 0x55,
+	0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd2, 0x08, 0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd3, 0x08,
+	0x12, 0x26, 0x0a, 0x21, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
+	0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x58, 0x45, 0x43, 0x55, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd4, 0x08, 0x12, 0x23, 0x0a, 0x1e, 0x54, 0x45, 0x53, 0x54,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f,
+	0x45, 0x58, 0x49, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd6, 0x08, 0x12, 0x1c, 0x0a,
+	0x17, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4d, 0x44,
+	0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x08, 0x12, 0x25, 0x0a, 0x20, 0x54,
+	0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e,
+	0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xd8, 0x08, 0x12, 0x2c, 0x0a, 0x27, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f,
+	0x4d, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x55,
+	0x4e, 0x4d, 0x41, 0x52, 0x53, 0x48, 0x41, 0x4c, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9, 0x08,
+	0x12, 0x21, 0x0a, 0x1c, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
+	0x43, 0x4d, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x49, 0x45, 0x56, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xda, 0x08, 0x12, 0x1d, 0x0a, 0x18, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xdb, 0x08, 0x12, 0x18, 0x0a, 0x13, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x52, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdc, 0x08, 0x12, 0x20, 0x0a, 0x1b,
+	0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49, 0x4c, 0x49,
+	0x54, 0x59, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdd, 0x08, 0x12, 0x22,
+	0x0a, 0x1d, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x56, 0x55, 0x4c, 0x4e, 0x45, 0x52, 0x41, 0x42, 0x49,
+	0x4c, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xde, 0x08, 0x12, 0x19, 0x0a, 0x14, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
+	0x52, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdf, 0x08, 0x12, 0x1c, 0x0a,
+	0x17, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x49, 0x4e, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdd, 0x0b, 0x12, 0x24, 0x0a, 0x1f, 0x52,
+	0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xf9,
+	0x0a, 0x12, 0x26, 0x0a, 0x21, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4b, 0x50, 0x54, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45,
+	0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfa, 0x0a, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e,
+	0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfb, 0x0a, 0x12, 0x25, 0x0a, 0x20, 0x52, 0x45, 0x4e, 0x44,
+	0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x5f, 0x41, 0x4c, 0x52,
+	0x45, 0x41, 0x44, 0x59, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfc, 0x0a, 0x12,
+	0x1d, 0x0a, 0x18, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x43,
+	0x45, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xfd, 0x0a, 0x12, 0x22,
+	0x0a, 0x1d, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x4f,
+	0x52, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xfe, 0x0a, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54,
+	0x5f, 0x4c, 0x41, 0x42, 0x45, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xff, 0x0a, 0x12, 0x1e, 0x0a,
+	0x19, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54,
+	0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x80, 0x0b, 0x12, 0x25, 0x0a,
+	0x20, 0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x45, 0x5f, 0x4d, 0x41,
+	0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x53, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0x81, 0x0b, 0x12, 0x1c, 0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46,
+	0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xb1, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xb2, 0x09, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x50,
+	0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
+	0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb3, 0x09, 0x12, 0x29, 0x0a, 0x24, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x53, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
+	0x10, 0xb4, 0x09, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x55,
+	0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x41, 0x43,
+	0x52, 0x4f, 0x53, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb5,
+	0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x42, 0x41, 0x44, 0x5f,
+	0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb6, 0x09, 0x12, 0x1a, 0x0a,
+	0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x46, 0x4f, 0x55,
+	0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb7, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c,
+	0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb8, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x55,
+	0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb9, 0x09, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x53, 0x5f, 0x53,
+	0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xba, 0x09, 0x12, 0x2d, 0x0a, 0x28, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbb,
+	0x09, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x49, 0x4c, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xbc, 0x09, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbd, 0x09, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x56, 0x41, 0x4c,
+	0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x10, 0xbe, 0x09, 0x12, 0x1f, 0x0a, 0x1a, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x10, 0xbf, 0x09, 0x12, 0x25, 0x0a, 0x20, 0x43, 0x4f,
+	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e,
+	0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc0,
+	0x09, 0x12, 0x2b, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
+	0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc1, 0x09, 0x12, 0x17,
+	0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0xc2, 0x09, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x47, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x41, 0x55,
+	0x54, 0x48, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc3, 0x09, 0x12, 0x25, 0x0a, 0x20, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4f, 0x55,
+	0x54, 0x5f, 0x4f, 0x46, 0x5f, 0x44, 0x41, 0x54, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc4, 0x09,
+	0x12, 0x18, 0x0a, 0x13, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x95, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f,
+	0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0x96, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54,
+	0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x0a,
+	0x12, 0x22, 0x0a, 0x1d, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46,
 	0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xb2, 0x09, 0x12, 0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44,
-	0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f,
-	0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb3, 0x09, 0x12, 0x29, 0x0a, 0x24, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x41, 0x4d, 0x45, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xb4, 0x09, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x44, 0x55, 0x50, 0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x41, 0x43, 0x52, 0x4f, 0x53, 0x53, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xb5, 0x09, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x42, 0x41,
-	0x44, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb6, 0x09, 0x12,
-	0x1a, 0x0a, 0x15, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x5a, 0x45, 0x52, 0x4f, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb7, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x59, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x49, 0x4c, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb8, 0x09, 0x12, 0x1e, 0x0a, 0x19, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x56, 0x41,
-	0x4c, 0x55, 0x45, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xb9, 0x09, 0x12, 0x27, 0x0a, 0x22, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x53,
-	0x5f, 0x53, 0x55, 0x42, 0x53, 0x54, 0x49, 0x54, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xba, 0x09, 0x12, 0x2d, 0x0a, 0x28, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d,
-	0x55, 0x4c, 0x54, 0x49, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xbb, 0x09, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44,
-	0x5f, 0x45, 0x52, 0x52, 0x10, 0xbc, 0x09, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x56, 0x45,
-	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xbd, 0x09, 0x12, 0x1d, 0x0a, 0x18,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x56,
-	0x41, 0x4c, 0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x10, 0xbe, 0x09, 0x12, 0x1f, 0x0a, 0x1a, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x54, 0x52,
-	0x41, 0x4e, 0x53, 0x46, 0x4f, 0x52, 0x4d, 0x45, 0x52, 0x10, 0xbf, 0x09, 0x12, 0x25, 0x0a, 0x20,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d,
-	0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0xc0, 0x09, 0x12, 0x2b, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x52, 0x45,
-	0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f,
-	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc1, 0x09,
-	0x12, 0x17, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x50, 0x47, 0x52, 0x41,
-	0x44, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xc2, 0x09, 0x12, 0x18, 0x0a, 0x13, 0x49, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52,
-	0x10, 0x95, 0x0a, 0x12, 0x29, 0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59,
-	0x5f, 0x45, 0x58, 0x49, 0x53, 0x54, 0x53, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x96, 0x0a, 0x12, 0x29,
-	0x0a, 0x24, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
-	0x45, 0x4e, 0x56, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x97, 0x0a, 0x12, 0x22, 0x0a, 0x1d, 0x49, 0x4e, 0x53,
-	0x50, 0x45, 0x43, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4e, 0x4f, 0x54,
-	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x98, 0x0a, 0x12, 0x26, 0x0a,
-	0x21, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55,
-	0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55,
-	0x4e, 0x44, 0x10, 0xc1, 0x0c, 0x12, 0x27, 0x0a, 0x22, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f,
-	0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f,
-	0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xc2, 0x0c, 0x12, 0x24,
-	0x0a, 0x1f, 0x4c, 0x4f, 0x47, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x52, 0x55, 0x4e,
-	0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e,
-	0x44, 0x10, 0xc3, 0x0c, 0x2a, 0xa5, 0x12, 0x0a, 0x0e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x07, 0x0a, 0x03, 0x4e, 0x49, 0x4c, 0x10, 0x00,
-	0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x44, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
-	0x52, 0x45, 0x50, 0x4f, 0x10, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x65, 0x12, 0x24,
-	0x0a, 0x20, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f,
-	0x52, 0x45, 0x50, 0x4f, 0x5f, 0x47, 0x4c, 0x4f, 0x42, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x44,
-	0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
-	0x47, 0x55, 0x52, 0x45, 0x10, 0x67, 0x12, 0x19, 0x0a, 0x15, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
-	0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10,
-	0x68, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55,
-	0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x69, 0x12, 0x18, 0x0a, 0x14, 0x43,
-	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
-	0x49, 0x4e, 0x47, 0x10, 0x6a, 0x12, 0x16, 0x0a, 0x12, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x53, 0x45,
-	0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0x6b, 0x12, 0x16, 0x0a,
-	0x12, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45,
-	0x54, 0x52, 0x59, 0x10, 0x6c, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x49, 0x58, 0x5f, 0x43, 0x41, 0x43,
-	0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x6d, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x49, 0x58,
-	0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x6e, 0x12, 0x20, 0x0a,
-	0x1c, 0x46, 0x49, 0x58, 0x5f, 0x4a, 0x49, 0x42, 0x5f, 0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f,
-	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x6f, 0x12,
-	0x25, 0x0a, 0x21, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45,
+	0x52, 0x10, 0x98, 0x0a, 0x12, 0x26, 0x0a, 0x21, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52,
+	0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f,
+	0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc1, 0x0c, 0x12, 0x27, 0x0a, 0x22,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x57, 0x41, 0x52, 0x44, 0x5f, 0x52, 0x55, 0x4e,
+	0x5f, 0x50, 0x52, 0x4f, 0x58, 0x59, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x10, 0xc2, 0x0c, 0x12, 0x24, 0x0a, 0x1f, 0x4c, 0x4f, 0x47, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x4e,
+	0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0xc3, 0x0c, 0x2a, 0xb7, 0x13, 0x0a, 0x0e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x07,
+	0x0a, 0x03, 0x4e, 0x49, 0x4c, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x44, 0x44, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x10, 0x64, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52,
+	0x45, 0x50, 0x4f, 0x10, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44,
+	0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f, 0x47, 0x4c, 0x4f, 0x42,
+	0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x47,
+	0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54,
+	0x48, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x67, 0x12, 0x19, 0x0a,
+	0x15, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x41, 0x55, 0x54, 0x48, 0x5f, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x55, 0x52, 0x45, 0x10, 0x68, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x47, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54,
+	0x10, 0x69, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b,
+	0x45, 0x52, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x6a, 0x12, 0x16, 0x0a, 0x12,
+	0x46, 0x49, 0x58, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0x6b, 0x12, 0x16, 0x0a, 0x12, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x42,
+	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x10, 0x6c, 0x12, 0x22, 0x0a, 0x1e,
+	0x46, 0x49, 0x58, 0x5f, 0x43, 0x41, 0x43, 0x48, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x41,
+	0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x10, 0x6d,
+	0x12, 0x22, 0x0a, 0x1e, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44,
+	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x46, 0x49,
+	0x4c, 0x45, 0x10, 0x6e, 0x12, 0x20, 0x0a, 0x1c, 0x46, 0x49, 0x58, 0x5f, 0x4a, 0x49, 0x42, 0x5f,
+	0x50, 0x4c, 0x55, 0x47, 0x49, 0x4e, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x55, 0x52, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x6f, 0x12, 0x25, 0x0a, 0x21, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f,
+	0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x70, 0x12, 0x2a, 0x0a,
+	0x26, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45,
 	0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f,
-	0x4e, 0x41, 0x4d, 0x45, 0x10, 0x70, 0x12, 0x2a, 0x0a, 0x26, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47,
-	0x10, 0x71, 0x12, 0x3a, 0x0a, 0x36, 0x46, 0x49, 0x58, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
-	0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x48,
-	0x45, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f,
-	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x72, 0x12, 0x14,
-	0x0a, 0x10, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x55,
-	0x4e, 0x45, 0x10, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x45, 0x54, 0x5f, 0x43, 0x4c, 0x45, 0x41,
-	0x4e, 0x55, 0x50, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x74, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x55,
-	0x49, 0x4c, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f,
-	0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10, 0x75, 0x12,
-	0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
-	0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54,
-	0x4f, 0x52, 0x53, 0x10, 0x76, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x54, 0x5f, 0x50, 0x55, 0x53,
-	0x48, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f,
-	0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10, 0x77, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x10, 0xca, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c,
-	0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcb, 0x01, 0x12, 0x11, 0x0a, 0x0c, 0x55, 0x50, 0x47, 0x52,
-	0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcc, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x46,
-	0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x41, 0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54,
-	0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45, 0x53, 0x10, 0xcd, 0x01, 0x12, 0x13, 0x0a,
-	0x0e, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x33, 0x32, 0x10,
-	0xce, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f,
-	0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43,
-	0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10,
-	0xcf, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4b, 0x50,
-	0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x53, 0x10, 0xd0, 0x01, 0x12, 0x18,
-	0x0a, 0x13, 0x41, 0x4c, 0x49, 0x47, 0x4e, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x45,
-	0x4e, 0x54, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12, 0x14, 0x0a, 0x0f, 0x49, 0x4e, 0x53, 0x54,
-	0x41, 0x4c, 0x4c, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43, 0x54, 0x4c, 0x10, 0xdc, 0x01, 0x12, 0x1f,
-	0x0a, 0x1a, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x59, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f,
-	0x52, 0x55, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xe6, 0x01, 0x12,
-	0x19, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
-	0x45, 0x52, 0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0xad, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x52,
-	0x4f, 0x42, 0x45, 0x10, 0xae, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10,
-	0xaf, 0x02, 0x12, 0x21, 0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f,
-	0x44, 0x45, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55,
-	0x52, 0x45, 0x10, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53,
-	0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53,
-	0x55, 0x52, 0x45, 0x10, 0x91, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53,
-	0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55,
-	0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92, 0x03, 0x12, 0x1e, 0x0a,
-	0x19, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x49,
-	0x44, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x93, 0x03, 0x12, 0x1f, 0x0a,
-	0x1a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e,
-	0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x94, 0x03, 0x12, 0x1d,
-	0x0a, 0x18, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55,
-	0x4e, 0x52, 0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95, 0x03, 0x12, 0x1b, 0x0a,
-	0x16, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f,
-	0x54, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x41, 0x44,
-	0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x53, 0x43, 0x48,
-	0x45, 0x44, 0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97, 0x03, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0x98, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f,
-	0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0xf5, 0x03, 0x12, 0x15, 0x0a, 0x10, 0x55,
-	0x4e, 0x50, 0x41, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10,
-	0xf6, 0x03, 0x12, 0x14, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
-	0x5f, 0x50, 0x55, 0x4c, 0x4c, 0x10, 0xa7, 0x04, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x45, 0x54, 0x5f,
-	0x52, 0x45, 0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x4c,
-	0x49, 0x4e, 0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0xd8, 0x04, 0x12, 0x18, 0x0a, 0x13,
-	0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x49,
-	0x4e, 0x49, 0x54, 0x10, 0xd9, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c,
-	0x45, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0xda, 0x04, 0x12,
-	0x24, 0x0a, 0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50,
-	0x41, 0x43, 0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53,
-	0x54, 0x53, 0x10, 0xdb, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10,
-	0xbc, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45,
-	0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x45,
-	0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xbd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
-	0x45, 0x53, 0x10, 0xbe, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0xbf, 0x05, 0x12,
-	0x24, 0x0a, 0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49,
-	0x4f, 0x4e, 0x10, 0xc0, 0x05, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54,
-	0x49, 0x4f, 0x4e, 0x10, 0xc1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
-	0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53,
-	0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc2, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x43,
-	0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x56, 0x45,
-	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xc3, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46,
-	0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0xc4, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x10, 0xc5, 0x05, 0x12, 0x25,
-	0x0a, 0x20, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x4d, 0x49, 0x53,
-	0x53, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49,
-	0x4c, 0x45, 0x10, 0xc6, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f,
-	0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45,
-	0x50, 0x4f, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc7, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f,
-	0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c,
-	0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e,
-	0x10, 0xc8, 0x05, 0x12, 0x26, 0x0a, 0x21, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55,
-	0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49, 0x46, 0x59, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x45, 0x57,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa0, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x49,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x42,
-	0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e, 0x56, 0x10, 0xa1, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x49,
-	0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x50,
-	0x55, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xa2, 0x06, 0x12, 0x0f, 0x0a,
-	0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x10, 0x84, 0x07, 0x12, 0x19,
-	0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43,
-	0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10, 0xe8, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x46, 0x49, 0x58,
-	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f,
-	0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xe9, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x43, 0x48,
-	0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41,
-	0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f,
-	0x43, 0x4d, 0x44, 0x10, 0xea, 0x07, 0x12, 0x2c, 0x0a, 0x27, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
-	0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44,
-	0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x50, 0x41, 0x54, 0x48,
-	0x53, 0x10, 0xeb, 0x07, 0x12, 0x26, 0x0a, 0x21, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x45,
-	0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49,
-	0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0xec, 0x07, 0x42, 0x36, 0x5a, 0x34,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x2f,
-	0x73, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65,
-	0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x71, 0x12, 0x3a, 0x0a, 0x36, 0x46, 0x49, 0x58,
+	0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x57, 0x48, 0x45, 0x4e, 0x5f, 0x45, 0x58, 0x54, 0x52, 0x41, 0x43,
+	0x54, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x4e,
+	0x41, 0x4d, 0x45, 0x10, 0x72, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43,
+	0x4b, 0x45, 0x52, 0x5f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x10, 0x73, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x45, 0x54, 0x5f, 0x43, 0x4c, 0x45, 0x41, 0x4e, 0x55, 0x50, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x10,
+	0x74, 0x12, 0x23, 0x0a, 0x1f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d, 0x5f,
+	0x46, 0x4c, 0x41, 0x47, 0x10, 0x75, 0x12, 0x24, 0x0a, 0x20, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x54, 0x46, 0x4f, 0x52, 0x4d,
+	0x5f, 0x45, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0x76, 0x12, 0x23, 0x0a, 0x1f,
+	0x53, 0x45, 0x54, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x43, 0x4f, 0x4e,
+	0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x59, 0x10,
+	0x77, 0x12, 0x1d, 0x0a, 0x18, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc9, 0x01,
+	0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55,
+	0x42, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0xca, 0x01, 0x12, 0x11, 0x0a, 0x0c,
+	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10, 0xcb, 0x01, 0x12,
+	0x11, 0x0a, 0x0c, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x10,
+	0xcc, 0x01, 0x12, 0x30, 0x0a, 0x2b, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f,
+	0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x41,
+	0x52, 0x54, 0x49, 0x46, 0x41, 0x43, 0x54, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x52, 0x49, 0x44, 0x45,
+	0x53, 0x10, 0xcd, 0x01, 0x12, 0x13, 0x0a, 0x0e, 0x55, 0x50, 0x47, 0x52, 0x41, 0x44, 0x45, 0x5f,
+	0x48, 0x45, 0x4c, 0x4d, 0x33, 0x32, 0x10, 0xce, 0x01, 0x12, 0x2e, 0x0a, 0x29, 0x46, 0x49, 0x58,
+	0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x48, 0x45, 0x4c, 0x4d, 0x5f, 0x43, 0x52, 0x45, 0x41, 0x54, 0x45, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x53, 0x50, 0x41, 0x43, 0x45, 0x10, 0xcf, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x4b, 0x50, 0x54, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53,
+	0x54, 0x53, 0x10, 0xd0, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x41, 0x4c, 0x49, 0x47, 0x4e, 0x5f, 0x4b,
+	0x50, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x45, 0x4e, 0x54, 0x4f, 0x52, 0x59, 0x10, 0xd1, 0x01, 0x12,
+	0x14, 0x0a, 0x0f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x43,
+	0x54, 0x4c, 0x10, 0xdc, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x59,
+	0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x5f, 0x52, 0x55, 0x4e, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x10, 0xe6, 0x01, 0x12, 0x22, 0x0a, 0x1d, 0x57, 0x41, 0x49, 0x54, 0x5f, 0x46,
+	0x4f, 0x52, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0xf0, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x46, 0x4f,
+	0x52, 0x43, 0x45, 0x5f, 0x55, 0x4e, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41,
+	0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0xf1, 0x01, 0x12, 0x19, 0x0a,
+	0x14, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x5f, 0x4c, 0x4f, 0x47, 0x53, 0x10, 0xad, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x49, 0x4e, 0x45, 0x53, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x42,
+	0x45, 0x10, 0xae, 0x02, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x10, 0xaf, 0x02,
+	0x12, 0x21, 0x0a, 0x1c, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45,
+	0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45,
+	0x10, 0x90, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x44, 0x49, 0x53, 0x4b, 0x5f, 0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52,
+	0x45, 0x10, 0x91, 0x03, 0x12, 0x25, 0x0a, 0x20, 0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f,
+	0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x45, 0x54, 0x57, 0x4f, 0x52, 0x4b, 0x5f, 0x55, 0x4e, 0x41,
+	0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x92, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x50, 0x49, 0x44, 0x5f,
+	0x50, 0x52, 0x45, 0x53, 0x53, 0x55, 0x52, 0x45, 0x10, 0x93, 0x03, 0x12, 0x1f, 0x0a, 0x1a, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x43,
+	0x48, 0x45, 0x44, 0x55, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x94, 0x03, 0x12, 0x1d, 0x0a, 0x18,
+	0x41, 0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x55, 0x4e, 0x52,
+	0x45, 0x41, 0x43, 0x48, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x95, 0x03, 0x12, 0x1b, 0x0a, 0x16, 0x41,
+	0x44, 0x44, 0x52, 0x45, 0x53, 0x53, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x4e, 0x4f, 0x54, 0x5f,
+	0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x96, 0x03, 0x12, 0x1e, 0x0a, 0x19, 0x41, 0x44, 0x44, 0x52,
+	0x45, 0x53, 0x53, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44,
+	0x55, 0x4c, 0x49, 0x4e, 0x47, 0x10, 0x97, 0x03, 0x12, 0x1a, 0x0a, 0x15, 0x43, 0x48, 0x45, 0x43,
+	0x4b, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0x98, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x53, 0x54, 0x41, 0x52, 0x54, 0x5f, 0x4d, 0x49,
+	0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0xf5, 0x03, 0x12, 0x15, 0x0a, 0x10, 0x55, 0x4e, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x49, 0x4b, 0x55, 0x42, 0x45, 0x10, 0xf6, 0x03,
+	0x12, 0x14, 0x0a, 0x0f, 0x52, 0x55, 0x4e, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x50,
+	0x55, 0x4c, 0x4c, 0x10, 0xa7, 0x04, 0x12, 0x22, 0x0a, 0x1d, 0x53, 0x45, 0x54, 0x5f, 0x52, 0x45,
+	0x4e, 0x44, 0x45, 0x52, 0x5f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x4f, 0x46, 0x46, 0x4c, 0x49, 0x4e,
+	0x45, 0x5f, 0x46, 0x41, 0x4c, 0x53, 0x45, 0x10, 0xd8, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x4b, 0x50,
+	0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x4d, 0x41, 0x4e, 0x55, 0x41, 0x4c, 0x5f, 0x49, 0x4e, 0x49,
+	0x54, 0x10, 0xd9, 0x04, 0x12, 0x17, 0x0a, 0x12, 0x4b, 0x50, 0x54, 0x46, 0x49, 0x4c, 0x45, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0xda, 0x04, 0x12, 0x24, 0x0a,
+	0x1f, 0x52, 0x45, 0x4d, 0x4f, 0x56, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43,
+	0x45, 0x5f, 0x46, 0x52, 0x4f, 0x4d, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x53,
+	0x10, 0xdb, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x10, 0xbc, 0x05,
+	0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x44, 0x45, 0x46, 0x49,
+	0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xbd, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x53,
+	0x10, 0xbe, 0x05, 0x12, 0x18, 0x0a, 0x13, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x10, 0xbf, 0x05, 0x12, 0x24, 0x0a,
+	0x1f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x44, 0x45, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x4f, 0x4e,
+	0x10, 0xc0, 0x05, 0x12, 0x2f, 0x0a, 0x2a, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43, 0x48,
+	0x45, 0x43, 0x4b, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x53, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0xc1, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x43,
+	0x48, 0x45, 0x43, 0x4b, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc2, 0x05, 0x12, 0x1b, 0x0a, 0x16, 0x43, 0x4f, 0x4e,
+	0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x56, 0x45, 0x52, 0x53,
+	0x49, 0x4f, 0x4e, 0x10, 0xc3, 0x05, 0x12, 0x20, 0x0a, 0x1b, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47,
+	0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x41, 0x54, 0x4f, 0x52, 0x53, 0x10, 0xc4, 0x05, 0x12, 0x22, 0x0a, 0x1d, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x65, 0x72, 0x73, 0x10, 0xc5, 0x05, 0x12, 0x25, 0x0a, 0x20,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x4d, 0x49, 0x53, 0x53, 0x49,
+	0x4e, 0x47, 0x5f, 0x4d, 0x41, 0x4e, 0x49, 0x46, 0x45, 0x53, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x45,
+	0x10, 0xc6, 0x05, 0x12, 0x23, 0x0a, 0x1e, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x4e,
+	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0xc7, 0x05, 0x12, 0x27, 0x0a, 0x22, 0x43, 0x4f, 0x4e, 0x46,
+	0x49, 0x47, 0x5f, 0x46, 0x49, 0x58, 0x5f, 0x53, 0x4b, 0x41, 0x46, 0x46, 0x4f, 0x4c, 0x44, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0xc8,
+	0x05, 0x12, 0x2b, 0x0a, 0x26, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x52, 0x45, 0x46, 0x52,
+	0x45, 0x53, 0x48, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4f, 0x5f,
+	0x43, 0x52, 0x45, 0x44, 0x45, 0x4e, 0x54, 0x49, 0x41, 0x4c, 0x53, 0x10, 0xc9, 0x05, 0x12, 0x1c,
+	0x0a, 0x17, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x5f,
+	0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0xca, 0x05, 0x12, 0x26, 0x0a, 0x21,
+	0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x49,
+	0x46, 0x59, 0x5f, 0x4f, 0x52, 0x5f, 0x4e, 0x45, 0x57, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c,
+	0x45, 0x10, 0xa0, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f,
+	0x55, 0x53, 0x45, 0x5f, 0x41, 0x44, 0x44, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x5f, 0x45, 0x4e,
+	0x56, 0x10, 0xa1, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x5f,
+	0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x49, 0x4e, 0x50, 0x55, 0x54, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x49, 0x4c, 0x45, 0x10, 0xa2, 0x06, 0x12, 0x0f, 0x0a, 0x0a, 0x4f, 0x50, 0x45, 0x4e, 0x5f, 0x49,
+	0x53, 0x53, 0x55, 0x45, 0x10, 0x84, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x43, 0x48, 0x45, 0x43, 0x4b,
+	0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x10,
+	0xe8, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x46, 0x49, 0x58, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d,
+	0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54,
+	0x10, 0xe9, 0x07, 0x12, 0x2a, 0x0a, 0x25, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53,
+	0x54, 0x4f, 0x4d, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45,
+	0x4e, 0x44, 0x45, 0x4e, 0x43, 0x49, 0x45, 0x53, 0x5f, 0x43, 0x4d, 0x44, 0x10, 0xea, 0x07, 0x12,
+	0x2c, 0x0a, 0x27, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f,
+	0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x44, 0x45, 0x50, 0x45, 0x4e, 0x44, 0x45, 0x4e,
+	0x43, 0x49, 0x45, 0x53, 0x5f, 0x50, 0x41, 0x54, 0x48, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x26, 0x0a,
+	0x21, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x41, 0x4e, 0x44, 0x5f, 0x41, 0x4e, 0x44, 0x5f, 0x49, 0x4d, 0x41, 0x47, 0x45, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x10, 0xec, 0x07, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c,
+	0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
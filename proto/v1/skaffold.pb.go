@@ -265,6 +265,7 @@ const StatusCode_DEPLOY_GET_CLOUD_RUN_CLIENT_ERR = enums.StatusCode_DEPLOY_GET_C
 const StatusCode_DEPLOY_CLOUD_RUN_GET_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_GET_SERVICE_ERR
 const StatusCode_DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR
 const StatusCode_DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR
+const StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED = enums.StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED
 const StatusCode_TEST_USER_CONFIG_ERR = enums.StatusCode_TEST_USER_CONFIG_ERR
 const StatusCode_TEST_CST_USER_ERR = enums.StatusCode_TEST_CST_USER_ERR
 const StatusCode_TEST_IMG_PULL_ERR = enums.StatusCode_TEST_IMG_PULL_ERR
@@ -278,6 +279,11 @@ const StatusCode_TEST_CUSTOM_CMD_RUN_ERR = enums.StatusCode_TEST_CUSTOM_CMD_RUN_
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR = enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR = enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR
 const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = enums.StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR
+const StatusCode_TEST_PACKER_VALIDATE_ERR = enums.StatusCode_TEST_PACKER_VALIDATE_ERR
+const StatusCode_TEST_PACKER_RUN_ERR = enums.StatusCode_TEST_PACKER_RUN_ERR
+const StatusCode_TEST_VULNERABILITY_SCAN_ERR = enums.StatusCode_TEST_VULNERABILITY_SCAN_ERR
+const StatusCode_TEST_VULNERABILITY_POLICY_ERR = enums.StatusCode_TEST_VULNERABILITY_POLICY_ERR
+const StatusCode_TEST_PACKER_INIT_ERR = enums.StatusCode_TEST_PACKER_INIT_ERR
 const StatusCode_RENDER_KPTFILE_INIT_ERR = enums.StatusCode_RENDER_KPTFILE_INIT_ERR
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR
@@ -306,6 +312,8 @@ const StatusCode_CONFIG_UNKNOWN_TRANSFORMER = enums.StatusCode_CONFIG_UNKNOWN_TR
 const StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR = enums.StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR
 const StatusCode_CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR = enums.StatusCode_CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR
 const StatusCode_CONFIG_UPGRADE_ERR = enums.StatusCode_CONFIG_UPGRADE_ERR
+const StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR = enums.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR
+const StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR = enums.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR
 const StatusCode_INSPECT_UNKNOWN_ERR = enums.StatusCode_INSPECT_UNKNOWN_ERR
 const StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR = enums.StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR
 const StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR = enums.StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR
@@ -351,6 +359,8 @@ const SuggestionCode_INVALID_KPT_MANIFESTS = enums.SuggestionCode_INVALID_KPT_MA
 const SuggestionCode_ALIGN_KPT_INVENTORY = enums.SuggestionCode_ALIGN_KPT_INVENTORY
 const SuggestionCode_INSTALL_KUBECTL = enums.SuggestionCode_INSTALL_KUBECTL
 const SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION = enums.SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION
+const SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK = enums.SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK
+const SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE = enums.SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE
 const SuggestionCode_CHECK_CONTAINER_LOGS = enums.SuggestionCode_CHECK_CONTAINER_LOGS
 const SuggestionCode_CHECK_READINESS_PROBE = enums.SuggestionCode_CHECK_READINESS_PROBE
 const SuggestionCode_CHECK_CONTAINER_IMAGE = enums.SuggestionCode_CHECK_CONTAINER_IMAGE
@@ -383,6 +393,8 @@ const SuggestionCode_CONFIG_ALLOWLIST_transformers = enums.SuggestionCode_CONFIG
 const SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE = enums.SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE
 const SuggestionCode_CONFIG_ENABLE_REMOTE_REPO_SYNC = enums.SuggestionCode_CONFIG_ENABLE_REMOTE_REPO_SYNC
 const SuggestionCode_CONFIG_FIX_SKAFFOLD_CONFIG_VERSION = enums.SuggestionCode_CONFIG_FIX_SKAFFOLD_CONFIG_VERSION
+const SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS = enums.SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS
+const SuggestionCode_CONFIG_UPDATE_LOCK_FILE = enums.SuggestionCode_CONFIG_UPDATE_LOCK_FILE
 const SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE = enums.SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE
 const SuggestionCode_INSPECT_USE_ADD_BUILD_ENV = enums.SuggestionCode_INSPECT_USE_ADD_BUILD_ENV
 const SuggestionCode_INSPECT_CHECK_INPUT_PROFILE = enums.SuggestionCode_INSPECT_CHECK_INPUT_PROFILE
@@ -265,6 +265,7 @@ const StatusCode_DEPLOY_GET_CLOUD_RUN_CLIENT_ERR = enums.StatusCode_DEPLOY_GET_C
 const StatusCode_DEPLOY_CLOUD_RUN_GET_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_GET_SERVICE_ERR
 const StatusCode_DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_UPDATE_SERVICE_ERR
 const StatusCode_DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR = enums.StatusCode_DEPLOY_CLOUD_RUN_DELETE_SERVICE_ERR
+const StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED = enums.StatusCode_DEPLOY_TERRAFORM_STATE_LOCKED
 const StatusCode_TEST_USER_CONFIG_ERR = enums.StatusCode_TEST_USER_CONFIG_ERR
 const StatusCode_TEST_CST_USER_ERR = enums.StatusCode_TEST_CST_USER_ERR
 const StatusCode_TEST_IMG_PULL_ERR = enums.StatusCode_TEST_IMG_PULL_ERR
@@ -278,6 +279,11 @@ const StatusCode_TEST_CUSTOM_CMD_RUN_ERR = enums.StatusCode_TEST_CUSTOM_CMD_RUN_
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR = enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_CMD_ERR
 const StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR = enums.StatusCode_TEST_CUSTOM_DEPENDENCIES_UNMARSHALL_ERR
 const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = enums.StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR
+const StatusCode_TEST_PACKER_VALIDATE_ERR = enums.StatusCode_TEST_PACKER_VALIDATE_ERR
+const StatusCode_TEST_PACKER_RUN_ERR = enums.StatusCode_TEST_PACKER_RUN_ERR
+const StatusCode_TEST_VULNERABILITY_SCAN_ERR = enums.StatusCode_TEST_VULNERABILITY_SCAN_ERR
+const StatusCode_TEST_VULNERABILITY_POLICY_ERR = enums.StatusCode_TEST_VULNERABILITY_POLICY_ERR
+const StatusCode_TEST_PACKER_INIT_ERR = enums.StatusCode_TEST_PACKER_INIT_ERR
 const StatusCode_RENDER_KPTFILE_INIT_ERR = enums.StatusCode_RENDER_KPTFILE_INIT_ERR
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR
@@ -306,6 +312,8 @@ const StatusCode_CONFIG_UNKNOWN_TRANSFORMER = enums.StatusCode_CONFIG_UNKNOWN_TR
 const StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR = enums.StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR
 const StatusCode_CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR = enums.StatusCode_CONFIG_REMOTE_REPO_CACHE_NOT_FOUND_ERR
 const StatusCode_CONFIG_UPGRADE_ERR = enums.StatusCode_CONFIG_UPGRADE_ERR
+const StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR = enums.StatusCode_CONFIG_REMOTE_REPO_AUTH_ERR
+const StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR = enums.StatusCode_CONFIG_LOCK_FILE_OUT_OF_DATE_ERR
 const StatusCode_INSPECT_UNKNOWN_ERR = enums.StatusCode_INSPECT_UNKNOWN_ERR
 const StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR = enums.StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR
 const StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR = enums.StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR
@@ -351,6 +359,8 @@ const SuggestionCode_INVALID_KPT_MANIFESTS = enums.SuggestionCode_INVALID_KPT_MA
 const SuggestionCode_ALIGN_KPT_INVENTORY = enums.SuggestionCode_ALIGN_KPT_INVENTORY
 const SuggestionCode_INSTALL_KUBECTL = enums.SuggestionCode_INSTALL_KUBECTL
 const SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION = enums.SuggestionCode_SPECIFY_CLOUD_RUN_LOCATION
+const SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK = enums.SuggestionCode_WAIT_FOR_TERRAFORM_STATE_LOCK
+const SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE = enums.SuggestionCode_FORCE_UNLOCK_TERRAFORM_STATE
 const SuggestionCode_CHECK_CONTAINER_LOGS = enums.SuggestionCode_CHECK_CONTAINER_LOGS
 const SuggestionCode_CHECK_READINESS_PROBE = enums.SuggestionCode_CHECK_READINESS_PROBE
 const SuggestionCode_CHECK_CONTAINER_IMAGE = enums.SuggestionCode_CHECK_CONTAINER_IMAGE
@@ -383,6 +393,8 @@ const SuggestionCode_CONFIG_ALLOWLIST_transformers = enums.SuggestionCode_CONFIG
 const SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE = enums.SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE
 const SuggestionCode_CONFIG_ENABLE_REMOTE_REPO_SYNC = enums.SuggestionCode_CONFIG_ENABLE_REMOTE_REPO_SYNC
 const SuggestionCode_CONFIG_FIX_SKAFFOLD_CONFIG_VERSION = enums.SuggestionCode_CONFIG_FIX_SKAFFOLD_CONFIG_VERSION
+const SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS = enums.SuggestionCode_CONFIG_REFRESH_REMOTE_REPO_CREDENTIALS
+const SuggestionCode_CONFIG_UPDATE_LOCK_FILE = enums.SuggestionCode_CONFIG_UPDATE_LOCK_FILE
 const SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE = enums.SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE
 const SuggestionCode_INSPECT_USE_ADD_BUILD_ENV = enums.SuggestionCode_INSPECT_USE_ADD_BUILD_ENV
 const SuggestionCode_INSPECT_CHECK_INPUT_PROFILE = enums.SuggestionCode_INSPECT_CHECK_INPUT_PROFILE
@@ -1487,6 +1499,8 @@ type Event struct {
 	//	*Event_VerifyEvent
 	//	*Event_CloudRunReadyEvent
 	//	*Event_ExecEvent
+	//	*Event_TerraformPlanEvent
+	//	*Event_VulnerabilityEvent
 	EventType isEvent_EventType `protobuf_oneof:"event_type"`
 }
 
@@ -1648,6 +1662,20 @@ func (x *Event) GetExecEvent() *ExecSubtaskEvent {
 	return nil
 }
 
+func (x *Event) GetTerraformPlanEvent() *TerraformPlanEvent {
+	if x, ok := x.GetEventType().(*Event_TerraformPlanEvent); ok {
+		return x.TerraformPlanEvent
+	}
+	return nil
+}
+
+func (x *Event) GetVulnerabilityEvent() *VulnerabilityEvent {
+	if x, ok := x.GetEventType().(*Event_VulnerabilityEvent); ok {
+		return x.VulnerabilityEvent
+	}
+	return nil
+}
+
 type isEvent_EventType interface {
 	isEvent_EventType()
 }
@@ -1716,6 +1744,14 @@ type Event_ExecEvent struct {
 	ExecEvent *ExecSubtaskEvent `protobuf:"bytes,17,opt,name=execEvent,proto3,oneof"` // describes if the exec has started, is in progress or is complete.
 }
 
+type Event_TerraformPlanEvent struct {
+	TerraformPlanEvent *TerraformPlanEvent `protobuf:"bytes,18,opt,name=terraformPlanEvent,proto3,oneof"` // describes the changes computed by `terraform plan` for a Terraform deployment.
+}
+
+type Event_VulnerabilityEvent struct {
+	VulnerabilityEvent *VulnerabilityEvent `protobuf:"bytes,19,opt,name=vulnerabilityEvent,proto3,oneof"` // describes a vulnerability found by a scanner in an image built by Skaffold.
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_SkaffoldLogEvent) isEvent_EventType() {}
@@ -1748,6 +1784,10 @@ func (*Event_CloudRunReadyEvent) isEvent_EventType() {}
 
 func (*Event_ExecEvent) isEvent_EventType() {}
 
+func (*Event_TerraformPlanEvent) isEvent_EventType() {}
+
+func (*Event_VulnerabilityEvent) isEvent_EventType() {}
+
 // `TerminationEvent` marks the end of the skaffold session
 type TerminationEvent struct {
 	state         protoimpl.MessageState
@@ -2818,6 +2858,216 @@ func (x *CloudRunReadyEvent) GetReadyRevision() string {
 	return ""
 }
 
+// `TerraformPlanEvent` describes the changes that `terraform plan` computed for a Terraform deployment,
+// and is emitted by Skaffold before the plan is applied.
+type TerraformPlanEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                       // id of the subtask which will be used in SkaffoldLog
+	TaskId     string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"` // id of the task of skaffold that this event came from
+	Deployment string   `protobuf:"bytes,3,opt,name=deployment,proto3" json:"deployment,omitempty"`       // name of the Terraform deployment
+	Add        int32    `protobuf:"varint,4,opt,name=add,proto3" json:"add,omitempty"`                    // number of resources to create
+	Change     int32    `protobuf:"varint,5,opt,name=change,proto3" json:"change,omitempty"`              // number of resources to update in place
+	Destroy    int32    `protobuf:"varint,6,opt,name=destroy,proto3" json:"destroy,omitempty"`            // number of resources to destroy
+	Drifted    []string `protobuf:"bytes,7,rep,name=drifted,proto3" json:"drifted,omitempty"`             // addresses of the resources that drifted from the recorded state
+}
+
+func (x *TerraformPlanEvent) Reset() {
+	*x = TerraformPlanEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_skaffold_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TerraformPlanEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerraformPlanEvent) ProtoMessage() {}
+
+func (x *TerraformPlanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_skaffold_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerraformPlanEvent.ProtoReflect.Descriptor instead.
+func (*TerraformPlanEvent) Descriptor() ([]byte, []int) {
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TerraformPlanEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TerraformPlanEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *TerraformPlanEvent) GetDeployment() string {
+	if x != nil {
+		return x.Deployment
+	}
+	return ""
+}
+
+func (x *TerraformPlanEvent) GetAdd() int32 {
+	if x != nil {
+		return x.Add
+	}
+	return 0
+}
+
+func (x *TerraformPlanEvent) GetChange() int32 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *TerraformPlanEvent) GetDestroy() int32 {
+	if x != nil {
+		return x.Destroy
+	}
+	return 0
+}
+
+func (x *TerraformPlanEvent) GetDrifted() []string {
+	if x != nil {
+		return x.Drifted
+	}
+	return nil
+}
+
+// `VulnerabilityEvent` describes a vulnerability that a scanner found in an image built by Skaffold,
+// and is emitted by Skaffold when the image is tested.
+type VulnerabilityEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`                             // id of the vulnerability, for example: `CVE-2023-44487`
+	TaskId           string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`       // id of the task of skaffold that this event came from
+	Image            string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`                       // tag of the scanned image
+	Scanner          string `protobuf:"bytes,4,opt,name=scanner,proto3" json:"scanner,omitempty"`                   // scanner that found the vulnerability: `trivy` or `grype`
+	Package          string `protobuf:"bytes,5,opt,name=package,proto3" json:"package,omitempty"`                   // name of the vulnerable package
+	InstalledVersion string `protobuf:"bytes,6,opt,name=installedVersion,proto3" json:"installedVersion,omitempty"` // version of the package installed in the image
+	FixedVersion     string `protobuf:"bytes,7,opt,name=fixedVersion,proto3" json:"fixedVersion,omitempty"`         // version of the package that fixes the vulnerability, if any
+	Severity         string `protobuf:"bytes,8,opt,name=severity,proto3" json:"severity,omitempty"`                 // severity oneof: unknown, negligible, low, medium, high, critical
+	Status           string `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`                     // status oneof: Failed when the vulnerability fails the test, Ignored or Allowed
+}
+
+func (x *VulnerabilityEvent) Reset() {
+	*x = VulnerabilityEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_v2_skaffold_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VulnerabilityEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VulnerabilityEvent) ProtoMessage() {}
+
+func (x *VulnerabilityEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_v2_skaffold_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VulnerabilityEvent.ProtoReflect.Descriptor instead.
+func (*VulnerabilityEvent) Descriptor() ([]byte, []int) {
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VulnerabilityEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetScanner() string {
+	if x != nil {
+		return x.Scanner
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetInstalledVersion() string {
+	if x != nil {
+		return x.InstalledVersion
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetFixedVersion() string {
+	if x != nil {
+		return x.FixedVersion
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetSeverity() string {
+	if x != nil {
+		return x.Severity
+	}
+	return ""
+}
+
+func (x *VulnerabilityEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 // PortForwardEvent Event describes each port forwarding event.
 type PortForwardEvent struct {
 	state         protoimpl.MessageState
@@ -2840,7 +3090,7 @@ type PortForwardEvent struct {
 func (x *PortForwardEvent) Reset() {
 	*x = PortForwardEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[32]
+		mi := &file_v2_skaffold_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2853,7 +3103,7 @@ func (x *PortForwardEvent) String() string {
 func (*PortForwardEvent) ProtoMessage() {}
 
 func (x *PortForwardEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[32]
+	mi := &file_v2_skaffold_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2866,7 +3116,7 @@ func (x *PortForwardEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PortForwardEvent.ProtoReflect.Descriptor instead.
 func (*PortForwardEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{32}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *PortForwardEvent) GetId() string {
@@ -2963,7 +3213,7 @@ type FileSyncEvent struct {
 func (x *FileSyncEvent) Reset() {
 	*x = FileSyncEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[33]
+		mi := &file_v2_skaffold_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2976,7 +3226,7 @@ func (x *FileSyncEvent) String() string {
 func (*FileSyncEvent) ProtoMessage() {}
 
 func (x *FileSyncEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[33]
+	mi := &file_v2_skaffold_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2989,7 +3239,7 @@ func (x *FileSyncEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileSyncEvent.ProtoReflect.Descriptor instead.
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{33}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *FileSyncEvent) GetId() string {
@@ -3055,7 +3305,7 @@ type DebuggingContainerEvent struct {
 func (x *DebuggingContainerEvent) Reset() {
 	*x = DebuggingContainerEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[34]
+		mi := &file_v2_skaffold_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3068,7 +3318,7 @@ func (x *DebuggingContainerEvent) String() string {
 func (*DebuggingContainerEvent) ProtoMessage() {}
 
 func (x *DebuggingContainerEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[34]
+	mi := &file_v2_skaffold_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3081,7 +3331,7 @@ func (x *DebuggingContainerEvent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DebuggingContainerEvent.ProtoReflect.Descriptor instead.
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{34}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *DebuggingContainerEvent) GetId() string {
@@ -3165,7 +3415,7 @@ type UserIntentRequest struct {
 func (x *UserIntentRequest) Reset() {
 	*x = UserIntentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[35]
+		mi := &file_v2_skaffold_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3178,7 +3428,7 @@ func (x *UserIntentRequest) String() string {
 func (*UserIntentRequest) ProtoMessage() {}
 
 func (x *UserIntentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[35]
+	mi := &file_v2_skaffold_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3191,7 +3441,7 @@ func (x *UserIntentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserIntentRequest.ProtoReflect.Descriptor instead.
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{35}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *UserIntentRequest) GetIntent() *Intent {
@@ -3212,7 +3462,7 @@ type TriggerRequest struct {
 func (x *TriggerRequest) Reset() {
 	*x = TriggerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[36]
+		mi := &file_v2_skaffold_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3225,7 +3475,7 @@ func (x *TriggerRequest) String() string {
 func (*TriggerRequest) ProtoMessage() {}
 
 func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[36]
+	mi := &file_v2_skaffold_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3238,7 +3488,7 @@ func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{36}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *TriggerRequest) GetState() *TriggerState {
@@ -3262,7 +3512,7 @@ type TriggerState struct {
 func (x *TriggerState) Reset() {
 	*x = TriggerState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[37]
+		mi := &file_v2_skaffold_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3275,7 +3525,7 @@ func (x *TriggerState) String() string {
 func (*TriggerState) ProtoMessage() {}
 
 func (x *TriggerState) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[37]
+	mi := &file_v2_skaffold_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3288,7 +3538,7 @@ func (x *TriggerState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TriggerState.ProtoReflect.Descriptor instead.
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{37}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{39}
 }
 
 func (m *TriggerState) GetVal() isTriggerState_Val {
@@ -3330,7 +3580,7 @@ type Intent struct {
 func (x *Intent) Reset() {
 	*x = Intent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[38]
+		mi := &file_v2_skaffold_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3343,7 +3593,7 @@ func (x *Intent) String() string {
 func (*Intent) ProtoMessage() {}
 
 func (x *Intent) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[38]
+	mi := &file_v2_skaffold_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,7 +3606,7 @@ func (x *Intent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Intent.ProtoReflect.Descriptor instead.
 func (*Intent) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{38}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *Intent) GetBuild() bool {
@@ -3400,7 +3650,7 @@ type Suggestion struct {
 func (x *Suggestion) Reset() {
 	*x = Suggestion{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[39]
+		mi := &file_v2_skaffold_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3413,7 +3663,7 @@ func (x *Suggestion) String() string {
 func (*Suggestion) ProtoMessage() {}
 
 func (x *Suggestion) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[39]
+	mi := &file_v2_skaffold_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3426,7 +3676,7 @@ func (x *Suggestion) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Suggestion.ProtoReflect.Descriptor instead.
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{39}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *Suggestion) GetSuggestionCode() enums.SuggestionCode {
@@ -3457,7 +3707,7 @@ type IntOrString struct {
 func (x *IntOrString) Reset() {
 	*x = IntOrString{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[40]
+		mi := &file_v2_skaffold_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3470,7 +3720,7 @@ func (x *IntOrString) String() string {
 func (*IntOrString) ProtoMessage() {}
 
 func (x *IntOrString) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[40]
+	mi := &file_v2_skaffold_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3483,7 +3733,7 @@ func (x *IntOrString) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use IntOrString.ProtoReflect.Descriptor instead.
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return file_v2_skaffold_proto_rawDescGZIP(), []int{40}
+	return file_v2_skaffold_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *IntOrString) GetType() int32 {
@@ -3521,7 +3771,7 @@ type BuildMetadata_Artifact struct {
 func (x *BuildMetadata_Artifact) Reset() {
 	*x = BuildMetadata_Artifact{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[43]
+		mi := &file_v2_skaffold_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3534,7 +3784,7 @@ func (x *BuildMetadata_Artifact) String() string {
 func (*BuildMetadata_Artifact) ProtoMessage() {}
 
 func (x *BuildMetadata_Artifact) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[43]
+	mi := &file_v2_skaffold_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3590,7 +3840,7 @@ type TestMetadata_Tester struct {
 func (x *TestMetadata_Tester) Reset() {
 	*x = TestMetadata_Tester{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[45]
+		mi := &file_v2_skaffold_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3603,7 +3853,7 @@ func (x *TestMetadata_Tester) String() string {
 func (*TestMetadata_Tester) ProtoMessage() {}
 
 func (x *TestMetadata_Tester) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[45]
+	mi := &file_v2_skaffold_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3645,7 +3895,7 @@ type RenderMetadata_Renderer struct {
 func (x *RenderMetadata_Renderer) Reset() {
 	*x = RenderMetadata_Renderer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[46]
+		mi := &file_v2_skaffold_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3658,7 +3908,7 @@ func (x *RenderMetadata_Renderer) String() string {
 func (*RenderMetadata_Renderer) ProtoMessage() {}
 
 func (x *RenderMetadata_Renderer) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[46]
+	mi := &file_v2_skaffold_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3700,7 +3950,7 @@ type DeployMetadata_Deployer struct {
 func (x *DeployMetadata_Deployer) Reset() {
 	*x = DeployMetadata_Deployer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_v2_skaffold_proto_msgTypes[47]
+		mi := &file_v2_skaffold_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3713,7 +3963,7 @@ func (x *DeployMetadata_Deployer) String() string {
 func (*DeployMetadata_Deployer) ProtoMessage() {}
 
 func (x *DeployMetadata_Deployer) ProtoReflect() protoreflect.Message {
-	mi := &file_v2_skaffold_proto_msgTypes[47]
+	mi := &file_v2_skaffold_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3957,7 +4207,7 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x6f, 0x54, 0x72, 0x69, 0x67,
-	0x67, 0x65, 0x72, 0x22, 0xc4, 0x09, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a,
+	0x67, 0x65, 0x72, 0x22, 0xe4, 0x0a, 0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x38, 0x0a,
 	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
@@ -4032,7 +4282,17 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x12, 0x3a, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45,
 	0x78, 0x65, 0x63, 0x53, 0x75, 0x62, 0x74, 0x61, 0x73, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48,
-	0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a,
+	0x00, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x12,
+	0x74, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x32, 0x2e, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6c, 0x61,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x74, 0x65, 0x72, 0x72, 0x61, 0x66,
+	0x6f, 0x72, 0x6d, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4e, 0x0a, 0x12,
+	0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x32, 0x2e, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74,
+	0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x48, 0x00, 0x52, 0x12, 0x76, 0x75, 0x6c, 0x6e, 0x65, 0x72,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x0a,
 	0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x55, 0x0a, 0x10, 0x54, 0x65,
 	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x16,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
@@ -4178,147 +4438,176 @@ var file_v2_skaffold_proto_rawDesc = []byte{
 	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x61, 0x64,
 	0x79, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22,
-	0xec, 0x02, 0x0a, 0x10, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45,
+	0xbb, 0x01, 0x0a, 0x12, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x50, 0x6c, 0x61,
+	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x64, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x61, 0x64,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x64, 0x65, 0x73, 0x74,
+	0x72, 0x6f, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x64, 0x72, 0x69, 0x66, 0x74, 0x65, 0x64, 0x22, 0x8b, 0x02,
+	0x0a, 0x12, 0x56, 0x75, 0x6c, 0x6e, 0x65, 0x72, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x45,
 	0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f,
-	0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
-	0x74, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xc3,
-	0x01, 0x0a, 0x0d, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x69, 0x78, 0x65, 0x64,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65, 0x76, 0x65, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xec, 0x02, 0x0a, 0x10,
+	0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c,
-	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69,
-	0x6c, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x62, 0x6c, 0x65, 0x45, 0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62,
-	0x6c, 0x65, 0x45, 0x72, 0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x45, 0x72, 0x72, 0x22, 0xa0, 0x03, 0x0a, 0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72,
-	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75,
-	0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67,
-	0x44, 0x69, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69,
-	0x6e, 0x67, 0x44, 0x69, 0x72, 0x12, 0x51, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f,
-	0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65,
-	0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06,
-	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06,
-	0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x42, 0x05, 0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x64, 0x0a, 0x06, 0x49, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e,
-	0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a,
-	0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64,
-	0x65, 0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x22,
-	0x69, 0x0a, 0x0a, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a,
-	0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e,
-	0x75, 0x6d, 0x73, 0x2e, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x52, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6e,
-	0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x69, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69,
-	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x32, 0xdf, 0x05,
-	0x0a, 0x11, 0x53, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x56, 0x32, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x76, 0x32, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b,
-	0x12, 0x09, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x12,
-	0x5b, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x22, 0x0b, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x65, 0x3a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x09,
-	0x41, 0x75, 0x74, 0x6f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x72, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x0d, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3d, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45,
+	0x72, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x32, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72,
+	0x72, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x72, 0x72,
+	0x22, 0xa0, 0x03, 0x0a, 0x17, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x73, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x77, 0x6f, 0x72, 0x6b, 0x69, 0x6e, 0x67, 0x44, 0x69,
+	0x72, 0x12, 0x51, 0x0a, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32,
+	0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x64, 0x65, 0x62, 0x75, 0x67, 0x50,
+	0x6f, 0x72, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x3d, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x32, 0x2e, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x22, 0x3e, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54,
+	0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x22, 0x31, 0x0a, 0x0c, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x05,
+	0x0a, 0x03, 0x76, 0x61, 0x6c, 0x22, 0x64, 0x0a, 0x06, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x70,
+	0x6c, 0x6f, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x65, 0x70, 0x6c, 0x6f,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x76, 0x6c, 0x6f, 0x6f, 0x70, 0x22, 0x69, 0x0a, 0x0a, 0x53,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e, 0x73, 0x75, 0x67,
+	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x65, 0x6e, 0x75, 0x6d, 0x73, 0x2e,
+	0x53, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x0e,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x0b, 0x49, 0x6e, 0x74, 0x4f, 0x72, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74,
+	0x56, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x56, 0x61,
+	0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x56, 0x61, 0x6c, 0x32, 0xdf, 0x05, 0x0a, 0x11, 0x53, 0x6b,
+	0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x56, 0x32, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x46, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76,
+	0x32, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x47, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x12, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0c, 0x12, 0x0a, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x30, 0x01,
+	0x12, 0x59, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x6f, 0x67, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x0f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x1b, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x67, 0x73, 0x30, 0x01, 0x12, 0x5b, 0x0a, 0x07, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76,
+	0x32, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1f, 0x1a, 0x16, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x61,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x15, 0x22, 0x0b, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x3a, 0x06, 0x69, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32,
+	0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x1a,
+	0x16, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
+	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x62,
+	0x0a, 0x08, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x24, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x1a, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x2f, 0x61,
 	0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x62, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x6f, 0x53, 0x79, 0x6e, 0x63, 0x12, 0x18,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x1a, 0x15, 0x2f, 0x76, 0x32, 0x2f, 0x73, 0x79,
-	0x6e, 0x63, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65,
-	0x70, 0x6c, 0x6f, 0x79, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
-	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x1a, 0x17,
-	0x2f, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4f,
-	0x0a, 0x06, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x76, 0x32, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x65,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x42,
-	0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f,
-	0x6c, 0x73, 0x2f, 0x73, 0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x76, 0x32, 0x50, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x65, 0x12, 0x66, 0x0a, 0x0a, 0x41, 0x75, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79,
+	0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x1a, 0x17, 0x2f, 0x76, 0x32, 0x2f,
+	0x64, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x2f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x3a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x4f, 0x0a, 0x06, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x12, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x32, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x2f, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x42, 0x33, 0x5a, 0x31, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x54, 0x6f, 0x6f, 0x6c, 0x73, 0x2f, 0x73,
+	0x6b, 0x61, 0x66, 0x66, 0x6f, 0x6c, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x32,
+	0x50, 0x03, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4333,7 +4622,7 @@ func file_v2_skaffold_proto_rawDescGZIP() []byte {
 	return file_v2_skaffold_proto_rawDescData
 }
 
-var file_v2_skaffold_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_v2_skaffold_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_v2_skaffold_proto_goTypes = []interface{}{
 	(*StateResponse)(nil),           // 0: proto.v2.StateResponse
 	(*Response)(nil),                // 1: proto.v2.Response
@@ -4367,45 +4656,47 @@ var file_v2_skaffold_proto_goTypes = []interface{}{
 	(*DeploySubtaskEvent)(nil),      // 29: proto.v2.DeploySubtaskEvent
 	(*StatusCheckSubtaskEvent)(nil), // 30: proto.v2.StatusCheckSubtaskEvent
 	(*CloudRunReadyEvent)(nil),      // 31: proto.v2.CloudRunReadyEvent
-	(*PortForwardEvent)(nil),        // 32: proto.v2.PortForwardEvent
-	(*FileSyncEvent)(nil),           // 33: proto.v2.FileSyncEvent
-	(*DebuggingContainerEvent)(nil), // 34: proto.v2.DebuggingContainerEvent
-	(*UserIntentRequest)(nil),       // 35: proto.v2.UserIntentRequest
-	(*TriggerRequest)(nil),          // 36: proto.v2.TriggerRequest
-	(*TriggerState)(nil),            // 37: proto.v2.TriggerState
-	(*Intent)(nil),                  // 38: proto.v2.Intent
-	(*Suggestion)(nil),              // 39: proto.v2.Suggestion
-	(*IntOrString)(nil),             // 40: proto.v2.IntOrString
-	nil,                             // 41: proto.v2.State.ForwardedPortsEntry
-	nil,                             // 42: proto.v2.Metadata.AdditionalEntry
-	(*BuildMetadata_Artifact)(nil),  // 43: proto.v2.BuildMetadata.Artifact
-	nil,                             // 44: proto.v2.BuildMetadata.AdditionalEntry
-	(*TestMetadata_Tester)(nil),     // 45: proto.v2.TestMetadata.Tester
-	(*RenderMetadata_Renderer)(nil), // 46: proto.v2.RenderMetadata.Renderer
-	(*DeployMetadata_Deployer)(nil), // 47: proto.v2.DeployMetadata.Deployer
-	nil,                             // 48: proto.v2.BuildState.ArtifactsEntry
-	nil,                             // 49: proto.v2.StatusCheckState.ResourcesEntry
-	nil,                             // 50: proto.v2.DebuggingContainerEvent.DebugPortsEntry
-	(enums.BuildType)(0),            // 51: proto.enums.BuildType
-	(enums.ClusterType)(0),          // 52: proto.enums.ClusterType
-	(enums.StatusCode)(0),           // 53: proto.enums.StatusCode
-	(*timestamppb.Timestamp)(nil),   // 54: google.protobuf.Timestamp
-	(enums.LogLevel)(0),             // 55: proto.enums.LogLevel
-	(enums.SuggestionCode)(0),       // 56: proto.enums.SuggestionCode
-	(enums.BuilderType)(0),          // 57: proto.enums.BuilderType
-	(enums.TesterType)(0),           // 58: proto.enums.TesterType
-	(enums.RenderType)(0),           // 59: proto.enums.RenderType
-	(enums.DeployerType)(0),         // 60: proto.enums.DeployerType
-	(*emptypb.Empty)(nil),           // 61: google.protobuf.Empty
+	(*TerraformPlanEvent)(nil),      // 32: proto.v2.TerraformPlanEvent
+	(*VulnerabilityEvent)(nil),      // 33: proto.v2.VulnerabilityEvent
+	(*PortForwardEvent)(nil),        // 34: proto.v2.PortForwardEvent
+	(*FileSyncEvent)(nil),           // 35: proto.v2.FileSyncEvent
+	(*DebuggingContainerEvent)(nil), // 36: proto.v2.DebuggingContainerEvent
+	(*UserIntentRequest)(nil),       // 37: proto.v2.UserIntentRequest
+	(*TriggerRequest)(nil),          // 38: proto.v2.TriggerRequest
+	(*TriggerState)(nil),            // 39: proto.v2.TriggerState
+	(*Intent)(nil),                  // 40: proto.v2.Intent
+	(*Suggestion)(nil),              // 41: proto.v2.Suggestion
+	(*IntOrString)(nil),             // 42: proto.v2.IntOrString
+	nil,                             // 43: proto.v2.State.ForwardedPortsEntry
+	nil,                             // 44: proto.v2.Metadata.AdditionalEntry
+	(*BuildMetadata_Artifact)(nil),  // 45: proto.v2.BuildMetadata.Artifact
+	nil,                             // 46: proto.v2.BuildMetadata.AdditionalEntry
+	(*TestMetadata_Tester)(nil),     // 47: proto.v2.TestMetadata.Tester
+	(*RenderMetadata_Renderer)(nil), // 48: proto.v2.RenderMetadata.Renderer
+	(*DeployMetadata_Deployer)(nil), // 49: proto.v2.DeployMetadata.Deployer
+	nil,                             // 50: proto.v2.BuildState.ArtifactsEntry
+	nil,                             // 51: proto.v2.StatusCheckState.ResourcesEntry
+	nil,                             // 52: proto.v2.DebuggingContainerEvent.DebugPortsEntry
+	(enums.BuildType)(0),            // 53: proto.enums.BuildType
+	(enums.ClusterType)(0),          // 54: proto.enums.ClusterType
+	(enums.StatusCode)(0),           // 55: proto.enums.StatusCode
+	(*timestamppb.Timestamp)(nil),   // 56: google.protobuf.Timestamp
+	(enums.LogLevel)(0),             // 57: proto.enums.LogLevel
+	(enums.SuggestionCode)(0),       // 58: proto.enums.SuggestionCode
+	(enums.BuilderType)(0),          // 59: proto.enums.BuilderType
+	(enums.TesterType)(0),           // 60: proto.enums.TesterType
+	(enums.RenderType)(0),           // 61: proto.enums.RenderType
+	(enums.DeployerType)(0),         // 62: proto.enums.DeployerType
+	(*emptypb.Empty)(nil),           // 63: google.protobuf.Empty
 }
 var file_v2_skaffold_proto_depIdxs = []int32{
 	3,  // 0: proto.v2.StateResponse.state:type_name -> proto.v2.State
 	9,  // 1: proto.v2.State.buildState:type_name -> proto.v2.BuildState
 	13, // 2: proto.v2.State.deployState:type_name -> proto.v2.DeployState
-	41, // 3: proto.v2.State.forwardedPorts:type_name -> proto.v2.State.ForwardedPortsEntry
+	43, // 3: proto.v2.State.forwardedPorts:type_name -> proto.v2.State.ForwardedPortsEntry
 	15, // 4: proto.v2.State.statusCheckState:type_name -> proto.v2.StatusCheckState
 	16, // 5: proto.v2.State.fileSyncState:type_name -> proto.v2.FileSyncState
-	34, // 6: proto.v2.State.debuggingContainers:type_name -> proto.v2.DebuggingContainerEvent
+	36, // 6: proto.v2.State.debuggingContainers:type_name -> proto.v2.DebuggingContainerEvent
 	4,  // 7: proto.v2.State.metadata:type_name -> proto.v2.Metadata
 	10, // 8: proto.v2.State.testState:type_name -> proto.v2.TestState
 	11, // 9: proto.v2.State.renderState:type_name -> proto.v2.RenderState
@@ -4415,86 +4706,88 @@ var file_v2_skaffold_proto_depIdxs = []int32{
 	8,  // 13: proto.v2.Metadata.deploy:type_name -> proto.v2.DeployMetadata
 	6,  // 14: proto.v2.Metadata.test:type_name -> proto.v2.TestMetadata
 	7,  // 15: proto.v2.Metadata.render:type_name -> proto.v2.RenderMetadata
-	42, // 16: proto.v2.Metadata.additional:type_name -> proto.v2.Metadata.AdditionalEntry
-	43, // 17: proto.v2.BuildMetadata.artifacts:type_name -> proto.v2.BuildMetadata.Artifact
-	51, // 18: proto.v2.BuildMetadata.type:type_name -> proto.enums.BuildType
-	44, // 19: proto.v2.BuildMetadata.additional:type_name -> proto.v2.BuildMetadata.AdditionalEntry
-	45, // 20: proto.v2.TestMetadata.Testers:type_name -> proto.v2.TestMetadata.Tester
-	46, // 21: proto.v2.RenderMetadata.Renderers:type_name -> proto.v2.RenderMetadata.Renderer
-	47, // 22: proto.v2.DeployMetadata.deployers:type_name -> proto.v2.DeployMetadata.Deployer
-	52, // 23: proto.v2.DeployMetadata.cluster:type_name -> proto.enums.ClusterType
-	48, // 24: proto.v2.BuildState.artifacts:type_name -> proto.v2.BuildState.ArtifactsEntry
-	53, // 25: proto.v2.BuildState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 26: proto.v2.TestState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 27: proto.v2.RenderState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 28: proto.v2.VerifyState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 29: proto.v2.DeployState.statusCode:type_name -> proto.enums.StatusCode
-	53, // 30: proto.v2.ExecState.statusCode:type_name -> proto.enums.StatusCode
-	49, // 31: proto.v2.StatusCheckState.resources:type_name -> proto.v2.StatusCheckState.ResourcesEntry
-	53, // 32: proto.v2.StatusCheckState.statusCode:type_name -> proto.enums.StatusCode
-	54, // 33: proto.v2.Event.timestamp:type_name -> google.protobuf.Timestamp
+	44, // 16: proto.v2.Metadata.additional:type_name -> proto.v2.Metadata.AdditionalEntry
+	45, // 17: proto.v2.BuildMetadata.artifacts:type_name -> proto.v2.BuildMetadata.Artifact
+	53, // 18: proto.v2.BuildMetadata.type:type_name -> proto.enums.BuildType
+	46, // 19: proto.v2.BuildMetadata.additional:type_name -> proto.v2.BuildMetadata.AdditionalEntry
+	47, // 20: proto.v2.TestMetadata.Testers:type_name -> proto.v2.TestMetadata.Tester
+	48, // 21: proto.v2.RenderMetadata.Renderers:type_name -> proto.v2.RenderMetadata.Renderer
+	49, // 22: proto.v2.DeployMetadata.deployers:type_name -> proto.v2.DeployMetadata.Deployer
+	54, // 23: proto.v2.DeployMetadata.cluster:type_name -> proto.enums.ClusterType
+	50, // 24: proto.v2.BuildState.artifacts:type_name -> proto.v2.BuildState.ArtifactsEntry
+	55, // 25: proto.v2.BuildState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 26: proto.v2.TestState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 27: proto.v2.RenderState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 28: proto.v2.VerifyState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 29: proto.v2.DeployState.statusCode:type_name -> proto.enums.StatusCode
+	55, // 30: proto.v2.ExecState.statusCode:type_name -> proto.enums.StatusCode
+	51, // 31: proto.v2.StatusCheckState.resources:type_name -> proto.v2.StatusCheckState.ResourcesEntry
+	55, // 32: proto.v2.StatusCheckState.statusCode:type_name -> proto.enums.StatusCode
+	56, // 33: proto.v2.Event.timestamp:type_name -> google.protobuf.Timestamp
 	20, // 34: proto.v2.Event.metaEvent:type_name -> proto.v2.MetaEvent
 	21, // 35: proto.v2.Event.skaffoldLogEvent:type_name -> proto.v2.SkaffoldLogEvent
 	22, // 36: proto.v2.Event.applicationLogEvent:type_name -> proto.v2.ApplicationLogEvent
 	23, // 37: proto.v2.Event.taskEvent:type_name -> proto.v2.TaskEvent
 	24, // 38: proto.v2.Event.buildSubtaskEvent:type_name -> proto.v2.BuildSubtaskEvent
 	29, // 39: proto.v2.Event.deploySubtaskEvent:type_name -> proto.v2.DeploySubtaskEvent
-	32, // 40: proto.v2.Event.portEvent:type_name -> proto.v2.PortForwardEvent
+	34, // 40: proto.v2.Event.portEvent:type_name -> proto.v2.PortForwardEvent
 	30, // 41: proto.v2.Event.statusCheckSubtaskEvent:type_name -> proto.v2.StatusCheckSubtaskEvent
-	33, // 42: proto.v2.Event.fileSyncEvent:type_name -> proto.v2.FileSyncEvent
-	34, // 43: proto.v2.Event.debuggingContainerEvent:type_name -> proto.v2.DebuggingContainerEvent
+	35, // 42: proto.v2.Event.fileSyncEvent:type_name -> proto.v2.FileSyncEvent
+	36, // 43: proto.v2.Event.debuggingContainerEvent:type_name -> proto.v2.DebuggingContainerEvent
 	18, // 44: proto.v2.Event.terminationEvent:type_name -> proto.v2.TerminationEvent
 	25, // 45: proto.v2.Event.testEvent:type_name -> proto.v2.TestSubtaskEvent
 	26, // 46: proto.v2.Event.renderEvent:type_name -> proto.v2.RenderSubtaskEvent
 	27, // 47: proto.v2.Event.verifyEvent:type_name -> proto.v2.VerifySubtaskEvent
 	31, // 48: proto.v2.Event.cloudRunReadyEvent:type_name -> proto.v2.CloudRunReadyEvent
 	28, // 49: proto.v2.Event.execEvent:type_name -> proto.v2.ExecSubtaskEvent
-	19, // 50: proto.v2.TerminationEvent.err:type_name -> proto.v2.ActionableErr
-	53, // 51: proto.v2.ActionableErr.errCode:type_name -> proto.enums.StatusCode
-	39, // 52: proto.v2.ActionableErr.suggestions:type_name -> proto.v2.Suggestion
-	4,  // 53: proto.v2.MetaEvent.metadata:type_name -> proto.v2.Metadata
-	55, // 54: proto.v2.SkaffoldLogEvent.level:type_name -> proto.enums.LogLevel
-	19, // 55: proto.v2.TaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 56: proto.v2.BuildSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 57: proto.v2.TestSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 58: proto.v2.RenderSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 59: proto.v2.VerifySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 60: proto.v2.ExecSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	19, // 61: proto.v2.DeploySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	53, // 62: proto.v2.StatusCheckSubtaskEvent.statusCode:type_name -> proto.enums.StatusCode
-	19, // 63: proto.v2.StatusCheckSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	40, // 64: proto.v2.PortForwardEvent.targetPort:type_name -> proto.v2.IntOrString
-	19, // 65: proto.v2.FileSyncEvent.actionableErr:type_name -> proto.v2.ActionableErr
-	50, // 66: proto.v2.DebuggingContainerEvent.debugPorts:type_name -> proto.v2.DebuggingContainerEvent.DebugPortsEntry
-	38, // 67: proto.v2.UserIntentRequest.intent:type_name -> proto.v2.Intent
-	37, // 68: proto.v2.TriggerRequest.state:type_name -> proto.v2.TriggerState
-	56, // 69: proto.v2.Suggestion.suggestionCode:type_name -> proto.enums.SuggestionCode
-	32, // 70: proto.v2.State.ForwardedPortsEntry.value:type_name -> proto.v2.PortForwardEvent
-	57, // 71: proto.v2.BuildMetadata.Artifact.type:type_name -> proto.enums.BuilderType
-	58, // 72: proto.v2.TestMetadata.Tester.type:type_name -> proto.enums.TesterType
-	59, // 73: proto.v2.RenderMetadata.Renderer.type:type_name -> proto.enums.RenderType
-	60, // 74: proto.v2.DeployMetadata.Deployer.type:type_name -> proto.enums.DeployerType
-	61, // 75: proto.v2.SkaffoldV2Service.GetState:input_type -> google.protobuf.Empty
-	61, // 76: proto.v2.SkaffoldV2Service.Events:input_type -> google.protobuf.Empty
-	61, // 77: proto.v2.SkaffoldV2Service.ApplicationLogs:input_type -> google.protobuf.Empty
-	35, // 78: proto.v2.SkaffoldV2Service.Execute:input_type -> proto.v2.UserIntentRequest
-	36, // 79: proto.v2.SkaffoldV2Service.AutoBuild:input_type -> proto.v2.TriggerRequest
-	36, // 80: proto.v2.SkaffoldV2Service.AutoSync:input_type -> proto.v2.TriggerRequest
-	36, // 81: proto.v2.SkaffoldV2Service.AutoDeploy:input_type -> proto.v2.TriggerRequest
-	17, // 82: proto.v2.SkaffoldV2Service.Handle:input_type -> proto.v2.Event
-	3,  // 83: proto.v2.SkaffoldV2Service.GetState:output_type -> proto.v2.State
-	17, // 84: proto.v2.SkaffoldV2Service.Events:output_type -> proto.v2.Event
-	17, // 85: proto.v2.SkaffoldV2Service.ApplicationLogs:output_type -> proto.v2.Event
-	61, // 86: proto.v2.SkaffoldV2Service.Execute:output_type -> google.protobuf.Empty
-	61, // 87: proto.v2.SkaffoldV2Service.AutoBuild:output_type -> google.protobuf.Empty
-	61, // 88: proto.v2.SkaffoldV2Service.AutoSync:output_type -> google.protobuf.Empty
-	61, // 89: proto.v2.SkaffoldV2Service.AutoDeploy:output_type -> google.protobuf.Empty
-	61, // 90: proto.v2.SkaffoldV2Service.Handle:output_type -> google.protobuf.Empty
-	83, // [83:91] is the sub-list for method output_type
-	75, // [75:83] is the sub-list for method input_type
-	75, // [75:75] is the sub-list for extension type_name
-	75, // [75:75] is the sub-list for extension extendee
-	0,  // [0:75] is the sub-list for field type_name
+	32, // 50: proto.v2.Event.terraformPlanEvent:type_name -> proto.v2.TerraformPlanEvent
+	33, // 51: proto.v2.Event.vulnerabilityEvent:type_name -> proto.v2.VulnerabilityEvent
+	19, // 52: proto.v2.TerminationEvent.err:type_name -> proto.v2.ActionableErr
+	55, // 53: proto.v2.ActionableErr.errCode:type_name -> proto.enums.StatusCode
+	41, // 54: proto.v2.ActionableErr.suggestions:type_name -> proto.v2.Suggestion
+	4,  // 55: proto.v2.MetaEvent.metadata:type_name -> proto.v2.Metadata
+	57, // 56: proto.v2.SkaffoldLogEvent.level:type_name -> proto.enums.LogLevel
+	19, // 57: proto.v2.TaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 58: proto.v2.BuildSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 59: proto.v2.TestSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 60: proto.v2.RenderSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 61: proto.v2.VerifySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 62: proto.v2.ExecSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	19, // 63: proto.v2.DeploySubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	55, // 64: proto.v2.StatusCheckSubtaskEvent.statusCode:type_name -> proto.enums.StatusCode
+	19, // 65: proto.v2.StatusCheckSubtaskEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	42, // 66: proto.v2.PortForwardEvent.targetPort:type_name -> proto.v2.IntOrString
+	19, // 67: proto.v2.FileSyncEvent.actionableErr:type_name -> proto.v2.ActionableErr
+	52, // 68: proto.v2.DebuggingContainerEvent.debugPorts:type_name -> proto.v2.DebuggingContainerEvent.DebugPortsEntry
+	40, // 69: proto.v2.UserIntentRequest.intent:type_name -> proto.v2.Intent
+	39, // 70: proto.v2.TriggerRequest.state:type_name -> proto.v2.TriggerState
+	58, // 71: proto.v2.Suggestion.suggestionCode:type_name -> proto.enums.SuggestionCode
+	34, // 72: proto.v2.State.ForwardedPortsEntry.value:type_name -> proto.v2.PortForwardEvent
+	59, // 73: proto.v2.BuildMetadata.Artifact.type:type_name -> proto.enums.BuilderType
+	60, // 74: proto.v2.TestMetadata.Tester.type:type_name -> proto.enums.TesterType
+	61, // 75: proto.v2.RenderMetadata.Renderer.type:type_name -> proto.enums.RenderType
+	62, // 76: proto.v2.DeployMetadata.Deployer.type:type_name -> proto.enums.DeployerType
+	63, // 77: proto.v2.SkaffoldV2Service.GetState:input_type -> google.protobuf.Empty
+	63, // 78: proto.v2.SkaffoldV2Service.Events:input_type -> google.protobuf.Empty
+	63, // 79: proto.v2.SkaffoldV2Service.ApplicationLogs:input_type -> google.protobuf.Empty
+	37, // 80: proto.v2.SkaffoldV2Service.Execute:input_type -> proto.v2.UserIntentRequest
+	38, // 81: proto.v2.SkaffoldV2Service.AutoBuild:input_type -> proto.v2.TriggerRequest
+	38, // 82: proto.v2.SkaffoldV2Service.AutoSync:input_type -> proto.v2.TriggerRequest
+	38, // 83: proto.v2.SkaffoldV2Service.AutoDeploy:input_type -> proto.v2.TriggerRequest
+	17, // 84: proto.v2.SkaffoldV2Service.Handle:input_type -> proto.v2.Event
+	3,  // 85: proto.v2.SkaffoldV2Service.GetState:output_type -> proto.v2.State
+	17, // 86: proto.v2.SkaffoldV2Service.Events:output_type -> proto.v2.Event
+	17, // 87: proto.v2.SkaffoldV2Service.ApplicationLogs:output_type -> proto.v2.Event
+	63, // 88: proto.v2.SkaffoldV2Service.Execute:output_type -> google.protobuf.Empty
+	63, // 89: proto.v2.SkaffoldV2Service.AutoBuild:output_type -> google.protobuf.Empty
+	63, // 90: proto.v2.SkaffoldV2Service.AutoSync:output_type -> google.protobuf.Empty
+	63, // 91: proto.v2.SkaffoldV2Service.AutoDeploy:output_type -> google.protobuf.Empty
+	63, // 92: proto.v2.SkaffoldV2Service.Handle:output_type -> google.protobuf.Empty
+	85, // [85:93] is the sub-list for method output_type
+	77, // [77:85] is the sub-list for method input_type
+	77, // [77:77] is the sub-list for extension type_name
+	77, // [77:77] is the sub-list for extension extendee
+	0,  // [0:77] is the sub-list for field type_name
 }
 
 func init() { file_v2_skaffold_proto_init() }
@@ -4888,7 +5181,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PortForwardEvent); i {
+			switch v := v.(*TerraformPlanEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4900,7 +5193,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileSyncEvent); i {
+			switch v := v.(*VulnerabilityEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4912,7 +5205,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebuggingContainerEvent); i {
+			switch v := v.(*PortForwardEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4924,7 +5217,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserIntentRequest); i {
+			switch v := v.(*FileSyncEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4936,7 +5229,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerRequest); i {
+			switch v := v.(*DebuggingContainerEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4948,7 +5241,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TriggerState); i {
+			switch v := v.(*UserIntentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4960,7 +5253,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Intent); i {
+			switch v := v.(*TriggerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4972,7 +5265,7 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Suggestion); i {
+			switch v := v.(*TriggerState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4984,6 +5277,30 @@ func file_v2_skaffold_proto_init() {
 			}
 		}
 		file_v2_skaffold_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Intent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_skaffold_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Suggestion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_v2_skaffold_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*IntOrString); i {
 			case 0:
 				return &v.state
@@ -4995,7 +5312,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BuildMetadata_Artifact); i {
 			case 0:
 				return &v.state
@@ -5007,7 +5324,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TestMetadata_Tester); i {
 			case 0:
 				return &v.state
@@ -5019,7 +5336,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenderMetadata_Renderer); i {
 			case 0:
 				return &v.state
@@ -5031,7 +5348,7 @@ func file_v2_skaffold_proto_init() {
 				return nil
 			}
 		}
-		file_v2_skaffold_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_v2_skaffold_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DeployMetadata_Deployer); i {
 			case 0:
 				return &v.state
@@ -5061,8 +5378,10 @@ func file_v2_skaffold_proto_init() {
 		(*Event_VerifyEvent)(nil),
 		(*Event_CloudRunReadyEvent)(nil),
 		(*Event_ExecEvent)(nil),
+		(*Event_TerraformPlanEvent)(nil),
+		(*Event_VulnerabilityEvent)(nil),
 	}
-	file_v2_skaffold_proto_msgTypes[37].OneofWrappers = []interface{}{
+	file_v2_skaffold_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*TriggerState_Enabled)(nil),
 	}
 	type x struct{}
@@ -5071,7 +5390,7 @@ func file_v2_skaffold_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_v2_skaffold_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   51,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
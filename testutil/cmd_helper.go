@@ -138,6 +138,18 @@ func (c *FakeCmd) AndRunWithOutput(command, output string) *FakeCmd {
 	})
 }
 
+// AndRunWithOutputErr takes a command, an expected output and an error.
+// It expected to match up with a call to RunCmd, pipes
+// the provided output to RunCmd's exec.Cmd's stdout and fails.
+func (c *FakeCmd) AndRunWithOutputErr(command, output string, err error) *FakeCmd {
+	return c.addRun(run{
+		command:    command,
+		output:     []byte(output),
+		pipeOutput: true,
+		err:        err,
+	})
+}
+
 func (c *FakeCmd) AndRunInputOut(command string, input string, output string) *FakeCmd {
 	return c.addRun(run{
 		command: command,